@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/export"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"time"
+)
+
+func exportCommand() *cobra.Command {
+	var (
+		format   string
+		output   string
+		from, to string
+		filter   smodels.ExportFilter
+	)
+	command := &cobra.Command{
+		Use:   "export pool-data|validator-data|pool-validator-data",
+		Short: "export a historical dataset as CSV or Parquet",
+		Long:  `write pool snapshots, validator epochs or pool-validator allocations to a file or stdout, reading them one by one`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, _ := zap.NewProduction()
+			defer log.Sync() // flushes buffer, if any
+			f, err := export.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if filter.From, err = parseTime(from); err != nil {
+				return fmt.Errorf("--from: %w", err)
+			}
+			if filter.To, err = parseTime(to); err != nil {
+				return fmt.Errorf("--to: %w", err)
+			}
+			cfg, err := config.NewEnv()
+			if err != nil {
+				return fmt.Errorf("config.NewEnv: %w", err)
+			}
+			d, err := dao.NewDAO(cfg)
+			if err != nil {
+				return fmt.Errorf("dao.NewDAO: %w", err)
+			}
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" {
+				file, err := os.Create(output)
+				if err != nil {
+					return fmt.Errorf("os.Create: %w", err)
+				}
+				defer file.Close()
+				w = file
+			}
+			if err := services.NewService(cfg, d, log).Export(args[0], f, filter, w); err != nil {
+				return fmt.Errorf("Export: %w", err)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&format, "format", string(export.CSV), "csv or parquet")
+	command.Flags().StringVarP(&output, "output", "o", "", "output file, stdout by default")
+	command.Flags().StringSliceVar(&filter.Pools, "pool", nil, "names of the pools")
+	command.Flags().StringSliceVar(&filter.Validators, "validator", nil, "vote keys of the validators")
+	command.Flags().Uint64Var(&filter.FromEpoch, "from-epoch", 0, "first epoch")
+	command.Flags().Uint64Var(&filter.ToEpoch, "to-epoch", 0, "last epoch")
+	command.Flags().StringVar(&from, "from", "", "start of the time range, RFC 3339")
+	command.Flags().StringVar(&to, "to", "", "end of the time range, RFC 3339")
+	return command
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
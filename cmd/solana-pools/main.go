@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/everstake/solana-pools/config"
 	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/delivery/grpcserv"
 	"github.com/everstake/solana-pools/internal/delivery/httpserv"
 	"github.com/everstake/solana-pools/internal/services"
 	"github.com/go-co-op/gocron"
@@ -50,8 +51,14 @@ func main() {
 				if err := s.UpdatePrice(); err != nil {
 					log.Error("UpdatePrice", zap.Error(err))
 				}
+				if err := s.FlushAPIKeyUsage(); err != nil {
+					log.Error("FlushAPIKeyUsage", zap.Error(err))
+				}
 			})
 			cron2.Every(time.Minute * 30).Do(func() {
+				if err := s.UpdateCurrencyRates(); err != nil {
+					log.Error("UpdateCurrencyRates", zap.Error(err))
+				}
 				if err := s.UpdateCoins(); err != nil {
 					log.Error("UpdateCoins", zap.Error(err))
 				}
@@ -91,6 +98,11 @@ func main() {
 			cron2.StartAsync()
 			cron3.StartAsync()
 			cron4.StartAsync()
+			go func() {
+				if err := grpcserv.New(cfg, s, log).Run(); err != nil {
+					log.Fatal("RUN: grpcserv.Run", zap.Error(err))
+				}
+			}()
 			api, err := httpserv.NewAPI(cfg, s, log)
 			if err != nil {
 				log.Fatal("RUN: httpserv.NewAPI", zap.Error(err))
@@ -99,6 +111,9 @@ func main() {
 		},
 	}
 
+	command.AddCommand(syncCatalogCommand())
+	command.AddCommand(exportCommand())
+
 	if err := command.Execute(); err != nil {
 		fmt.Println(err.Error())
 		os.Exit(1)
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/internal/catalog"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"strings"
+)
+
+func syncCatalogCommand() *cobra.Command {
+	var (
+		file   string
+		dryRun bool
+	)
+	command := &cobra.Command{
+		Use:   "sync-catalog",
+		Short: "reconcile pools, coins and liquidity pools with the catalog file",
+		Long:  `create and update pools, coins and liquidity pools from the catalog file and deactivate pools missing from it on the networks it declares`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log, _ := zap.NewProduction()
+			defer log.Sync() // flushes buffer, if any
+			c, err := catalog.Load(file)
+			if err != nil {
+				return fmt.Errorf("catalog.Load: %w", err)
+			}
+			cfg, err := config.NewEnv()
+			if err != nil {
+				return fmt.Errorf("config.NewEnv: %w", err)
+			}
+			d, err := dao.NewDAO(cfg)
+			if err != nil {
+				return fmt.Errorf("dao.NewDAO: %w", err)
+			}
+
+			changes, err := services.NewService(cfg, d, log).SyncCatalog(c, dryRun)
+			for _, ch := range changes {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s %s: %s\n", ch.Action, ch.Entity, ch.Name, strings.Join(ch.Fields, ", "))
+			}
+			if err != nil {
+				return fmt.Errorf("SyncCatalog: %w", err)
+			}
+			if len(changes) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "database matches the catalog")
+			} else if dryRun {
+				fmt.Fprintf(cmd.OutOrStdout(), "%d changes, nothing applied (dry run)\n", len(changes))
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "catalog.yaml", "catalog file, YAML or JSON")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "print the changes without applying them")
+	return command
+}
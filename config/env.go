@@ -1,19 +1,66 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"github.com/caarlos0/env/v6"
 	"github.com/joho/godotenv"
+	"strings"
+	"time"
 )
 
 type Env struct {
-	PostgresDSN        string `env:"POSTGRES_DSN"`
-	MainnetNode        string `env:"MAINNET_NODE"`
-	TestnetNode        string `env:"TESTNET_NODE"`
-	ValidatorsAppKey   string `env:"VALIDATORS_APP_KEY"`
-	HttpPort           uint64 `env:"HTTP_PORT" envDefault:"8080"`
-	HttpSwaggerAddress string `env:"HTTP_SWAGGER_ADDRESS" envDefault:"localhost:8080"`
-	GinMode            string `env:"GIN_MODE"`
+	PostgresDSN        string   `env:"POSTGRES_DSN"`
+	MainnetNode        string   `env:"MAINNET_NODE"`
+	TestnetNode        string   `env:"TESTNET_NODE"`
+	ValidatorsAppKey   string   `env:"VALIDATORS_APP_KEY"`
+	HttpPort           uint64   `env:"HTTP_PORT" envDefault:"8080"`
+	GRPCPort           uint64   `env:"GRPC_PORT" envDefault:"9090"`
+	HttpSwaggerAddress string   `env:"HTTP_SWAGGER_ADDRESS" envDefault:"localhost:8080"`
+	GinMode            string   `env:"GIN_MODE"`
+	YieldEpochWindows  []uint64 `env:"YIELD_EPOCH_WINDOWS" envSeparator:"," envDefault:"1,10,30"`
+	YieldDayWindows    []uint64 `env:"YIELD_DAY_WINDOWS" envSeparator:"," envDefault:"90"`
+	PegAlertThreshold  float64  `env:"PEG_ALERT_THRESHOLD" envDefault:"0.02"`
+	// PriceStaleAfter is the age after which a price quote is ignored and a stored price is flagged as stale.
+	PriceStaleAfter time.Duration `env:"PRICE_STALE_AFTER" envDefault:"1h"`
+	// Currencies are the fiat currencies USD values can be requested in.
+	Currencies []string `env:"CURRENCIES" envSeparator:"," envDefault:"usd,eur,gbp,jpy,cny,krw"`
+	// AdminTokens maps admin names to the bearer tokens accepted by the admin API; the API is closed when empty.
+	// Every token must be at least MinAdminTokenLength characters and must not be a placeholder.
+	AdminTokens map[string]string `env:"ADMIN_TOKENS"`
+	// RateLimitTiers are the "rate/burst" limits of the public API per API key tier; anonymous applies per client IP.
+	RateLimitTiers map[string]string `env:"RATE_LIMIT_TIERS" envDefault:"anonymous:5/10,free:20/40,pro:100/200"`
+	// TrustedProxies are the proxy IPs or CIDRs whose X-Forwarded-For header is trusted for the client IP;
+	// with none the client IP is the connection's remote address.
+	TrustedProxies []string `env:"TRUSTED_PROXIES" envSeparator:","`
+}
+
+// MinAdminTokenLength is the shortest admin token accepted.
+const MinAdminTokenLength = 32
+
+// weakAdminTokens are placeholders from examples and docs that must never guard the admin API.
+var weakAdminTokens = map[string]bool{
+	"change-me": true,
+	"changeme":  true,
+	"secret":    true,
+	"password":  true,
+	"admin":     true,
+	"token":     true,
+}
+
+// ValidateAdminTokens rejects empty, placeholder and short admin tokens.
+func ValidateAdminTokens(tokens map[string]string) error {
+	for name, token := range tokens {
+		switch {
+		case name == "":
+			return errors.New("admin token without a name")
+		case weakAdminTokens[strings.ToLower(token)]:
+			return fmt.Errorf("admin token of %s is a placeholder", name)
+		case len(token) < MinAdminTokenLength:
+			return fmt.Errorf("admin token of %s is shorter than %d characters", name, MinAdminTokenLength)
+		}
+	}
+	return nil
 }
 
 func NewEnv() (e Env, err error) {
@@ -25,5 +72,9 @@ func NewEnv() (e Env, err error) {
 	if err != nil {
 		return e, fmt.Errorf("cant` parse env file: %s", err)
 	}
+	err = ValidateAdminTokens(e.AdminTokens)
+	if err != nil {
+		return e, fmt.Errorf("ADMIN_TOKENS: %s", err)
+	}
 	return e, nil
 }
@@ -0,0 +1,31 @@
+package config_test
+
+import (
+	"github.com/everstake/solana-pools/config"
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+func TestValidateAdminTokens(t *testing.T) {
+	data := map[string]struct {
+		Tokens map[string]string
+		Valid  bool
+	}{
+		"empty":       {Tokens: nil, Valid: true},
+		"strong":      {Tokens: map[string]string{"alice": strings.Repeat("a1", 16)}, Valid: true},
+		"placeholder": {Tokens: map[string]string{"alice": "change-me"}},
+		"short":       {Tokens: map[string]string{"alice": "0123456789abcdef"}},
+		"no name":     {Tokens: map[string]string{"": strings.Repeat("a1", 16)}},
+	}
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			err := config.ValidateAdminTokens(s2.Tokens)
+			if s2.Valid {
+				assert.NilError(t, err)
+			} else {
+				assert.Assert(t, err != nil)
+			}
+		})
+	}
+}
@@ -67,6 +67,13 @@ var doc = `{
                         "description": "coin name",
                         "name": "name",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Currency of the converted price and value fields",
+                        "name": "currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -109,6 +116,111 @@ var doc = `{
                             "$ref": "#/definitions/tools.ResponseError"
                         }
                     },
+                    "503": {
+                        "description": "currency rates are not synced yet",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "default": {
+                        "description": "default response",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/coins/{name}/history": {
+            "get": {
+                "description": "The USD price history of a coin or governance token for the specified aggregation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "coin"
+                ],
+                "summary": "RestAPI",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "mSOL",
+                        "description": "Name of the coin or governance token with strict observance of the case.",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "week",
+                            "month",
+                            "quarter",
+                            "half-year",
+                            "year"
+                        ],
+                        "type": "string",
+                        "description": "Type of data aggregation for a time period",
+                        "name": "aggregation",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Currency of the price field",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ok",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tools.ResponseData"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.price"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "503": {
+                        "description": "currency rates are not synced yet",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
                     "default": {
                         "description": "default response",
                         "schema": {
@@ -177,6 +289,52 @@ var doc = `{
                 }
             }
         },
+        "/events": {
+            "get": {
+                "description": "Streams an event every time the update jobs commit new data. The event name is the topic type (pool, validator,\nliquidity-pool, network-stats, epoch, coins or delinquency) and the data names the topic; only delinquency events carry\ntheir payload, the others tell which REST resource to re-read. Reconnecting with the Last-Event-ID header, or the\nlast_event_id query parameter, replays the recent events that were missed; a resync event is sent when they are no longer kept.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Server-Sent Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated topics to stream, \u003ctype\u003e:* selects every topic of a type; all topics by default.",
+                        "name": "topics",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last received event.",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last received event, set by EventSource on reconnect.",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event stream",
+                        "schema": {
+                            "$ref": "#/definitions/v1.sseEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
         "/governance": {
             "get": {
                 "description": "get governance",
@@ -200,7 +358,12 @@ var doc = `{
                     {
                         "enum": [
                             "price",
-                            "name"
+                            "name",
+                            "market_cap",
+                            "fdv",
+                            "circulating_supply",
+                            "inflation",
+                            "holders"
                         ],
                         "type": "string",
                         "default": "price",
@@ -230,6 +393,13 @@ var doc = `{
                         "name": "limit",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Currency of the converted price and value fields",
+                        "name": "currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -272,6 +442,110 @@ var doc = `{
                             "$ref": "#/definitions/tools.ResponseError"
                         }
                     },
+                    "503": {
+                        "description": "currency rates are not synced yet",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "default": {
+                        "description": "default response",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/governance/{name}/history": {
+            "get": {
+                "description": "The supply, holders, price, market cap and FDV history of a governance token for the specified aggregation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "governance"
+                ],
+                "summary": "RestAPI",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the governance token with strict observance of the case.",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "week",
+                            "month",
+                            "quarter",
+                            "half-year",
+                            "year"
+                        ],
+                        "type": "string",
+                        "description": "Type of data aggregation for a time period",
+                        "name": "aggregation",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Currency of the converted price and value fields",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ok",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tools.ResponseData"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.governanceSupply"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "503": {
+                        "description": "currency rates are not synced yet",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
                     "default": {
                         "description": "default response",
                         "schema": {
@@ -369,7 +643,7 @@ var doc = `{
         },
         "/pool-coins": {
             "get": {
-                "description": "The information about pool tokens with the specified search parameters.",
+                "description": "The information about pool tokens with the specified search parameters. DeFi pairs break their APY down into trading fee and farm reward APR and include 7 and 30 day trends.",
                 "consumes": [
                     "application/json"
                 ],
@@ -420,6 +694,13 @@ var doc = `{
                         "name": "limit",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Currency of the converted price and value fields",
+                        "name": "currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -462,6 +743,12 @@ var doc = `{
                             "$ref": "#/definitions/tools.ResponseError"
                         }
                     },
+                    "503": {
+                        "description": "currency rates are not synced yet",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
                     "default": {
                         "description": "default response",
                         "schema": {
@@ -471,9 +758,9 @@ var doc = `{
                 }
             }
         },
-        "/pool-statistic": {
+        "/pool-peg": {
             "get": {
-                "description": "The pool statistic for the specified aggregation.",
+                "description": "The pool token price on DEX markets against its on-chain value for the specified aggregation.",
                 "consumes": [
                     "application/json"
                 ],
@@ -522,7 +809,7 @@ var doc = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/v1.poolStatistic"
+                                                "$ref": "#/definitions/v1.peg"
                                             }
                                         }
                                     }
@@ -557,9 +844,9 @@ var doc = `{
                 }
             }
         },
-        "/pool-validators/{pname}": {
+        "/pool-statistic": {
             "get": {
-                "description": "This list with pool's validators.",
+                "description": "The pool statistic for the specified aggregation, with USD values at the time of every point.",
                 "consumes": [
                     "application/json"
                 ],
@@ -567,7 +854,7 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "validatorData"
+                    "pool"
                 ],
                 "summary": "RestAPI",
                 "parameters": [
@@ -575,20 +862,119 @@ var doc = `{
                         "type": "string",
                         "default": "Eversol",
                         "description": "Name of the pool with strict observance of the case.",
-                        "name": "pname",
-                        "in": "path",
+                        "name": "name",
+                        "in": "query",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "The name of the validatorData without strict observance of the case.",
-                        "name": "vname",
-                        "in": "query"
-                    },
                     {
                         "enum": [
-                            1,
-                            10
+                            "week",
+                            "month",
+                            "quarter",
+                            "half-year",
+                            "year"
+                        ],
+                        "type": "string",
+                        "description": "Type of data aggregation for a time period",
+                        "name": "aggregation",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Currency of the converted price and value fields",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ok",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tools.ResponseData"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.poolStatistic"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "503": {
+                        "description": "currency rates are not synced yet",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "default": {
+                        "description": "default response",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pool-validators/{pname}": {
+            "get": {
+                "description": "This list with pool's validators. The filters combine. With paging=cursor, pages are read from a snapshot of the first page, follow the next and prev cursors of the meta data to browse it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validatorData"
+                ],
+                "summary": "RestAPI",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Eversol",
+                        "description": "Name of the pool with strict observance of the case.",
+                        "name": "pname",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The name of the validatorData without strict observance of the case.",
+                        "name": "vname",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            1,
+                            10
                         ],
                         "type": "number",
                         "default": 10,
@@ -619,18 +1005,104 @@ var doc = `{
                         "name": "desc",
                         "in": "query"
                     },
+                    {
+                        "type": "number",
+                        "description": "Minimum fee, a fraction.",
+                        "name": "min_fee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum fee, a fraction.",
+                        "name": "max_fee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum APY.",
+                        "name": "min_apy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum APY.",
+                        "name": "max_apy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum score.",
+                        "name": "min_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum skipped slots.",
+                        "name": "max_skipped_slots",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only delinquent validators when true, none when false.",
+                        "name": "delinquent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Data centers.",
+                        "name": "data_centers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum active stake in SOL.",
+                        "name": "min_stake",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum active stake in SOL.",
+                        "name": "max_stake",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The next or prev cursor of the previous page.",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "List the data up to the epoch, the latest by default.",
+                        "name": "snapshot_epoch",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "default": "offset",
+                        "description": "offset lists the current data, cursor pages through a snapshot; a cursor implies cursor paging",
+                        "name": "paging",
+                        "in": "query"
+                    },
                     {
                         "type": "number",
                         "default": 0,
-                        "description": "offset for aggregation",
+                        "description": "offset for aggregation, ignored with cursor paging",
                         "name": "offset",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     },
                     {
                         "type": "number",
                         "default": 10,
-                        "description": "limit for aggregation",
+                        "description": "limit for aggregation, 0 lists all",
                         "name": "limit",
                         "in": "query",
                         "required": true
@@ -687,7 +1159,7 @@ var doc = `{
         },
         "/pool/{name}": {
             "get": {
-                "description": "Creates a WS request to get server data for the pool with the pool name specified in the request.",
+                "description": "The pool with the pool name specified in the request; subscribe to pool:{name} on /ws for updates.",
                 "consumes": [
                     "application/json"
                 ],
@@ -697,7 +1169,7 @@ var doc = `{
                 "tags": [
                     "pool"
                 ],
-                "summary": "WebSocket",
+                "summary": "RestAPI",
                 "parameters": [
                     {
                         "type": "string",
@@ -887,7 +1359,7 @@ var doc = `{
         },
         "/pools-statistic": {
             "get": {
-                "description": "Creates a WS request to get current statistics.",
+                "description": "The current statistics of all pools; subscribe to network-stats on /ws for updates.",
                 "consumes": [
                     "application/json"
                 ],
@@ -897,7 +1369,7 @@ var doc = `{
                 "tags": [
                     "pool"
                 ],
-                "summary": "WebSocket",
+                "summary": "RestAPI",
                 "parameters": [
                     {
                         "enum": [
@@ -909,6 +1381,13 @@ var doc = `{
                         "description": "Epoch aggregation.",
                         "name": "epoch",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "usd",
+                        "description": "Currency of the converted price and value fields",
+                        "name": "currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -948,6 +1427,12 @@ var doc = `{
                             "$ref": "#/definitions/tools.ResponseError"
                         }
                     },
+                    "503": {
+                        "description": "currency rates are not synced yet",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
                     "default": {
                         "description": "default response",
                         "schema": {
@@ -957,9 +1442,9 @@ var doc = `{
                 }
             }
         },
-        "/validators": {
+        "/search": {
             "get": {
-                "description": "This list with all Solana's validators.",
+                "description": "Finds pools, validators, coins, governance tokens and liquidity pools by a part of the name, or by\nan address: a pool address, a validator vote key or node identity, a coin mint or gecko key, a\ngovernance token symbol or contract. Results are ranked by match quality, exact matches score 1.",
                 "consumes": [
                     "application/json"
                 ],
@@ -967,75 +1452,42 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "validatorData"
+                    "search"
                 ],
                 "summary": "RestAPI",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "The name of the validatorData without strict observance of the case.",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            1,
-                            10
-                        ],
-                        "type": "number",
-                        "default": 10,
-                        "description": "Epoch aggregation.",
-                        "name": "epoch",
-                        "in": "query"
+                        "description": "The name or address to search for, at least 2 characters.",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "array",
                         "items": {
-                            "type": "number"
+                            "enum": [
+                                "pool",
+                                "validator",
+                                "coin",
+                                "governance",
+                                "liquidity_pool"
+                            ],
+                            "type": "string"
                         },
                         "collectionFormat": "multi",
-                        "description": "Epochs for filter.",
-                        "name": "epochs",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "apy",
-                            "stake",
-                            "fee",
-                            "score",
-                            "skipped slot",
-                            "data center",
-                            "staking accounts"
-                        ],
-                        "type": "string",
-                        "default": "apy",
-                        "description": "sort param",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "default": true,
-                        "description": "desc",
-                        "name": "desc",
+                        "description": "Types of the results.",
+                        "name": "types",
                         "in": "query"
                     },
                     {
+                        "maximum": 100,
+                        "minimum": 1,
                         "type": "number",
-                        "default": 0,
-                        "description": "offset for aggregation",
-                        "name": "offset",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "number",
-                        "default": 10,
-                        "description": "limit for aggregation",
+                        "default": 20,
+                        "description": "limit for the results",
                         "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1052,7 +1504,7 @@ var doc = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/v1.validator"
+                                                "$ref": "#/definitions/v1.searchResult"
                                             }
                                         }
                                     }
@@ -1066,12 +1518,6 @@ var doc = `{
                             "$ref": "#/definitions/tools.ResponseError"
                         }
                     },
-                    "404": {
-                        "description": "bad request",
-                        "schema": {
-                            "$ref": "#/definitions/tools.ResponseError"
-                        }
-                    },
                     "500": {
                         "description": "internal server error",
                         "schema": {
@@ -1086,29 +1532,370 @@ var doc = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "tools.MetaData": {
-            "type": "object",
-            "properties": {
-                "limit": {
-                    "type": "integer"
-                },
-                "offset": {
-                    "type": "integer"
-                },
-                "total_amount": {
-                    "type": "integer"
-                }
-            }
         },
-        "tools.ResponseArrayData": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "meta_data": {
-                    "$ref": "#/definitions/tools.MetaData"
+        "/validator/{vote}": {
+            "get": {
+                "description": "The validator with its epoch history and the pools delegating to it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validatorData"
+                ],
+                "summary": "RestAPI",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vote account of the validator.",
+                        "name": "vote",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            1,
+                            10
+                        ],
+                        "type": "number",
+                        "default": 10,
+                        "description": "Epoch aggregation.",
+                        "name": "epoch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 10,
+                        "description": "limit for the epoch history",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ok",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tools.ResponseData"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.validatorDetails"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "default": {
+                        "description": "default response",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/validators": {
+            "get": {
+                "description": "This list with all Solana's validators. The filters combine. With paging=cursor, pages are read from a snapshot of the first page, follow the next and prev cursors of the meta data to browse it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validatorData"
+                ],
+                "summary": "RestAPI",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The name of the validatorData without strict observance of the case.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            1,
+                            10
+                        ],
+                        "type": "number",
+                        "default": 10,
+                        "description": "Epoch aggregation.",
+                        "name": "epoch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Epochs for filter.",
+                        "name": "epochs",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "apy",
+                            "stake",
+                            "fee",
+                            "score",
+                            "skipped slot",
+                            "data center",
+                            "staking accounts"
+                        ],
+                        "type": "string",
+                        "default": "apy",
+                        "description": "sort param",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "desc",
+                        "name": "desc",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum fee, a fraction.",
+                        "name": "min_fee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum fee, a fraction.",
+                        "name": "max_fee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum APY.",
+                        "name": "min_apy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum APY.",
+                        "name": "max_apy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum score.",
+                        "name": "min_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum skipped slots.",
+                        "name": "max_skipped_slots",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only delinquent validators when true, none when false.",
+                        "name": "delinquent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Data centers.",
+                        "name": "data_centers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum active stake in SOL.",
+                        "name": "min_stake",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum active stake in SOL.",
+                        "name": "max_stake",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only validators some of the pools delegate to.",
+                        "name": "delegated_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validators no pool delegates to.",
+                        "name": "undelegated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The next or prev cursor of the previous page.",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "List the data up to the epoch, the latest by default.",
+                        "name": "snapshot_epoch",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offset",
+                            "cursor"
+                        ],
+                        "type": "string",
+                        "default": "offset",
+                        "description": "offset lists the current data, cursor pages through a snapshot; a cursor implies cursor paging",
+                        "name": "paging",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 0,
+                        "description": "offset for aggregation, ignored with cursor paging",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 10,
+                        "description": "limit for aggregation, 0 lists all",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ok",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/tools.ResponseArrayData"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.validator"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "bad request",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    },
+                    "default": {
+                        "description": "default response",
+                        "schema": {
+                            "$ref": "#/definitions/tools.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "Multiplexed WS connection. Send {\"action\":\"subscribe\",\"topic\":\"pool:Eversol\"} to receive the current value\nof a topic and then every change of it; topics are pool:{name}, validator:{vote}, network-stats, epoch and delinquency.\n{\"action\":\"unsubscribe\"} stops a topic and {\"action\":\"ping\"} is answered with a pong. The server pings every 30 seconds\nand closes connections that do not answer or do not read their messages fast enough.",
+                "tags": [
+                    "ws"
+                ],
+                "summary": "WebSocket",
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols",
+                        "schema": {
+                            "$ref": "#/definitions/v1.wsMessage"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "tools.MetaData": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tools.ResponseArrayData": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "meta_data": {
+                    "$ref": "#/definitions/tools.MetaData"
                 }
             }
         },
@@ -1132,6 +1919,9 @@ var doc = `{
                 "avg_performance_score": {
                     "type": "integer"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "max_performance_score": {
                     "type": "integer"
                 },
@@ -1147,9 +1937,15 @@ var doc = `{
                 "pools_max_apy": {
                     "type": "number"
                 },
+                "pools_max_realized_apy": {
+                    "type": "number"
+                },
                 "skipped_slot": {
                     "type": "number"
                 },
+                "sol_price": {
+                    "type": "number"
+                },
                 "total_active_stake": {
                     "type": "number"
                 },
@@ -1176,6 +1972,9 @@ var doc = `{
                 "address": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "de_fi": {
                     "type": "object",
                     "additionalProperties": {
@@ -1191,6 +1990,18 @@ var doc = `{
                 "name": {
                     "type": "string"
                 },
+                "price": {
+                    "type": "number"
+                },
+                "price_sources": {
+                    "type": "string"
+                },
+                "price_stale": {
+                    "type": "boolean"
+                },
+                "price_updated_at": {
+                    "type": "string"
+                },
                 "small_image": {
                     "type": "string"
                 },
@@ -1211,11 +2022,49 @@ var doc = `{
                 "buy_coin": {
                     "$ref": "#/definitions/v1.coin"
                 },
+                "currency": {
+                    "type": "string"
+                },
+                "fee_apr": {
+                    "type": "number"
+                },
                 "liquidity": {
                     "type": "number"
                 },
                 "liquidity_pool": {
                     "$ref": "#/definitions/v1.liquidityPool"
+                },
+                "reward_apr": {
+                    "type": "number"
+                },
+                "trends": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.deFiTrend"
+                    }
+                },
+                "volume_24h": {
+                    "type": "number"
+                }
+            }
+        },
+        "v1.deFiTrend": {
+            "type": "object",
+            "properties": {
+                "apy_change": {
+                    "type": "number"
+                },
+                "avg_apy": {
+                    "type": "number"
+                },
+                "avg_liquidity": {
+                    "type": "number"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "liquidity_change": {
+                    "type": "number"
                 }
             }
         },
@@ -1234,60 +2083,183 @@ var doc = `{
                 "slots_in_epoch": {
                     "type": "integer"
                 },
-                "sps": {
+                "sps": {
+                    "type": "number"
+                }
+            }
+        },
+        "v1.governance": {
+            "type": "object",
+            "properties": {
+                "blockchain": {
+                    "type": "string"
+                },
+                "circulating_supply": {
+                    "type": "number"
+                },
+                "contract_address": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "fdv": {
+                    "type": "number"
+                },
+                "fdv_basis": {
+                    "type": "string",
+                    "enum": [
+                        "max_supply",
+                        "total_supply"
+                    ]
+                },
+                "holders": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "inflation_rate": {
+                    "type": "number"
+                },
+                "market_cap": {
+                    "type": "number"
+                },
+                "maximum_token_supply": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "on_chain_supply": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "price_sources": {
+                    "type": "string"
+                },
+                "price_stale": {
+                    "type": "boolean"
+                },
+                "price_updated_at": {
+                    "type": "string"
+                },
+                "supply_mismatch": {
+                    "type": "boolean"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "total_supply": {
+                    "type": "number"
+                },
+                "usd": {
+                    "type": "number"
+                },
+                "vote_url": {
+                    "type": "string"
+                },
+                "web_site_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.governanceSupply": {
+            "type": "object",
+            "properties": {
+                "circulating_supply": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "fdv": {
+                    "type": "number"
+                },
+                "fdv_basis": {
+                    "type": "string",
+                    "enum": [
+                        "max_supply",
+                        "total_supply"
+                    ]
+                },
+                "holders": {
+                    "type": "integer"
+                },
+                "market_cap": {
+                    "type": "number"
+                },
+                "maximum_token_supply": {
+                    "type": "number"
+                },
+                "on_chain_supply": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "total_supply": {
+                    "type": "number"
+                },
+                "usd": {
                     "type": "number"
                 }
             }
         },
-        "v1.governance": {
+        "v1.liquidityPool": {
             "type": "object",
             "properties": {
-                "blockchain": {
+                "about": {
                     "type": "string"
                 },
-                "circulating_supply": {
-                    "type": "number"
-                },
-                "contract_address": {
+                "error": {
                     "type": "string"
                 },
                 "image": {
                     "type": "string"
                 },
-                "maximum_token_supply": {
-                    "type": "number"
-                },
                 "name": {
                     "type": "string"
                 },
-                "symbol": {
+                "status": {
                     "type": "string"
                 },
-                "usd": {
-                    "type": "number"
-                },
-                "vote_url": {
+                "synced_at": {
                     "type": "string"
                 },
-                "web_site_url": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "v1.liquidityPool": {
+        "v1.peg": {
             "type": "object",
             "properties": {
-                "about": {
+                "created_at": {
                     "type": "string"
                 },
-                "image": {
-                    "type": "string"
+                "depegged": {
+                    "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "deviation": {
+                    "type": "number"
                 },
-                "url": {
-                    "type": "string"
+                "dex_price": {
+                    "type": "number"
+                },
+                "epoch": {
+                    "type": "integer"
+                },
+                "fair_value": {
+                    "type": "number"
+                },
+                "liquidity": {
+                    "type": "number"
                 }
             }
         },
@@ -1324,9 +2296,15 @@ var doc = `{
                 "name": {
                     "type": "string"
                 },
+                "peg": {
+                    "$ref": "#/definitions/v1.peg"
+                },
                 "rewards_fee": {
                     "type": "number"
                 },
+                "stake_weighted": {
+                    "$ref": "#/definitions/v1.stakeWeighted"
+                },
                 "staking_accounts": {
                     "type": "integer"
                 },
@@ -1344,6 +2322,9 @@ var doc = `{
                 },
                 "withdrawal_fee": {
                     "type": "number"
+                },
+                "yield": {
+                    "$ref": "#/definitions/v1.yield"
                 }
             }
         },
@@ -1380,9 +2361,15 @@ var doc = `{
                 "name": {
                     "type": "string"
                 },
+                "peg": {
+                    "$ref": "#/definitions/v1.peg"
+                },
                 "rewards_fee": {
                     "type": "number"
                 },
+                "stake_weighted": {
+                    "$ref": "#/definitions/v1.stakeWeighted"
+                },
                 "staking_accounts": {
                     "type": "integer"
                 },
@@ -1400,6 +2387,9 @@ var doc = `{
                 },
                 "withdrawal_fee": {
                     "type": "number"
+                },
+                "yield": {
+                    "$ref": "#/definitions/v1.yield"
                 }
             }
         },
@@ -1415,23 +2405,139 @@ var doc = `{
                 "created_at": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "delinquent": {
                     "type": "integer"
                 },
                 "number_of_validators": {
                     "type": "integer"
                 },
+                "sol_price": {
+                    "description": "The same values in Currency, at the rate of CreatedAt.",
+                    "type": "number"
+                },
+                "sol_usd": {
+                    "description": "USD values use the prices saved last before CreatedAt and are zero when there is none.",
+                    "type": "number"
+                },
+                "token_price": {
+                    "type": "number"
+                },
+                "token_usd": {
+                    "type": "number"
+                },
                 "tokens_supply": {
                     "type": "number"
                 },
+                "tokens_supply_usd": {
+                    "type": "number"
+                },
+                "tokens_supply_value": {
+                    "type": "number"
+                },
                 "total_sol": {
                     "type": "number"
                 },
+                "total_sol_usd": {
+                    "type": "number"
+                },
+                "total_sol_value": {
+                    "type": "number"
+                },
                 "unstacked_liquidity": {
                     "type": "number"
                 }
             }
         },
+        "v1.price": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "sources": {
+                    "type": "string"
+                },
+                "usd": {
+                    "type": "number"
+                }
+            }
+        },
+        "v1.realizedAPY": {
+            "type": "object",
+            "properties": {
+                "apy": {
+                    "type": "number"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "epochs": {
+                    "type": "integer"
+                },
+                "fee_drag": {
+                    "type": "number"
+                }
+            }
+        },
+        "v1.searchResult": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.sseEvent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "data": {},
+                "topic": {
+                    "type": "string",
+                    "example": "pool:Eversol"
+                }
+            }
+        },
+        "v1.stakeWeighted": {
+            "type": "object",
+            "properties": {
+                "apy": {
+                    "type": "number"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "skipped_slots": {
+                    "type": "number"
+                }
+            }
+        },
         "v1.validator": {
             "type": "object",
             "properties": {
@@ -1516,6 +2622,154 @@ var doc = `{
                     "type": "string"
                 }
             }
+        },
+        "v1.validatorDetails": {
+            "type": "object",
+            "properties": {
+                "apy": {
+                    "type": "number"
+                },
+                "data_center": {
+                    "type": "string"
+                },
+                "delinquent": {
+                    "type": "boolean"
+                },
+                "epoch": {
+                    "type": "integer"
+                },
+                "fee": {
+                    "type": "number"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.validatorEpoch"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "node_pk": {
+                    "type": "string"
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.validatorPool"
+                    }
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "skipped_slots": {
+                    "type": "number"
+                },
+                "staking_accounts": {
+                    "type": "integer"
+                },
+                "total_active_stake": {
+                    "type": "number"
+                },
+                "vote_pk": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.validatorEpoch": {
+            "type": "object",
+            "properties": {
+                "active_stake": {
+                    "type": "number"
+                },
+                "apy": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "epoch": {
+                    "type": "integer"
+                },
+                "fee": {
+                    "type": "number"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "skipped_slots": {
+                    "type": "number"
+                },
+                "staking_accounts": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.validatorPool": {
+            "type": "object",
+            "properties": {
+                "active_stake": {
+                    "type": "number"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pool_share": {
+                    "type": "number"
+                }
+            }
+        },
+        "v1.wsMessage": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "data",
+                        "subscribed",
+                        "unsubscribed",
+                        "error",
+                        "pong"
+                    ]
+                }
+            }
+        },
+        "v1.yield": {
+            "type": "object",
+            "properties": {
+                "realized": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.realizedAPY"
+                    }
+                },
+                "validator_apy": {
+                    "type": "number"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
         }
     },
     "x-extension-openapi": {
@@ -1539,7 +2793,7 @@ var SwaggerInfo = swaggerInfo{
 	BasePath:    "/v1",
 	Schemes:     []string{},
 	Title:       "",
-	Description: "",
+	Description: "Optional; requests without a key are rate limited per client IP.",
 }
 
 type s struct{}
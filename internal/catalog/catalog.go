@@ -0,0 +1,158 @@
+package catalog
+
+import (
+	"fmt"
+	"github.com/dfuse-io/solana-go"
+	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/pkg/pools"
+	"gopkg.in/yaml.v3"
+	"os"
+)
+
+// Version is the catalog format this build reads.
+const Version = 1
+
+type (
+	// Catalog is the declared set of pools, coins and DEX venues; JSON catalogs are read as YAML.
+	Catalog struct {
+		Version        int             `yaml:"version"`
+		Coins          []Coin          `yaml:"coins"`
+		Pools          []Pool          `yaml:"pools"`
+		LiquidityPools []LiquidityPool `yaml:"liquidity_pools"`
+	}
+	Coin struct {
+		Name     string `yaml:"name"`
+		Mint     string `yaml:"mint"`
+		GeckoKey string `yaml:"gecko_key"`
+		Images   struct {
+			Thumb string `yaml:"thumb"`
+			Small string `yaml:"small"`
+			Large string `yaml:"large"`
+		} `yaml:"images"`
+	}
+	// Pool references its coin by name; pools are active unless Active is false.
+	Pool struct {
+		Name    string `yaml:"name"`
+		Program string `yaml:"program"`
+		Address string `yaml:"address"`
+		Network string `yaml:"network"`
+		Coin    string `yaml:"coin"`
+		Image   string `yaml:"image"`
+		Active  *bool  `yaml:"active"`
+	}
+	LiquidityPool struct {
+		Name  string `yaml:"name"`
+		About string `yaml:"about"`
+		Image string `yaml:"image"`
+		URL   string `yaml:"url"`
+	}
+)
+
+func Load(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) (*Catalog, error) {
+	c := &Catalog{}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("yaml.Unmarshal: %w", err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate checks the catalog is self-consistent: unique names and gecko keys, known programs and networks,
+// base58 addresses and pools referencing catalog coins.
+func (c *Catalog) Validate() error {
+	if c.Version != Version {
+		return fmt.Errorf("unsupported catalog version %d, want %d", c.Version, Version)
+	}
+
+	coins := make(map[string]bool, len(c.Coins))
+	geckoKeys := make(map[string]string, len(c.Coins))
+	for _, coin := range c.Coins {
+		if coin.Name == "" {
+			return fmt.Errorf("coin without name")
+		}
+		if coins[coin.Name] {
+			return fmt.Errorf("coin %s: duplicated name", coin.Name)
+		}
+		coins[coin.Name] = true
+		if coin.GeckoKey != "" {
+			if other, ok := geckoKeys[coin.GeckoKey]; ok {
+				return fmt.Errorf("coin %s: gecko key %s is already used by %s", coin.Name, coin.GeckoKey, other)
+			}
+			geckoKeys[coin.GeckoKey] = coin.Name
+		}
+		if coin.Mint != "" {
+			if _, err := solana.PublicKeyFromBase58(coin.Mint); err != nil {
+				return fmt.Errorf("coin %s: mint %s is not a base58 public key", coin.Name, coin.Mint)
+			}
+		}
+	}
+
+	names := make(map[string]bool, len(c.Pools))
+	for _, pool := range c.Pools {
+		if pool.Name == "" {
+			return fmt.Errorf("pool without name")
+		}
+		if names[pool.Name] {
+			return fmt.Errorf("pool %s: duplicated name", pool.Name)
+		}
+		names[pool.Name] = true
+		if !coins[pool.Coin] {
+			return fmt.Errorf("pool %s: coin %s is not in the catalog", pool.Name, pool.Coin)
+		}
+		if config.Network(pool.Network) != config.Mainnet && config.Network(pool.Network) != config.Testnet {
+			return fmt.Errorf("pool %s: unknown network %s", pool.Name, pool.Network)
+		}
+		if !knownProgram(pool.Program) {
+			return fmt.Errorf("pool %s: unknown program %s", pool.Name, pool.Program)
+		}
+		if _, err := solana.PublicKeyFromBase58(pool.Address); err != nil {
+			return fmt.Errorf("pool %s: address %s is not a base58 public key", pool.Name, pool.Address)
+		}
+	}
+
+	venues := make(map[string]bool, len(c.LiquidityPools))
+	for _, lp := range c.LiquidityPools {
+		if lp.Name == "" {
+			return fmt.Errorf("liquidity pool without name")
+		}
+		if venues[lp.Name] {
+			return fmt.Errorf("liquidity pool %s: duplicated name", lp.Name)
+		}
+		venues[lp.Name] = true
+	}
+
+	return nil
+}
+
+// Networks returns the networks the catalog declares pools on; SyncCatalog only deactivates pools on these networks.
+func (c *Catalog) Networks() map[string]bool {
+	networks := make(map[string]bool)
+	for _, pool := range c.Pools {
+		networks[pool.Network] = true
+	}
+	return networks
+}
+
+// IsActive reports whether the pool should be active; pools are active by default.
+func (p Pool) IsActive() bool {
+	return p.Active == nil || *p.Active
+}
+
+func knownProgram(program string) bool {
+	for _, p := range pools.Programs {
+		if p == program {
+			return true
+		}
+	}
+	return false
+}
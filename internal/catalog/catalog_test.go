@@ -0,0 +1,57 @@
+package catalog_test
+
+import (
+	"github.com/everstake/solana-pools/internal/catalog"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestLoadExample(t *testing.T) {
+	c, err := catalog.Load("../../catalog.example.yaml")
+	assert.NilError(t, err)
+	assert.Equal(t, len(c.Pools), 2)
+	assert.Equal(t, c.Pools[0].IsActive(), true)
+}
+
+func TestParse(t *testing.T) {
+	data := map[string]struct {
+		Catalog string
+		Err     string
+	}{
+		"json": {
+			Catalog: `{"version": 1, "coins": [{"name": "mSOL"}], "pools": [{"name": "Marinade", "program": "marinade", "network": "mainnet", "coin": "mSOL", "address": "8szGkuLTAux9XMgZ2vtY39jVSowEcpBfFfD8hXSEqdGC", "active": false}]}`,
+		},
+		"version": {
+			Catalog: "version: 2",
+			Err:     "unsupported catalog version 2, want 1",
+		},
+		"duplicated gecko key": {
+			Catalog: "version: 1\ncoins: [{name: mSOL, gecko_key: msol}, {name: mSOL2, gecko_key: msol}]",
+			Err:     "coin mSOL2: gecko key msol is already used by mSOL",
+		},
+		"unknown coin": {
+			Catalog: "version: 1\npools: [{name: Marinade, program: marinade, network: mainnet, coin: mSOL, address: 8szGkuLTAux9XMgZ2vtY39jVSowEcpBfFfD8hXSEqdGC}]",
+			Err:     "pool Marinade: coin mSOL is not in the catalog",
+		},
+		"unknown program": {
+			Catalog: "version: 1\ncoins: [{name: mSOL}]\npools: [{name: Marinade, program: lido, network: mainnet, coin: mSOL, address: 8szGkuLTAux9XMgZ2vtY39jVSowEcpBfFfD8hXSEqdGC}]",
+			Err:     "pool Marinade: unknown program lido",
+		},
+		"bad address": {
+			Catalog: "version: 1\ncoins: [{name: mSOL}]\npools: [{name: Marinade, program: marinade, network: mainnet, coin: mSOL, address: 0xdead}]",
+			Err:     "pool Marinade: address 0xdead is not a base58 public key",
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			c, err := catalog.Parse([]byte(s2.Catalog))
+			if s2.Err != "" {
+				assert.Error(t, err, s2.Err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, c.Pools[0].IsActive(), false)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"time"
+)
+
+const apiKeyKey = "api_key"
+
+// SetAPIKey caches the key looked up by hash; a nil key caches an unknown or inactive key.
+func (c *Cache) SetAPIKey(hash string, key *smodels.APIKey, storageTime time.Duration) {
+	c.cache.Set(fmt.Sprintf("%s:%s", apiKeyKey, hash), key, storageTime)
+}
+
+func (c *Cache) GetAPIKey(hash string) (*smodels.APIKey, error) {
+	v, b := c.cache.Get(fmt.Sprintf("%s:%s", apiKeyKey, hash))
+	if !b {
+		return nil, fmt.Errorf("%w: %s", KeyWasNotFound, apiKeyKey)
+	}
+
+	return v.(*smodels.APIKey), nil
+}
+
+// InvalidateAPIKeys drops the cached keys so deactivated keys stop working immediately.
+func (c *Cache) InvalidateAPIKeys() {
+	c.invalidatePrefix(apiKeyKey + ":")
+}
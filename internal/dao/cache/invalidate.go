@@ -0,0 +1,17 @@
+package cache
+
+import "strings"
+
+// InvalidatePools drops the cached pool details and the total statistic built from them.
+func (c *Cache) InvalidatePools() {
+	c.invalidatePrefix(PoolKey + ":")
+	c.cache.Delete(totalCurrentStatisticsKey)
+}
+
+func (c *Cache) invalidatePrefix(prefix string) {
+	for k := range c.cache.Items() {
+		if strings.HasPrefix(k, prefix) {
+			c.cache.Delete(k)
+		}
+	}
+}
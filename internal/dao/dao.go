@@ -7,6 +7,7 @@ import (
 	"github.com/everstake/solana-pools/internal/dao/dmodels"
 	"github.com/everstake/solana-pools/internal/dao/postgres"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
 //go:generate moq -out postgres_mock.go . Postgres
@@ -18,13 +19,25 @@ type (
 	Postgres interface {
 		CreatePoolValidatorData(pools ...*dmodels.PoolValidatorData) error
 		CreateSlotTime(slotTime ...*dmodels.SlotTime) error
+		CreatePoolPeg(pegs ...*dmodels.PoolPeg) error
+		CreatePriceHistory(history ...*dmodels.PriceHistory) error
+		CreateCurrencyRates(rates ...*dmodels.CurrencyRate) error
+		CreateGovernanceSupply(supply ...*dmodels.GovernanceSupply) error
+		ApplyAudited(entity interface{}, audit *dmodels.AuditLog) error
+		ApplyAuditedBatch(entities ...postgres.AuditedEntity) error
+		AddAPIKeyUsage(date time.Time, requests map[uuid.UUID]int64) error
+		TouchDataVersions(types ...string) error
 		SaveGovernance(gov ...*dmodels.Governance) error
 		SaveCoin(coin ...*dmodels.Coin) error
 		SaveDEFIs(defiData ...*dmodels.DEFI) error
+		ReplaceDEFIs(liquidityPoolID uuid.UUID, defiData []*dmodels.DEFI, history []*dmodels.DEFIHistory) error
+		SavePoolExchangeRate(rate *dmodels.PoolExchangeRate) error
 
 		UpdatePoolData(*dmodels.PoolData) error
 		UpdateValidators(validators ...*dmodels.Validator) error
+		GetValidatorsDelinquency() (map[string]bool, error)
 		UpdateValidatorsData(data ...*dmodels.ValidatorData) error
+		UpdateLiquidityPoolStatus(pool *dmodels.LiquidityPool) error
 
 		DeleteValidators(poolID uuid.UUID) error
 		DeleteDeFis(cond *postgres.DeFiCondition) error
@@ -34,26 +47,53 @@ type (
 		GetValidator(validatorID string, epoch uint64) (*dmodels.ValidatorView, error)
 		GetLastPoolDataWithApyForTenEpoch(poolID uuid.UUID) (*dmodels.PoolData, error)
 		GetLastPoolData(PoolID uuid.UUID) (*dmodels.PoolData, error)
+		GetLastPoolsData(poolIDs []uuid.UUID) ([]*dmodels.PoolData, error)
+		GetLastPoolPeg(poolID uuid.UUID) (*dmodels.PoolPeg, error)
+		GetPricesAt(assetIDs []uuid.UUID, points []time.Time) ([]*dmodels.PriceHistory, error)
+		GetCurrencyRateAt(currency string, t time.Time) (*dmodels.CurrencyRate, error)
+		GetCurrencyRatesBetween(currency string, from time.Time, to time.Time) ([]*dmodels.CurrencyRate, error)
+		GetGovernanceSupplyAt(governanceID uuid.UUID, t time.Time) (*dmodels.GovernanceSupply, error)
+		GetAPIKeyByHash(hash string) (*dmodels.APIKey, error)
+		GetAPIKeyUsage(since time.Time) (map[uuid.UUID]int64, error)
+		GetDataVersion(types []string) (time.Time, error)
 		GetValidatorByVotePK(key solana.PublicKey) (*dmodels.ValidatorView, error)
 		GetLastEpochPoolData(PoolID uuid.UUID, currentEpoch uint64) (*dmodels.PoolData, error)
+		GetPoolExchangeRate(poolID uuid.UUID, epoch uint64) (*dmodels.PoolExchangeRate, error)
+		GetPoolsExchangeRateWindows(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error)
 		GetDEFIs(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error)
+		GetDEFIHistory(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error)
 		GetLiquidityPool(cond *postgres.Condition) (*dmodels.LiquidityPool, error)
 
 		GetPoolCount(*postgres.Condition) (int64, error)
 		GetCoinsCount(cond *postgres.CoinCondition) (int64, error)
 		GetGovernanceCount(cond *postgres.GovernanceCondition) (int64, error)
 		GetValidatorDataCount(condition *postgres.PoolValidatorDataCondition, epoch uint64) (int64, error)
+		GetValidatorDataCounts(poolDataIDs []uuid.UUID) (map[uuid.UUID]int64, error)
 		GetValidatorCount(condition *postgres.ValidatorCondition, epoch uint64) (int64, error)
 		GetLiquidityPoolsCount(cond *postgres.Condition) (int64, error)
+		GetAuditLogsCount(cond *postgres.AuditLogCondition) (int64, error)
 
 		GetSlotTime(cond *postgres.SlotTimeCondition) ([]*dmodels.SlotTime, error)
 		GetPools(condition *postgres.PoolCondition) ([]*dmodels.Pool, error)
 		GetCoins(cond *postgres.CoinCondition) ([]*dmodels.Coin, error)
 		GetLiquidityPools(cond *postgres.Condition) ([]*dmodels.LiquidityPool, error)
 		GetGovernance(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error)
+		GetAuditLogs(cond *postgres.AuditLogCondition) ([]*dmodels.AuditLog, error)
+		GetAPIKeys() ([]*dmodels.APIKey, error)
 		GetValidators(condition *postgres.ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error)
+		GetValidatorData(condition *postgres.ValidatorDataCondition) ([]*dmodels.ValidatorData, error)
 		GetPoolStatistic(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error)
+		GetPoolsStatistic(poolIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error)
+		GetPoolPegs(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolPeg, error)
+		GetPriceHistory(assetID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PriceHistory, error)
+		GetGovernanceSupplyHistory(governanceID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error)
+		GetGovernanceSuppliesHistory(governanceIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error)
 		GetPoolValidatorData(condition *postgres.PoolValidatorDataCondition, epoch uint64) ([]*dmodels.PoolValidatorData, error)
+		Search(cond *postgres.SearchCondition) ([]*dmodels.SearchResult, error)
+
+		ExportPoolData(cond *postgres.ExportCondition, fn func(*dmodels.PoolDataExport) error) error
+		ExportValidatorData(cond *postgres.ExportCondition, fn func(*dmodels.ValidatorDataExport) error) error
+		ExportPoolValidatorData(cond *postgres.ExportCondition, fn func(*dmodels.PoolValidatorDataExport) error) error
 	}
 	Imp struct {
 		*postgres.DB
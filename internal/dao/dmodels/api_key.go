@@ -0,0 +1,23 @@
+package dmodels
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// APIKey authenticates public API clients; only the SHA-256 hash of the key is stored.
+type APIKey struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	Name      string    `gorm:"type:varchar(100);not null;"`
+	KeyHash   string    `gorm:"type:varchar(64);not null;index:idx_api_key_hash,unique;"`
+	Tier      string    `gorm:"type:varchar(40);not null;"`
+	Active    bool      `gorm:"not null;default:true;"`
+	CreatedAt time.Time `gorm:"not null;"`
+}
+
+// APIKeyUsage is the number of requests made with a key during a day.
+type APIKeyUsage struct {
+	APIKeyID uuid.UUID `gorm:"primaryKey;type:uuid;not null;"`
+	Date     time.Time `gorm:"primaryKey;type:date;not null;"`
+	Requests int64     `gorm:"not null;default:0;"`
+}
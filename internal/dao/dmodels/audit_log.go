@@ -0,0 +1,30 @@
+package dmodels
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+
+	AuditEntityPool          = "pool"
+	AuditEntityCoin          = "coin"
+	AuditEntityLiquidityPool = "liquidity_pool"
+	AuditEntityGovernance    = "governance"
+	AuditEntityAPIKey        = "api_key"
+)
+
+// AuditLog records a change made through the admin API; Before and After are JSON snapshots of the entity.
+type AuditLog struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	Actor     string    `gorm:"type:varchar(60);not null;"`
+	Action    string    `gorm:"type:varchar(20);not null;"`
+	Entity    string    `gorm:"type:varchar(40);not null;index:idx_audit_log_entity;"`
+	EntityID  uuid.UUID `gorm:"type:uuid;not null;index:idx_audit_log_entity;"`
+	Before    string    `gorm:"type:text;not null;default:'';"`
+	After     string    `gorm:"type:text;not null;default:'';"`
+	CreatedAt time.Time `gorm:"not null;index;"`
+}
@@ -1,6 +1,9 @@
 package dmodels
 
-import uuid "github.com/satori/go.uuid"
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
 
 type Coin struct {
 	ID         uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
@@ -11,4 +14,7 @@ type Coin struct {
 	ThumbImage string    `gorm:"type:varchar(240);not null;default:'NaN';"`
 	SmallImage string    `gorm:"type:varchar(240);not null;default:0;default:'NaN';"`
 	LargeImage string    `gorm:"type:varchar(240);not null;default:0;default:'NaN';"`
+	// PriceUpdatedAt and PriceSources describe the last aggregated USD price.
+	PriceUpdatedAt *time.Time `gorm:"type:timestamp"`
+	PriceSources   string     `gorm:"type:varchar(120);not null;default:'';"`
 }
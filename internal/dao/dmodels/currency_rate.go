@@ -0,0 +1,14 @@
+package dmodels
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// CurrencyRate is how many units of a fiat currency one USD buys, saved on every rate update.
+type CurrencyRate struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	Currency  string    `gorm:"type:varchar(10);not null;index:idx_currency_rate;"`
+	Rate      float64   `gorm:"type:float8;not null;"`
+	CreatedAt time.Time `gorm:"not null;index:idx_currency_rate;"`
+}
@@ -0,0 +1,9 @@
+package dmodels
+
+import "time"
+
+// DataVersion records when the data of a type last changed, so every instance tags cached responses alike.
+type DataVersion struct {
+	Type      string    `gorm:"primaryKey;type:varchar(40);not null;"`
+	ChangedAt time.Time `gorm:"not null;default:clock_timestamp();"`
+}
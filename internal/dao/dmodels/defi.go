@@ -3,6 +3,7 @@ package dmodels
 import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
+	"time"
 )
 
 type DEFI struct {
@@ -11,7 +12,29 @@ type DEFI struct {
 	SaleCoinID      uuid.UUID       `gorm:"type:uuid;not null;"`
 	BuyCoinID       uuid.UUID       `gorm:"type:uuid;not null;"`
 	Liquidity       float64         `gorm:"type:float8;not null;"`
+	Price           float64         `gorm:"type:float8;not null;default:0;"`
+	Volume24H       float64         `gorm:"type:float8;not null;default:0;"`
 	APY             decimal.Decimal `gorm:"type:decimal(24,9);not null;"`
+	FeeAPR          decimal.Decimal `gorm:"type:decimal(24,9);not null;default:0;"`
+	RewardAPR       decimal.Decimal `gorm:"type:decimal(24,9);not null;default:0;"`
+	SaleCoin        Coin            `gorm:"foreignKey:SaleCoinID;constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
+	BuyCoin         Coin            `gorm:"foreignKey:BuyCoinID;constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
+	LiquidityPool   LiquidityPool   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
+}
+
+// DEFIHistory is a snapshot of a DEFI row taken on every DeFi update.
+type DEFIHistory struct {
+	ID              uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	LiquidityPoolID uuid.UUID       `gorm:"type:uuid;not null;index:idx_defi_history_pair;"`
+	SaleCoinID      uuid.UUID       `gorm:"type:uuid;not null;index:idx_defi_history_pair;"`
+	BuyCoinID       uuid.UUID       `gorm:"type:uuid;not null;index:idx_defi_history_pair;"`
+	Liquidity       float64         `gorm:"type:float8;not null;"`
+	Price           float64         `gorm:"type:float8;not null;default:0;"`
+	Volume24H       float64         `gorm:"type:float8;not null;default:0;"`
+	APY             decimal.Decimal `gorm:"type:decimal(24,9);not null;"`
+	FeeAPR          decimal.Decimal `gorm:"type:decimal(24,9);not null;default:0;"`
+	RewardAPR       decimal.Decimal `gorm:"type:decimal(24,9);not null;default:0;"`
+	CreatedAt       time.Time       `gorm:"index;not null"`
 	SaleCoin        Coin            `gorm:"foreignKey:SaleCoinID;constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
 	BuyCoin         Coin            `gorm:"foreignKey:BuyCoinID;constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
 	LiquidityPool   LiquidityPool   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
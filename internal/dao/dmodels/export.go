@@ -0,0 +1,44 @@
+package dmodels
+
+import (
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// PoolDataExport is a pool snapshot with the name of its pool.
+type PoolDataExport struct {
+	Pool              string          `gorm:"column:pool"`
+	Epoch             uint64          `gorm:"column:epoch"`
+	ActiveStake       uint64          `gorm:"column:active_stake"`
+	TotalTokensSupply uint64          `gorm:"column:total_tokens_supply"`
+	TotalLamports     uint64          `gorm:"column:total_lamports"`
+	APY               decimal.Decimal `gorm:"column:apy"`
+	UnstakeLiquidity  uint64          `gorm:"column:unstake_liquidity"`
+	DepossitFee       decimal.Decimal `gorm:"column:depossit_fee"`
+	WithdrawalFee     decimal.Decimal `gorm:"column:withdrawal_fee"`
+	RewardsFee        decimal.Decimal `gorm:"column:rewards_fee"`
+	CreatedAt         time.Time       `gorm:"column:created_at"`
+}
+
+// ValidatorDataExport is the data of a validator in an epoch with the name of the validator.
+type ValidatorDataExport struct {
+	ValidatorID     string          `gorm:"column:validator_id"`
+	Name            string          `gorm:"column:name"`
+	Epoch           uint64          `gorm:"column:epoch"`
+	APY             decimal.Decimal `gorm:"column:apy"`
+	StakingAccounts uint64          `gorm:"column:staking_accounts"`
+	ActiveStake     uint64          `gorm:"column:active_stake"`
+	Fee             decimal.Decimal `gorm:"column:fee"`
+	Score           int64           `gorm:"column:score"`
+	SkippedSlots    decimal.Decimal `gorm:"column:skipped_slots"`
+	CreatedAt       time.Time       `gorm:"column:created_at"`
+}
+
+// PoolValidatorDataExport is the stake a pool delegated to a validator at a pool snapshot.
+type PoolValidatorDataExport struct {
+	Pool        string    `gorm:"column:pool"`
+	Epoch       uint64    `gorm:"column:epoch"`
+	ValidatorID string    `gorm:"column:validator_id"`
+	ActiveStake uint64    `gorm:"column:active_stake"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
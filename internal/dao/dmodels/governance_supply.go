@@ -0,0 +1,19 @@
+package dmodels
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// GovernanceSupply is a snapshot of the supply and USD price of a governance token, saved on every governance update.
+type GovernanceSupply struct {
+	ID                 uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	GovernanceID       uuid.UUID `gorm:"type:uuid;not null;index:idx_governance_supply;"`
+	MaximumTokenSupply float64   `gorm:"type:float8;default:0;not null;"`
+	TotalSupply        float64   `gorm:"type:float8;default:0;not null;"`
+	CirculatingSupply  float64   `gorm:"type:float8;default:0;not null;"`
+	OnChainSupply      float64   `gorm:"type:float8;default:0;not null;"`
+	Holders            uint64    `gorm:"type:int8;default:0;not null;"`
+	USD                float64   `gorm:"type:float8;default:0;not null;"`
+	CreatedAt          time.Time `gorm:"not null;index:idx_governance_supply;"`
+}
@@ -1,6 +1,14 @@
 package dmodels
 
-import uuid "github.com/satori/go.uuid"
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	LiquidityPoolStatusOK     = "ok"
+	LiquidityPoolStatusFailed = "failed"
+)
 
 type LiquidityPool struct {
 	ID    uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
@@ -8,4 +16,8 @@ type LiquidityPool struct {
 	About string    `gorm:"type:text;default:'';not null;"`
 	Image string    `gorm:"type:text;default:'null';not null;"`
 	URL   string    `gorm:"type:text;default:'null';not null;"`
+	// Status, Error and SyncedAt describe the last DeFi update from the pool's source.
+	Status   string     `gorm:"type:varchar(20);default:'';not null;"`
+	Error    string     `gorm:"type:text;default:'';not null;"`
+	SyncedAt *time.Time `gorm:"type:timestamp"`
 }
@@ -9,6 +9,7 @@ type Pool struct {
 	CoinID  uuid.UUID `gorm:"type:uuid;not null;"`
 	Address string    `gorm:"index;not null;"`
 	Network string    `gorm:"type:varchar(50);not null;"`
+	Program string    `gorm:"type:varchar(40);not null;default:'';"`
 	Image   string    `gorm:"type:varchar(240);not null;default:'Default';"`
 	Coin    Coin      `gorm:"constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
 }
@@ -0,0 +1,20 @@
+package dmodels
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// PoolExchangeRate is the last observed value of one pool token in lamports for an epoch.
+type PoolExchangeRate struct {
+	ID                uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	PoolID            uuid.UUID       `gorm:"type:uuid;not null;index:idx_pool_exchange_rate_epoch,unique;"`
+	Epoch             uint64          `gorm:"type:int8;not null;index:idx_pool_exchange_rate_epoch,unique;"`
+	TotalTokensSupply uint64          `gorm:"type:int8;not null;"`
+	TotalLamports     uint64          `gorm:"type:int8;not null;"`
+	Rate              decimal.Decimal `gorm:"type:decimal(24,12);not null;"`
+	UpdatedAt         time.Time       `gorm:"index;not null"`
+	CreatedAt         time.Time       `gorm:"not null"`
+	Pool              Pool            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
+}
@@ -0,0 +1,20 @@
+package dmodels
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// PoolPeg compares the on-chain value of a pool token with its liquidity-weighted DEX price, both in SOL.
+type PoolPeg struct {
+	ID        uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	PoolID    uuid.UUID       `gorm:"type:uuid;not null;index;"`
+	Epoch     uint64          `gorm:"type:int8;not null;"`
+	FairValue decimal.Decimal `gorm:"type:decimal(24,12);not null;"`
+	DEXPrice  decimal.Decimal `gorm:"type:decimal(24,12);not null;"`
+	Deviation decimal.Decimal `gorm:"type:decimal(24,9);not null;"`
+	Liquidity float64         `gorm:"type:float8;not null;"`
+	CreatedAt time.Time       `gorm:"index;not null"`
+	Pool      Pool            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:Restrict;"`
+}
@@ -0,0 +1,21 @@
+package dmodels
+
+import (
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+const (
+	PriceAssetCoin       = "coin"
+	PriceAssetGovernance = "governance"
+)
+
+// PriceHistory is a USD price of a coin or governance token, saved on every price update.
+type PriceHistory struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4();not null;"`
+	AssetType string    `gorm:"type:varchar(20);not null;"`
+	AssetID   uuid.UUID `gorm:"type:uuid;not null;index:idx_price_history_asset;"`
+	USD       float64   `gorm:"type:float8;not null;"`
+	Sources   string    `gorm:"type:varchar(120);not null;default:'';"`
+	CreatedAt time.Time `gorm:"not null;index:idx_price_history_asset;"`
+}
@@ -0,0 +1,20 @@
+package dmodels
+
+const (
+	SearchPool          = "pool"
+	SearchValidator     = "validator"
+	SearchCoin          = "coin"
+	SearchGovernance    = "governance"
+	SearchLiquidityPool = "liquidity_pool"
+)
+
+// SearchResult is an entity matching a search; Key identifies it in the API, Address is its on-chain address and
+// Score ranks the match from 0 to 1.
+type SearchResult struct {
+	Type    string  `gorm:"column:type"`
+	Key     string  `gorm:"column:key"`
+	Name    string  `gorm:"column:name"`
+	Address string  `gorm:"column:address"`
+	Image   string  `gorm:"column:image"`
+	Score   float64 `gorm:"column:score"`
+}
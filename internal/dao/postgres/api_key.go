@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+func (db *DB) GetAPIKeys() ([]*dmodels.APIKey, error) {
+	var keys []*dmodels.APIKey
+	return keys, db.Order("created_at").Find(&keys).Error
+}
+
+// GetAPIKeyByHash returns the key with the hash, or nil when there is none.
+func (db *DB) GetAPIKeyByHash(hash string) (*dmodels.APIKey, error) {
+	key := &dmodels.APIKey{}
+	if err := db.Where(`key_hash = ?`, hash).First(key).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+// AddAPIKeyUsage adds the request counts to the usage of the day.
+func (db *DB) AddAPIKeyUsage(date time.Time, requests map[uuid.UUID]int64) error {
+	if len(requests) == 0 {
+		return nil
+	}
+	usage := make([]*dmodels.APIKeyUsage, 0, len(requests))
+	for id, n := range requests {
+		usage = append(usage, &dmodels.APIKeyUsage{APIKeyID: id, Date: date, Requests: n})
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "api_key_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"requests": gorm.Expr("api_key_usages.requests + excluded.requests")}),
+	}).Create(&usage).Error
+}
+
+// GetAPIKeyUsage returns the number of requests per key since the date.
+func (db *DB) GetAPIKeyUsage(since time.Time) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		APIKeyID uuid.UUID
+		Requests int64
+	}
+	if err := db.Model(&dmodels.APIKeyUsage{}).
+		Select("api_key_id, sum(requests) as requests").
+		Where(`date >= ?`, since).
+		Group("api_key_id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	usage := make(map[uuid.UUID]int64, len(rows))
+	for _, r := range rows {
+		usage[r.APIKeyID] = r.Requests
+	}
+	return usage, nil
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/jackc/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Postgres error codes of constraint violations reported by ApplyAudited.
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+var (
+	ErrorRecordInUse      = errors.New("record is referenced by other records")
+	ErrorRecordDuplicated = errors.New("record already exists")
+)
+
+type AuditLogCondition struct {
+	*Condition
+	Entity string
+}
+
+// AuditedEntity is an entity change ApplyAuditedBatch applies together with its audit log.
+type AuditedEntity struct {
+	Entity interface{}
+	Audit  *dmodels.AuditLog
+}
+
+// ApplyAudited creates, updates or deletes the entity according to audit.Action and saves the audit log in the same transaction.
+func (db *DB) ApplyAudited(entity interface{}, audit *dmodels.AuditLog) error {
+	return db.ApplyAuditedBatch(AuditedEntity{Entity: entity, Audit: audit})
+}
+
+// ApplyAuditedBatch applies the entities in order like ApplyAudited, all in one transaction.
+func (db *DB) ApplyAuditedBatch(entities ...AuditedEntity) error {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		tx = tx.Omit(clause.Associations)
+		for _, e := range entities {
+			if err := applyAudited(tx, e.Entity, e.Audit); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case foreignKeyViolation:
+			return fmt.Errorf("%w: %s", ErrorRecordInUse, pgErr.Detail)
+		case uniqueViolation:
+			return fmt.Errorf("%w: %s", ErrorRecordDuplicated, pgErr.Detail)
+		}
+	}
+	return err
+}
+
+func applyAudited(tx *gorm.DB, entity interface{}, audit *dmodels.AuditLog) error {
+	var err error
+	switch audit.Action {
+	case dmodels.AuditActionCreate:
+		err = tx.Create(entity).Error
+	case dmodels.AuditActionUpdate:
+		err = tx.Save(entity).Error
+	case dmodels.AuditActionDelete:
+		err = tx.Delete(entity).Error
+	default:
+		return fmt.Errorf("unknown audit action %s", audit.Action)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Create(audit).Error
+}
+
+func (db *DB) GetAuditLogs(cond *AuditLogCondition) ([]*dmodels.AuditLog, error) {
+	var logs []*dmodels.AuditLog
+	return logs, withAuditLogCondition(db.DB, cond).Order("created_at desc").Find(&logs).Error
+}
+
+func (db *DB) GetAuditLogsCount(cond *AuditLogCondition) (int64, error) {
+	var count int64
+	if err := withAuditLogCondition(db.DB, cond).Model(&dmodels.AuditLog{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func withAuditLogCondition(db *gorm.DB, cond *AuditLogCondition) *gorm.DB {
+	if cond == nil {
+		return db
+	}
+	db = withCond(db, cond.Condition)
+	if cond.Entity != "" {
+		db = db.Where(`entity = ?`, cond.Entity)
+	}
+	return db
+}
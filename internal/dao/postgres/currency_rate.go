@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"gorm.io/gorm"
+	"time"
+)
+
+func (db *DB) CreateCurrencyRates(rates ...*dmodels.CurrencyRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	return db.Create(&rates).Error
+}
+
+// GetCurrencyRateAt returns the last rate of the currency saved at or before t, or nil when there is none.
+func (db *DB) GetCurrencyRateAt(currency string, t time.Time) (*dmodels.CurrencyRate, error) {
+	rate := &dmodels.CurrencyRate{}
+	if err := db.Where(`currency = ?`, currency).
+		Where(`created_at <= ?`, t).
+		Order("created_at desc").First(rate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rate, nil
+}
+
+// GetCurrencyRatesBetween returns the rates of the currency saved between from and to, oldest first, together
+// with the last rate saved before from.
+func (db *DB) GetCurrencyRatesBetween(currency string, from time.Time, to time.Time) ([]*dmodels.CurrencyRate, error) {
+	var rates []*dmodels.CurrencyRate
+	if err := db.Raw(`(SELECT * FROM currency_rates WHERE currency = @currency AND created_at >= @from AND created_at <= @to)
+UNION ALL
+(SELECT * FROM currency_rates WHERE currency = @currency AND created_at < @from ORDER BY created_at DESC LIMIT 1)
+ORDER BY created_at`,
+		sql.Named("currency", currency), sql.Named("from", from), sql.Named("to", to)).
+		Scan(&rates).Error; err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// TouchDataVersions sets the change time of the types to the database clock.
+func (db *DB) TouchDataVersions(types ...string) error {
+	if len(types) == 0 {
+		return nil
+	}
+	versions := make([]*dmodels.DataVersion, len(types))
+	for i, t := range types {
+		versions[i] = &dmodels.DataVersion{Type: t}
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "type"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"changed_at": gorm.Expr("clock_timestamp()")}),
+	}).Create(&versions).Error
+}
+
+// GetDataVersion returns the last change time of any of the types, zero when none was recorded.
+func (db *DB) GetDataVersion(types []string) (time.Time, error) {
+	var changed *time.Time
+	if err := db.Model(&dmodels.DataVersion{}).
+		Select("max(changed_at)").
+		Where("type IN (?)", types).
+		Scan(&changed).Error; err != nil {
+		return time.Time{}, err
+	}
+	if changed == nil {
+		return time.Time{}, nil
+	}
+	return *changed, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/everstake/solana-pools/internal/dao/dmodels"
 	uuid "github.com/satori/go.uuid"
 	"gorm.io/gorm"
+	"time"
 )
 
 type DeFiCondition struct {
@@ -13,6 +14,11 @@ type DeFiCondition struct {
 	BuyCoinID        []uuid.UUID
 }
 
+type DeFiHistoryCondition struct {
+	DeFiCondition
+	From time.Time
+}
+
 func (db *DB) GetDEFIs(cond *DeFiCondition) ([]*dmodels.DEFI, error) {
 	var lp []*dmodels.DEFI
 	return lp, withDeFiCondition(db.DB, cond).Find(&lp).Error
@@ -29,6 +35,39 @@ func (db *DB) SaveDEFIs(defiData ...*dmodels.DEFI) error {
 	return db.Save(&defiData).Error
 }
 
+// ReplaceDEFIs swaps the current rows of the liquidity pool and appends the snapshot to the history in one transaction,
+// so readers never see the liquidity pool without DeFi data.
+func (db *DB) ReplaceDEFIs(liquidityPoolID uuid.UUID, defiData []*dmodels.DEFI, history []*dmodels.DEFIHistory) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("liquidity_pool_id = ?", liquidityPoolID).Delete(&dmodels.DEFI{}).Error; err != nil {
+			return err
+		}
+		if len(defiData) > 0 {
+			if err := tx.Create(&defiData).Error; err != nil {
+				return err
+			}
+		}
+		if len(history) > 0 {
+			if err := tx.Create(&history).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (db *DB) GetDEFIHistory(cond *DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+	var history []*dmodels.DEFIHistory
+	d := db.DB
+	if cond != nil {
+		d = withDeFiCondition(d, &cond.DeFiCondition)
+		if !cond.From.IsZero() {
+			d = d.Where("created_at >= ?", cond.From)
+		}
+	}
+	return history, d.Order("created_at").Find(&history).Error
+}
+
 func withDeFiCondition(db *gorm.DB, cond *DeFiCondition) *gorm.DB {
 	if cond == nil {
 		return db
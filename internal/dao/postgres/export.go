@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"gorm.io/gorm"
+	"time"
+)
+
+// ExportCondition filters exported rows; empty fields do not filter and the epoch and time bounds are inclusive.
+type ExportCondition struct {
+	Pools        []string
+	ValidatorIDs []string
+	FromEpoch    uint64
+	ToEpoch      uint64
+	From         time.Time
+	To           time.Time
+}
+
+// ExportPoolData calls fn for every pool snapshot in the order they were taken, reading them one by one;
+// the row passed to fn is reused by the next call. ValidatorIDs do not apply to pool snapshots.
+func (db *DB) ExportPoolData(cond *ExportCondition, fn func(*dmodels.PoolDataExport) error) error {
+	q := db.Table("pool_data").
+		Select("pools.name as pool, pool_data.epoch, pool_data.active_stake, pool_data.total_tokens_supply, pool_data.total_lamports, " +
+			"pool_data.apy, pool_data.unstake_liquidity, pool_data.depossit_fee, pool_data.withdrawal_fee, pool_data.rewards_fee, pool_data.created_at").
+		Joins("join pools on pools.id = pool_data.pool_id")
+	if cond != nil && len(cond.Pools) > 0 {
+		q = q.Where("pools.name in (?)", cond.Pools)
+	}
+	q = withExportRange(q, cond, "pool_data").Order("pool_data.created_at, pools.name")
+
+	var row dmodels.PoolDataExport
+	return exportRows(q, &row, func() error { return fn(&row) })
+}
+
+// ExportValidatorData calls fn for every epoch of the validators, ordered by epoch; Pools keep the validators
+// the pools delegated to in that epoch. The row passed to fn is reused by the next call.
+func (db *DB) ExportValidatorData(cond *ExportCondition, fn func(*dmodels.ValidatorDataExport) error) error {
+	q := db.Table("validator_data").
+		Select("validator_data.validator_id, validators.name, validator_data.epoch, validator_data.apy, validator_data.staking_accounts, " +
+			"validator_data.active_stake, validator_data.fee, validator_data.score, validator_data.skipped_slots, validator_data.created_at").
+		Joins("join validators on validators.id = validator_data.validator_id")
+	if cond != nil && len(cond.ValidatorIDs) > 0 {
+		q = q.Where("validator_data.validator_id in (?)", cond.ValidatorIDs)
+	}
+	if cond != nil && len(cond.Pools) > 0 {
+		q = q.Where(`validator_data.validator_id in (select pool_validator_data.validator_id from pool_validator_data
+			join pool_data on pool_data.id = pool_validator_data.pool_data_id
+			join pools on pools.id = pool_data.pool_id
+			where pools.name in (?) and pool_data.epoch = validator_data.epoch)`, cond.Pools)
+	}
+	q = withExportRange(q, cond, "validator_data").Order("validator_data.epoch, validator_data.validator_id")
+
+	var row dmodels.ValidatorDataExport
+	return exportRows(q, &row, func() error { return fn(&row) })
+}
+
+// ExportPoolValidatorData calls fn for the stake of every validator of every pool snapshot, in the order
+// the snapshots were taken; the row passed to fn is reused by the next call.
+func (db *DB) ExportPoolValidatorData(cond *ExportCondition, fn func(*dmodels.PoolValidatorDataExport) error) error {
+	q := db.Table("pool_validator_data").
+		Select("pools.name as pool, pool_data.epoch, pool_validator_data.validator_id, pool_validator_data.active_stake, pool_validator_data.created_at").
+		Joins("join pool_data on pool_data.id = pool_validator_data.pool_data_id").
+		Joins("join pools on pools.id = pool_data.pool_id")
+	if cond != nil && len(cond.Pools) > 0 {
+		q = q.Where("pools.name in (?)", cond.Pools)
+	}
+	if cond != nil && len(cond.ValidatorIDs) > 0 {
+		q = q.Where("pool_validator_data.validator_id in (?)", cond.ValidatorIDs)
+	}
+	q = withExportRange(q, cond, "pool_data").Order("pool_data.created_at, pools.name, pool_validator_data.validator_id")
+
+	var row dmodels.PoolValidatorDataExport
+	return exportRows(q, &row, func() error { return fn(&row) })
+}
+
+// withExportRange filters by the epoch and created_at columns of table.
+func withExportRange(db *gorm.DB, cond *ExportCondition, table string) *gorm.DB {
+	if cond == nil {
+		return db
+	}
+	if cond.FromEpoch > 0 {
+		db = db.Where(table+".epoch >= ?", cond.FromEpoch)
+	}
+	if cond.ToEpoch > 0 {
+		db = db.Where(table+".epoch <= ?", cond.ToEpoch)
+	}
+	if !cond.From.IsZero() {
+		db = db.Where(table+".created_at >= ?", cond.From)
+	}
+	if !cond.To.IsZero() {
+		db = db.Where(table+".created_at <= ?", cond.To)
+	}
+	return db
+}
+
+// exportRows scans the rows of the query into row one at a time, calling fn after each.
+func exportRows(db *gorm.DB, row interface{}, fn func() error) error {
+	rows, err := db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := db.ScanRows(rows, row); err != nil {
+			return err
+		}
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -28,6 +28,12 @@ func withGovernanceCondition(db *gorm.DB, cond *GovernanceCondition) *gorm.DB {
 		return db
 	}
 	db = withCond(db, cond.Condition)
+	if len(cond.GeckoKeys) > 0 {
+		db = db.Where(`gecko_key IN (?)`, cond.GeckoKeys)
+	}
+	if len(cond.ContractAddresses) > 0 {
+		db = db.Where(`contract_address IN (?)`, cond.ContractAddresses)
+	}
 
 	if cond.Sort != nil {
 		db = sortGovernance(db, cond.Sort.Sort, cond.Sort.Desc)
@@ -37,33 +43,36 @@ func withGovernanceCondition(db *gorm.DB, cond *GovernanceCondition) *gorm.DB {
 }
 
 func sortGovernance(db *gorm.DB, sort GovernanceSortType, desc bool) *gorm.DB {
-
+	var column string
 	switch sort {
 	case GovernanceName:
-		db = db.Select("governances.*")
-		return db.Clauses(clause.OrderBy{
-			Columns: []clause.OrderByColumn{
-				{
-					Column: clause.Column{
-						Name: "governances.name",
-					},
-					Desc: desc,
-				},
-			},
-		})
+		column = "governances.name"
 	case GovernancePrice:
-		db = db.Select("governances.*")
-		return db.Clauses(clause.OrderBy{
-			Columns: []clause.OrderByColumn{
-				{
-					Column: clause.Column{
-						Name: "governances.usd",
-					},
-					Desc: desc,
-				},
-			},
-		})
+		column = "governances.usd"
+	case GovernanceMarketCap:
+		column = "governances.circulating_supply * governances.usd"
+	case GovernanceFDV:
+		column = "coalesce(nullif(governances.maximum_token_supply, 0), governances.total_supply) * governances.usd"
+	case GovernanceCirculatingSupply:
+		column = "governances.circulating_supply"
+	case GovernanceInflation:
+		column = "governances.inflation_rate"
+	case GovernanceHolders:
+		column = "governances.holders"
+	default:
+		return db
 	}
 
-	return db
+	db = db.Select("governances.*")
+	return db.Clauses(clause.OrderBy{
+		Columns: []clause.OrderByColumn{
+			{
+				Column: clause.Column{
+					Name: column,
+					Raw:  true,
+				},
+				Desc: desc,
+			},
+		},
+	})
 }
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+func (db *DB) CreateGovernanceSupply(supply ...*dmodels.GovernanceSupply) error {
+	if len(supply) == 0 {
+		return nil
+	}
+	return db.Create(&supply).Error
+}
+
+// GetGovernanceSupplyHistory returns the last supply snapshot of every day (week for quarter and half-year, month for year) within the aggregate period.
+func (db *DB) GetGovernanceSupplyHistory(governanceID uuid.UUID, aggregate Aggregate) ([]*dmodels.GovernanceSupply, error) {
+	return db.GetGovernanceSuppliesHistory([]uuid.UUID{governanceID}, aggregate)
+}
+
+// GetGovernanceSuppliesHistory returns the aggregated supply history of all the governance tokens, ordered by time.
+func (db *DB) GetGovernanceSuppliesHistory(governanceIDs []uuid.UUID, aggregate Aggregate) ([]*dmodels.GovernanceSupply, error) {
+	from, bucket := aggregateBucket(aggregate)
+
+	var history []*dmodels.GovernanceSupply
+	if err := db.Table("governance_supplies").
+		Where(`governance_id IN (?)`, governanceIDs).
+		Where(`created_at >= ?`, from).
+		Where(`created_at = (SELECT max(t1.created_at) FROM governance_supplies t1 WHERE t1.governance_id = governance_supplies.governance_id AND date_trunc(?, t1.created_at) = date_trunc(?, governance_supplies.created_at))`, bucket, bucket).
+		Order("created_at").Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// GetGovernanceSupplyAt returns the last supply snapshot saved at or before t, or nil when there is none.
+func (db *DB) GetGovernanceSupplyAt(governanceID uuid.UUID, t time.Time) (*dmodels.GovernanceSupply, error) {
+	supply := &dmodels.GovernanceSupply{}
+	if err := db.Where(`governance_id = ?`, governanceID).
+		Where(`created_at <= ?`, t).
+		Order("created_at desc").First(supply).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return supply, nil
+}
@@ -28,3 +28,7 @@ func (db *DB) GetLiquidityPool(cond *Condition) (*dmodels.LiquidityPool, error)
 	}
 	return pool, nil
 }
+
+func (db *DB) UpdateLiquidityPoolStatus(pool *dmodels.LiquidityPool) error {
+	return db.DB.Model(pool).Select("status", "error", "synced_at").Updates(pool).Error
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+// SavePoolExchangeRate stores the rate for the pool's epoch, replacing an earlier observation of the same epoch.
+func (db *DB) SavePoolExchangeRate(rate *dmodels.PoolExchangeRate) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "pool_id"}, {Name: "epoch"}},
+		DoUpdates: clause.AssignmentColumns([]string{"total_tokens_supply", "total_lamports", "rate", "updated_at"}),
+	}).Create(rate).Error
+}
+
+// GetPoolExchangeRate returns the latest rate recorded at or before the epoch.
+func (db *DB) GetPoolExchangeRate(poolID uuid.UUID, epoch uint64) (*dmodels.PoolExchangeRate, error) {
+	rate := &dmodels.PoolExchangeRate{}
+	if err := db.Where(`pool_id = ?`, poolID).Where(`epoch <= ?`, epoch).
+		Order("epoch desc").First(rate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return rate, nil
+}
+
+// GetPoolsExchangeRateWindows returns, for each pool, the latest rate recorded at or before the pool's epoch and the
+// latest rates at or before each epoch window and each day window back from that rate. Rows can repeat; the latest of
+// them at or before a window start is the rate of the window.
+func (db *DB) GetPoolsExchangeRateWindows(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error) {
+	var rates []*dmodels.PoolExchangeRate
+	if len(epochs) == 0 {
+		return rates, nil
+	}
+
+	pools := make([]string, 0, len(epochs))
+	args := make([]interface{}, 0, 2*len(epochs)+len(epochWindows)+len(dayWindows))
+	for id, epoch := range epochs {
+		pools = append(pools, "(?::uuid, ?::int8)")
+		args = append(args, id, epoch)
+	}
+	query := fmt.Sprintf(`WITH pools (pool_id, epoch) AS (VALUES %s),
+	cur AS (SELECT DISTINCT ON (r.pool_id) r.*
+		FROM pool_exchange_rates r
+		JOIN pools p ON p.pool_id = r.pool_id AND r.epoch <= p.epoch
+		ORDER BY r.pool_id, r.epoch DESC)
+	SELECT * FROM cur`, strings.Join(pools, ", "))
+	if len(epochWindows) != 0 {
+		query += fmt.Sprintf(`
+	UNION ALL
+	SELECT w.* FROM cur
+		CROSS JOIN (VALUES %s) AS windows (epochs)
+		JOIN LATERAL (SELECT * FROM pool_exchange_rates r
+			WHERE r.pool_id = cur.pool_id AND r.epoch <= cur.epoch - windows.epochs
+			ORDER BY r.epoch DESC LIMIT 1) w ON true`, values(len(epochWindows), "int8"))
+		for _, w := range epochWindows {
+			args = append(args, w)
+		}
+	}
+	if len(dayWindows) != 0 {
+		query += fmt.Sprintf(`
+	UNION ALL
+	SELECT w.* FROM cur
+		CROSS JOIN (VALUES %s) AS windows (days)
+		JOIN LATERAL (SELECT * FROM pool_exchange_rates r
+			WHERE r.pool_id = cur.pool_id AND r.updated_at <= cur.updated_at - windows.days * interval '1 day'
+			ORDER BY r.updated_at DESC LIMIT 1) w ON true`, values(len(dayWindows), "int8"))
+		for _, w := range dayWindows {
+			args = append(args, w)
+		}
+	}
+
+	if err := db.Raw(query, args...).Scan(&rates).Error; err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// values is a VALUES list of n rows of one column of the type.
+func values(n int, typ string) string {
+	rows := make([]string, n)
+	for i := range rows {
+		rows[i] = fmt.Sprintf("(?::%s)", typ)
+	}
+	return strings.Join(rows, ", ")
+}
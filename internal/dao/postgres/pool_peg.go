@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	uuid "github.com/satori/go.uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+func (db *DB) CreatePoolPeg(pegs ...*dmodels.PoolPeg) error {
+	if len(pegs) == 0 {
+		return nil
+	}
+	return db.Create(&pegs).Error
+}
+
+func (db *DB) GetLastPoolPeg(poolID uuid.UUID) (*dmodels.PoolPeg, error) {
+	peg := &dmodels.PoolPeg{}
+	if err := db.Where(`pool_id = ?`, poolID).Order("created_at desc").First(peg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return peg, nil
+}
+
+// GetPoolPegs returns the last peg of every day (week for quarter and half-year, month for year) within the aggregate period.
+func (db *DB) GetPoolPegs(poolID uuid.UUID, aggregate Aggregate) ([]*dmodels.PoolPeg, error) {
+	from, bucket := aggregateBucket(aggregate)
+	var pegs []*dmodels.PoolPeg
+	if err := db.Table("pool_pegs").
+		Where(`pool_id = ?`, poolID).
+		Where(`created_at >= ?`, from).
+		Where(`created_at = (SELECT max(t1.created_at) FROM pool_pegs t1 WHERE t1.pool_id = pool_pegs.pool_id AND date_trunc(?, t1.created_at) = date_trunc(?, pool_pegs.created_at))`, bucket, bucket).
+		Order("created_at").Find(&pegs).Error; err != nil {
+		return nil, err
+	}
+	return pegs, nil
+}
+
+// aggregateBucket returns the start of the aggregate period and the date_trunc field its points are bucketed by.
+func aggregateBucket(aggregate Aggregate) (time.Time, string) {
+	switch aggregate {
+	case Month:
+		return time.Now().AddDate(0, -1, 0), "day"
+	case Quarter:
+		return time.Now().AddDate(0, -3, 0), "week"
+	case HalfYear:
+		return time.Now().AddDate(0, -6, 0), "week"
+	case Year:
+		return time.Now().AddDate(-1, 0, 0), "month"
+	default:
+		return time.Now().AddDate(0, 0, -7), "day"
+	}
+}
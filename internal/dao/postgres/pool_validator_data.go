@@ -28,6 +28,25 @@ func (db *DB) GetValidatorDataCount(condition *PoolValidatorDataCondition, epoch
 	return i, withPoolValidatorDataCondition(db.DB.Model(&dmodels.PoolValidatorData{}), condition, epoch).Count(&i).Error
 }
 
+// GetValidatorDataCounts returns the number of validators of every pool data.
+func (db *DB) GetValidatorDataCounts(poolDataIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		PoolDataID uuid.UUID
+		Count      int64
+	}
+	if err := db.Model(&dmodels.PoolValidatorData{}).
+		Select("pool_data_id, count(*) as count").
+		Where("pool_data_id in (?)", poolDataIDs).
+		Group("pool_data_id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, r := range rows {
+		counts[r.PoolDataID] = r.Count
+	}
+	return counts, nil
+}
+
 func withPoolValidatorDataCondition(db *gorm.DB, condition *PoolValidatorDataCondition, epoch uint64) *gorm.DB {
 	if condition == nil {
 		return db
@@ -42,14 +61,28 @@ func withPoolValidatorDataCondition(db *gorm.DB, condition *PoolValidatorDataCon
 		db = db.Where("validator_id in (?)", condition.ValidatorIDs)
 	}
 
-	if condition.Sort != nil {
-		if epoch == 10 {
+	if condition.Sort != nil || condition.Filter != nil {
+		if condition.Snapshot != nil {
+			query, args := validatorSnapshot(condition.Snapshot, epoch)
+			db = db.Joins("join "+query+" on validators.id = pool_validator_data.validator_id", args...)
+		} else if epoch == 10 {
 			db = db.Joins("join material_validator_data_view as validators on validators.id = pool_validator_data.validator_id")
 		} else {
 			db = db.Joins("join validator_view_current_data as validators on validators.id = pool_validator_data.validator_id")
 		}
 
 		db = db.Select("pool_validator_data.id, pool_validator_data.pool_data_id, pool_validator_data.validator_id, pool_validator_data.active_stake, pool_validator_data.created_at, pool_validator_data.updated_at")
+		db = withValidatorFilter(db, condition.Filter, condition.Snapshot)
+		if condition.Sort == nil {
+			return db
+		}
+		if condition.Snapshot != nil {
+			column, ok := validatorDataSortColumns[condition.Sort.ValidatorDataSort]
+			if !ok {
+				column = validatorDataSortColumns[ValidatorDataAPY]
+			}
+			return withKeyset(db, column, condition.Sort.Desc, condition.Keyset)
+		}
 		return sortValidators(db, condition.Sort.ValidatorDataSort, condition.Sort.Desc)
 	}
 
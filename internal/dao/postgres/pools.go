@@ -56,6 +56,21 @@ func (db *DB) GetLastPoolData(PoolID uuid.UUID) (*dmodels.PoolData, error) {
 	return pool, nil
 }
 
+// GetLastPoolsData returns the latest pool data of each of the pools.
+func (db *DB) GetLastPoolsData(poolIDs []uuid.UUID) ([]*dmodels.PoolData, error) {
+	var data []*dmodels.PoolData
+	if len(poolIDs) == 0 {
+		return data, nil
+	}
+	if err := db.DB.Table("pool_data").Select("DISTINCT ON (pool_id) *").
+		Where("pool_id IN (?)", poolIDs).
+		Order("pool_id, created_at desc").Find(&data).Error; err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
 func (db *DB) GetLastEpochPoolData(PoolID uuid.UUID, currentEpoch uint64) (*dmodels.PoolData, error) {
 	pool := &dmodels.PoolData{}
 	if err := db.Table("pool_data_view as pool_data").Where(`pool_id = ?`, PoolID).
@@ -70,20 +85,17 @@ func (db *DB) GetLastEpochPoolData(PoolID uuid.UUID, currentEpoch uint64) (*dmod
 }
 
 func (db *DB) GetPoolStatistic(PoolID uuid.UUID, aggregate Aggregate) ([]*dmodels.PoolData, error) {
+	return db.GetPoolsStatistic([]uuid.UUID{PoolID}, aggregate)
+}
+
+// GetPoolsStatistic returns the aggregated data of all the pools, ordered by time.
+func (db *DB) GetPoolsStatistic(poolIDs []uuid.UUID, aggregate Aggregate) ([]*dmodels.PoolData, error) {
 	var data []*dmodels.PoolData
 	w, err := aggregateByDate(aggregate, db.DB.Table("pool_data_view as pool_data"))
 	if err != nil {
 		return nil, err
 	}
-	if err := w.Where(`pool_id = ?`, PoolID).
-		Order("created_at").Find(&data).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, nil
-		}
-		return nil, err
-	}
-
-	return data, nil
+	return data, w.Where(`pool_id IN (?)`, poolIDs).Order("created_at").Find(&data).Error
 }
 
 func withPoolCondition(db *gorm.DB, condition *PoolCondition) *gorm.DB {
@@ -27,7 +27,17 @@ var autoMigrateModels = []interface{}{
 	&dmodels.Governance{},
 	&dmodels.LiquidityPool{},
 	&dmodels.DEFI{},
+	&dmodels.DEFIHistory{},
 	&dmodels.SlotTime{},
+	&dmodels.PoolExchangeRate{},
+	&dmodels.PoolPeg{},
+	&dmodels.PriceHistory{},
+	&dmodels.CurrencyRate{},
+	&dmodels.GovernanceSupply{},
+	&dmodels.AuditLog{},
+	&dmodels.APIKey{},
+	&dmodels.APIKeyUsage{},
+	&dmodels.DataVersion{},
 }
 
 func NewDB(dsn string) (db *DB, err error) {
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+func (db *DB) CreatePriceHistory(history ...*dmodels.PriceHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+	return db.Create(&history).Error
+}
+
+// GetPriceHistory returns the last price of every day (week for quarter and half-year, month for year) within the aggregate period.
+func (db *DB) GetPriceHistory(assetID uuid.UUID, aggregate Aggregate) ([]*dmodels.PriceHistory, error) {
+	from, bucket := aggregateBucket(aggregate)
+
+	var history []*dmodels.PriceHistory
+	if err := db.Table("price_histories").
+		Where(`asset_id = ?`, assetID).
+		Where(`created_at >= ?`, from).
+		Where(`created_at = (SELECT max(t1.created_at) FROM price_histories t1 WHERE t1.asset_id = price_histories.asset_id AND date_trunc(?, t1.created_at) = date_trunc(?, price_histories.created_at))`, bucket, bucket).
+		Order("created_at").Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// GetPricesAt returns the last price of every asset saved at or before every point, oldest first; a point
+// without an earlier price of an asset has none.
+func (db *DB) GetPricesAt(assetIDs []uuid.UUID, points []time.Time) ([]*dmodels.PriceHistory, error) {
+	var history []*dmodels.PriceHistory
+	if len(assetIDs) == 0 || len(points) == 0 {
+		return history, nil
+	}
+	args := make([]interface{}, 0, len(points)+len(assetIDs))
+	for _, p := range points {
+		args = append(args, p)
+	}
+	for _, id := range assetIDs {
+		args = append(args, id)
+	}
+	// one index lookup per asset and point instead of reading every price of the range
+	if err := db.Raw(fmt.Sprintf(`SELECT DISTINCT p.*
+	FROM (VALUES %s) AS points (at)
+	CROSS JOIN (VALUES %s) AS assets (id)
+	JOIN LATERAL (SELECT * FROM price_histories
+		WHERE asset_id = assets.id AND created_at <= points.at
+		ORDER BY created_at DESC LIMIT 1) p ON true
+	ORDER BY p.created_at`, values(len(points), "timestamptz"), values(len(assetIDs), "uuid")), args...).
+		Scan(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"strings"
+)
+
+// SearchCondition searches Query in the entities of Types, all of them when empty.
+type SearchCondition struct {
+	Query string
+	Types []string
+	Limit uint64
+}
+
+type searchSource struct {
+	Type    string
+	Table   string
+	Key     string
+	Name    string
+	Address string
+	Image   string
+	Where   string
+	Fields  []string
+}
+
+// searchSources are the searched tables, with the fields matched against the query; every field has a trigram index.
+var searchSources = []searchSource{
+	{Type: dmodels.SearchPool, Table: "pools", Key: "name", Name: "name", Address: "address", Image: "image", Where: "active",
+		Fields: []string{"name", "address"}},
+	{Type: dmodels.SearchValidator, Table: "validators", Key: "id", Name: "name", Address: "id", Image: "image",
+		Fields: []string{"name", "id", "node_pk"}},
+	{Type: dmodels.SearchCoin, Table: "coins", Key: "name", Name: "name", Address: "address", Image: "thumb_image",
+		Fields: []string{"name", "address", "gecko_key"}},
+	{Type: dmodels.SearchGovernance, Table: "governances", Key: "name", Name: "name", Address: "contract_address", Image: "image",
+		Fields: []string{"name", "symbol", "contract_address"}},
+	{Type: dmodels.SearchLiquidityPool, Table: "liquidity_pools", Key: "name", Name: "name", Address: "''", Image: "image",
+		Fields: []string{"name"}},
+}
+
+// Search finds the entities with a field equal to, starting with, containing or similar to the query, best matches
+// first: exact matches score 1, prefixes from 0.75, substrings from 0.5 and trigram similar fields up to 0.5.
+func (db *DB) Search(cond *SearchCondition) ([]*dmodels.SearchResult, error) {
+	queries := make([]string, 0, len(searchSources))
+	for _, s := range searchSources {
+		if len(cond.Types) > 0 && !contains(cond.Types, s.Type) {
+			continue
+		}
+		queries = append(queries, s.query())
+	}
+	results := make([]*dmodels.SearchResult, 0)
+	if len(queries) == 0 {
+		return results, nil
+	}
+
+	like := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(cond.Query))
+	return results, db.Raw(
+		"SELECT * FROM ("+strings.Join(queries, " UNION ALL ")+") results ORDER BY score DESC, name LIMIT @limit",
+		sql.Named("q", strings.ToLower(cond.Query)),
+		sql.Named("prefix", like+"%"),
+		sql.Named("contains", "%"+like+"%"),
+		sql.Named("limit", cond.Limit),
+	).Scan(&results).Error
+}
+
+func (s searchSource) query() string {
+	scores := make([]string, len(s.Fields))
+	matches := make([]string, len(s.Fields))
+	for i, f := range s.Fields {
+		scores[i] = fmt.Sprintf(`CASE WHEN lower(%[1]s) = @q THEN 1
+			WHEN lower(%[1]s) LIKE @prefix THEN 0.75 + 0.25 * similarity(%[1]s, @q)
+			WHEN lower(%[1]s) LIKE @contains THEN 0.5 + 0.25 * similarity(%[1]s, @q)
+			ELSE 0.5 * similarity(%[1]s, @q) END`, f)
+		matches[i] = fmt.Sprintf("%[1]s ILIKE @contains OR %[1]s %% @q", f)
+	}
+	where := "(" + strings.Join(matches, " OR ") + ")"
+	if s.Where != "" {
+		where = s.Where + " AND " + where
+	}
+	return fmt.Sprintf("SELECT '%s' AS type, %s AS key, %s AS name, %s AS address, %s AS image, GREATEST(%s) AS score FROM %s WHERE %s",
+		s.Type, s.Key, s.Name, s.Address, s.Image, strings.Join(scores, ", "), s.Table, where)
+}
+
+func contains(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"math"
+	"strconv"
+)
+
+// ErrorInvalidKeyset is returned for a keyset whose value is not of the type of the sort column.
+var ErrorInvalidKeyset = errors.New("keyset value does not match the sort column")
+
+type sortColumn struct {
+	Name string
+	Type string
+}
+
+var (
+	validatorSortColumns = map[ValidatorSortType]sortColumn{
+		ValidatorAPY:         {Name: "validators.apy", Type: "numeric"},
+		ValidatorStake:       {Name: "validators.active_stake", Type: "int8"},
+		ValidatorFee:         {Name: "validators.fee", Type: "numeric"},
+		ValidatorScore:       {Name: "validators.score", Type: "int8"},
+		ValidatorSkippedSlot: {Name: "validators.skipped_slots", Type: "numeric"},
+		ValidatorDataCenter:  {Name: "validators.data_center", Type: "text"},
+		StakingAccounts:      {Name: "validators.staking_accounts", Type: "int8"},
+	}
+	validatorDataSortColumns = map[ValidatorDataSortType]sortColumn{
+		ValidatorDataAPY:         {Name: "validators.apy", Type: "numeric"},
+		ValidatorDataPoolStake:   {Name: "pool_validator_data.active_stake", Type: "int8"},
+		ValidatorDataStake:       {Name: "validators.active_stake", Type: "int8"},
+		ValidatorDataFee:         {Name: "validators.fee", Type: "numeric"},
+		ValidatorDataScore:       {Name: "validators.score", Type: "int8"},
+		ValidatorDataSkippedSlot: {Name: "validators.skipped_slots", Type: "numeric"},
+		ValidatorDataDataCenter:  {Name: "validators.data_center", Type: "text"},
+	}
+)
+
+// validatorSnapshot is a subquery of the validators like the validator views showed them at the snapshot: the last
+// data of every validator, with the APY, score and skipped slots averaged over ten epochs for the epoch 10 aggregation.
+func validatorSnapshot(s *Snapshot, epoch uint64) (string, []interface{}) {
+	maxEpoch := uint64(math.MaxInt64)
+	if s.Epoch > 0 {
+		maxEpoch = s.Epoch
+	}
+	args := []interface{}{maxEpoch, s.At}
+
+	stats, join := "vd.apy, vd.score, vd.skipped_slots", ""
+	if epoch == 10 {
+		stats = "avgs.apy, avgs.score, avgs.skipped_slots"
+		// the columns are aliased without AS, gorm takes the table name from the first AS it finds
+		join = `JOIN LATERAL (SELECT avg(d.apy)::numeric(8, 4) apy, avg(d.score)::int8 score, avg(d.skipped_slots)::numeric(5, 4) skipped_slots
+		FROM validator_data d
+		WHERE d.validator_id = vd.validator_id AND d.epoch BETWEEN vd.epoch - 9 AND vd.epoch AND d.created_at <= ?) avgs ON true`
+		args = append(args, s.At)
+	}
+
+	return fmt.Sprintf(`(SELECT v.id, v.image, v.name, v.delinquent, v.node_pk, vd.staking_accounts, vd.active_stake, vd.fee,
+		%s, v.data_center, vd.epoch, v.created_at, v.updated_at
+	FROM (SELECT DISTINCT ON (validator_id) *
+		FROM validator_data
+		WHERE epoch <= ? AND created_at <= ?
+		ORDER BY validator_id, created_at DESC) vd
+	JOIN validators v ON v.id = vd.validator_id
+	%s) AS validators`, stats, join), args
+}
+
+// validatorsTable selects the validators from the snapshot, or from the view of the epoch aggregation without one.
+func validatorsTable(db *gorm.DB, s *Snapshot, epoch uint64) *gorm.DB {
+	if s != nil {
+		query, args := validatorSnapshot(s, epoch)
+		return db.Table(query, args...)
+	}
+	if epoch == 10 {
+		return db.Table("public.material_validator_data_view as validators")
+	}
+	return db.Table("public.validator_view_current_data as validators")
+}
+
+// withKeyset orders a snapshot listing by the column and the validator id and starts it at the keyset.
+func withKeyset(db *gorm.DB, column sortColumn, desc bool, keyset *Keyset) *gorm.DB {
+	if keyset != nil {
+		if !column.valid(keyset.Value) {
+			_ = db.AddError(fmt.Errorf("%w: %s %q", ErrorInvalidKeyset, column.Name, keyset.Value))
+			return db
+		}
+		if keyset.Before {
+			desc = !desc
+		}
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		db = db.Where(fmt.Sprintf("(%s, validators.id) %s (?::%s, ?)", column.Name, op, column.Type), keyset.Value, keyset.ID)
+	}
+	return db.Clauses(clause.OrderBy{
+		Columns: []clause.OrderByColumn{
+			{Column: clause.Column{Name: column.Name}, Desc: desc},
+			{Column: clause.Column{Name: "validators.id"}, Desc: desc},
+		},
+	})
+}
+
+// valid reports whether value casts to the column type, so a tampered keyset fails before the query does.
+func (c sortColumn) valid(value string) bool {
+	switch c.Type {
+	case "numeric":
+		_, err := decimal.NewFromString(value)
+		return err == nil
+	case "int8":
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	}
+	return true
+}
@@ -3,7 +3,9 @@ package postgres
 import (
 	"errors"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"time"
 )
 
 type Network string
@@ -21,6 +23,14 @@ type ValidatorCondition struct {
 	PoolDataIDs  []uuid.UUID
 	ValidatorIDs []string
 	Sort         *ValidatorSort
+	Filter       *ValidatorFilter
+	Snapshot     *Snapshot
+	Keyset       *Keyset
+}
+
+type ValidatorDataCondition struct {
+	*Condition
+	ValidatorIDs []string
 }
 
 type PoolValidatorDataCondition struct {
@@ -28,6 +38,42 @@ type PoolValidatorDataCondition struct {
 	PoolDataIDs  []uuid.UUID
 	ValidatorIDs []string
 	Sort         *ValidatorDataSort
+	Filter       *ValidatorFilter
+	Snapshot     *Snapshot
+	Keyset       *Keyset
+}
+
+// ValidatorFilter narrows validator listings by the validator columns; nil and empty fields don't filter, the
+// bounds are inclusive and stakes are in lamports. DelegatedBy and Undelegated look at the last data of the active
+// pools, the last before the snapshot in snapshot listings.
+type ValidatorFilter struct {
+	MinFee          *decimal.Decimal
+	MaxFee          *decimal.Decimal
+	MinAPY          *decimal.Decimal
+	MaxAPY          *decimal.Decimal
+	MinScore        *int64
+	MaxSkippedSlots *decimal.Decimal
+	Delinquent      *bool
+	DataCenters     []string
+	MinStake        *uint64
+	MaxStake        *uint64
+	DelegatedBy     []string
+	Undelegated     bool
+}
+
+// Snapshot reads validators as they were at At, with the data of epochs up to Epoch, or of all epochs when zero.
+// Snapshot listings are ordered by the sort column and the validator id.
+type Snapshot struct {
+	Epoch uint64
+	At    time.Time
+}
+
+// Keyset continues a snapshot listing after the row with the sort value Value and validator ID, or before it
+// with Before, in which case the rows come in reverse order.
+type Keyset struct {
+	Value  string
+	ID     string
+	Before bool
 }
 
 type CoinCondition struct {
@@ -213,7 +259,9 @@ func SearchAggregate(name string) Aggregate {
 
 type GovernanceCondition struct {
 	*Condition
-	Sort *GovernanceSort
+	GeckoKeys         []string
+	ContractAddresses []string
+	Sort              *GovernanceSort
 }
 
 type GovernanceSort struct {
@@ -226,12 +274,27 @@ type GovernanceSortType int
 const (
 	GovernanceName = GovernanceSortType(iota)
 	GovernancePrice
+	GovernanceMarketCap
+	GovernanceFDV
+	GovernanceCirculatingSupply
+	GovernanceInflation
+	GovernanceHolders
 )
 
 func SearchGovernanceSort(sort string) GovernanceSortType {
 	switch sort {
 	case "price":
 		return GovernancePrice
+	case "market_cap":
+		return GovernanceMarketCap
+	case "fdv":
+		return GovernanceFDV
+	case "circulating_supply":
+		return GovernanceCirculatingSupply
+	case "inflation":
+		return GovernanceInflation
+	case "holders":
+		return GovernanceHolders
 	default:
 		return GovernanceName
 	}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// withValidatorFilter filters the rows of the validators table, view or snapshot joined as validators.
+func withValidatorFilter(db *gorm.DB, f *ValidatorFilter, s *Snapshot) *gorm.DB {
+	if f == nil {
+		return db
+	}
+
+	if f.MinFee != nil {
+		db = db.Where("validators.fee >= ?", *f.MinFee)
+	}
+	if f.MaxFee != nil {
+		db = db.Where("validators.fee <= ?", *f.MaxFee)
+	}
+	if f.MinAPY != nil {
+		db = db.Where("validators.apy >= ?", *f.MinAPY)
+	}
+	if f.MaxAPY != nil {
+		db = db.Where("validators.apy <= ?", *f.MaxAPY)
+	}
+	if f.MinScore != nil {
+		db = db.Where("validators.score >= ?", *f.MinScore)
+	}
+	if f.MaxSkippedSlots != nil {
+		db = db.Where("validators.skipped_slots <= ?", *f.MaxSkippedSlots)
+	}
+	if f.Delinquent != nil {
+		db = db.Where("validators.delinquent = ?", *f.Delinquent)
+	}
+	if len(f.DataCenters) > 0 {
+		db = db.Where("validators.data_center IN (?)", f.DataCenters)
+	}
+	if f.MinStake != nil {
+		db = db.Where("validators.active_stake >= ?", *f.MinStake)
+	}
+	if f.MaxStake != nil {
+		db = db.Where("validators.active_stake <= ?", *f.MaxStake)
+	}
+
+	at := time.Now()
+	if s != nil {
+		at = s.At
+	}
+	if len(f.DelegatedBy) > 0 {
+		db = db.Where(`validators.id IN (`+delegatedValidators+` AND p.name IN (?))`, at, f.DelegatedBy)
+	}
+	if f.Undelegated {
+		db = db.Where(`validators.id NOT IN (`+delegatedValidators+`)`, at)
+	}
+
+	return db
+}
+
+// delegatedValidators selects the validators in the last data of the active pools created up to a time.
+const delegatedValidators = `SELECT pvd.validator_id
+	FROM pool_validator_data pvd
+	JOIN pool_data pd ON pd.id = pvd.pool_data_id
+	JOIN pools p ON p.id = pd.pool_id
+	WHERE p.active AND pd.created_at = (SELECT max(created_at) FROM pool_data WHERE pool_id = p.id AND created_at <= ?)`
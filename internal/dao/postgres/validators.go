@@ -16,6 +16,22 @@ func (db *DB) GetValidatorByVotePK(key solana.PublicKey) (*dmodels.ValidatorView
 	return validator, err
 }
 
+// GetValidatorsDelinquency returns the stored delinquent flag of every validator by vote account.
+func (db *DB) GetValidatorsDelinquency() (map[string]bool, error) {
+	var rows []struct {
+		ID         string
+		Delinquent bool
+	}
+	if err := db.Model(&dmodels.Validator{}).Select("id, delinquent").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	delinquency := make(map[string]bool, len(rows))
+	for _, r := range rows {
+		delinquency[r.ID] = r.Delinquent
+	}
+	return delinquency, nil
+}
+
 func (db *DB) GetValidator(validatorID string, epoch uint64) (*dmodels.ValidatorView, error) {
 	validator := &dmodels.ValidatorView{}
 	DB := db.Table("public.validator_view_current_data as validators")
@@ -31,20 +47,20 @@ func (db *DB) GetValidator(validatorID string, epoch uint64) (*dmodels.Validator
 
 func (db *DB) GetValidators(condition *ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error) {
 	validators := make([]*dmodels.ValidatorView, 0)
-	DB := db.Table("public.validator_view_current_data as validators")
-	if epoch == 10 {
-		DB = db.Table("public.material_validator_data_view as validators")
+	var snapshot *Snapshot
+	if condition != nil {
+		snapshot = condition.Snapshot
 	}
-	return validators, withValidatorCondition(DB, condition).Select("validators.*").Find(&validators).Error
+	return validators, withValidatorCondition(validatorsTable(db.DB, snapshot, epoch), condition).Select("validators.*").Find(&validators).Error
 }
 
 func (db *DB) GetValidatorCount(condition *ValidatorCondition, epoch uint64) (int64, error) {
 	i := int64(0)
-	DB := db.Table("public.validator_view_current_data as validators")
-	if epoch == 10 {
-		DB = db.Table("public.material_validator_data_view as validators")
+	var snapshot *Snapshot
+	if condition != nil {
+		snapshot = condition.Snapshot
 	}
-	return i, withValidatorCondition(DB, condition).Count(&i).Error
+	return i, withValidatorCondition(validatorsTable(db.DB, snapshot, epoch), condition).Count(&i).Error
 }
 
 func withValidatorCondition(db *gorm.DB, condition *ValidatorCondition) *gorm.DB {
@@ -74,7 +90,16 @@ func withValidatorCondition(db *gorm.DB, condition *ValidatorCondition) *gorm.DB
 
 	db = withCond(db, condition.Condition)
 
+	db = withValidatorFilter(db, condition.Filter, condition.Snapshot)
+
 	if condition.Sort != nil {
+		if condition.Snapshot != nil {
+			column, ok := validatorSortColumns[condition.Sort.ValidatorSort]
+			if !ok {
+				column = validatorSortColumns[ValidatorAPY]
+			}
+			return withKeyset(db, column, condition.Sort.Desc, condition.Keyset)
+		}
 		return sortValidator(db, condition.Sort.ValidatorSort, condition.Sort.Desc)
 	}
 
@@ -171,3 +196,24 @@ func (db *DB) UpdateValidators(validators ...*dmodels.Validator) error {
 func (db *DB) UpdateValidatorsData(data ...*dmodels.ValidatorData) error {
 	return db.Save(&data).Error
 }
+
+func (db *DB) GetValidatorData(condition *ValidatorDataCondition) ([]*dmodels.ValidatorData, error) {
+	var data []*dmodels.ValidatorData
+	return data, withValidatorDataCondition(db.DB, condition).Order("epoch desc").Find(&data).Error
+}
+
+func withValidatorDataCondition(db *gorm.DB, condition *ValidatorDataCondition) *gorm.DB {
+	if condition == nil {
+		return db
+	}
+
+	if len(condition.ValidatorIDs) > 0 {
+		db = db.Where(`validator_id in (?)`, condition.ValidatorIDs)
+	}
+
+	if condition.Condition != nil && len(condition.Condition.Epochs) > 0 {
+		db = db.Where(`epoch in (?)`, condition.Condition.Epochs)
+	}
+
+	return withCond(db, condition.Condition)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/everstake/solana-pools/internal/dao/postgres"
 	uuid "github.com/satori/go.uuid"
 	"sync"
+	"time"
 )
 
 // Ensure, that PostgresMock does implement Postgres.
@@ -21,9 +22,30 @@ var _ Postgres = &PostgresMock{}
 //
 // 		// make and configure a mocked Postgres
 // 		mockedPostgres := &PostgresMock{
+// 			AddAPIKeyUsageFunc: func(date time.Time, requests map[uuid.UUID]int64) error {
+// 				panic("mock out the AddAPIKeyUsage method")
+// 			},
+// 			ApplyAuditedFunc: func(entity interface{}, audit *dmodels.AuditLog) error {
+// 				panic("mock out the ApplyAudited method")
+// 			},
+// 			ApplyAuditedBatchFunc: func(entities ...postgres.AuditedEntity) error {
+// 				panic("mock out the ApplyAuditedBatch method")
+// 			},
+// 			CreateCurrencyRatesFunc: func(rates ...*dmodels.CurrencyRate) error {
+// 				panic("mock out the CreateCurrencyRates method")
+// 			},
+// 			CreateGovernanceSupplyFunc: func(supply ...*dmodels.GovernanceSupply) error {
+// 				panic("mock out the CreateGovernanceSupply method")
+// 			},
+// 			CreatePoolPegFunc: func(pegs ...*dmodels.PoolPeg) error {
+// 				panic("mock out the CreatePoolPeg method")
+// 			},
 // 			CreatePoolValidatorDataFunc: func(pools ...*dmodels.PoolValidatorData) error {
 // 				panic("mock out the CreatePoolValidatorData method")
 // 			},
+// 			CreatePriceHistoryFunc: func(history ...*dmodels.PriceHistory) error {
+// 				panic("mock out the CreatePriceHistory method")
+// 			},
 // 			CreateSlotTimeFunc: func(slotTime ...*dmodels.SlotTime) error {
 // 				panic("mock out the CreateSlotTime method")
 // 			},
@@ -33,6 +55,30 @@ var _ Postgres = &PostgresMock{}
 // 			DeleteValidatorsFunc: func(poolID uuid.UUID) error {
 // 				panic("mock out the DeleteValidators method")
 // 			},
+// 			ExportPoolDataFunc: func(cond *postgres.ExportCondition, fn func(*dmodels.PoolDataExport) error) error {
+// 				panic("mock out the ExportPoolData method")
+// 			},
+// 			ExportPoolValidatorDataFunc: func(cond *postgres.ExportCondition, fn func(*dmodels.PoolValidatorDataExport) error) error {
+// 				panic("mock out the ExportPoolValidatorData method")
+// 			},
+// 			ExportValidatorDataFunc: func(cond *postgres.ExportCondition, fn func(*dmodels.ValidatorDataExport) error) error {
+// 				panic("mock out the ExportValidatorData method")
+// 			},
+// 			GetAPIKeyByHashFunc: func(hash string) (*dmodels.APIKey, error) {
+// 				panic("mock out the GetAPIKeyByHash method")
+// 			},
+// 			GetAPIKeyUsageFunc: func(since time.Time) (map[uuid.UUID]int64, error) {
+// 				panic("mock out the GetAPIKeyUsage method")
+// 			},
+// 			GetAPIKeysFunc: func() ([]*dmodels.APIKey, error) {
+// 				panic("mock out the GetAPIKeys method")
+// 			},
+// 			GetAuditLogsFunc: func(cond *postgres.AuditLogCondition) ([]*dmodels.AuditLog, error) {
+// 				panic("mock out the GetAuditLogs method")
+// 			},
+// 			GetAuditLogsCountFunc: func(cond *postgres.AuditLogCondition) (int64, error) {
+// 				panic("mock out the GetAuditLogsCount method")
+// 			},
 // 			GetCoinByIDFunc: func(id uuid.UUID) (*dmodels.Coin, error) {
 // 				panic("mock out the GetCoinByID method")
 // 			},
@@ -42,15 +88,36 @@ var _ Postgres = &PostgresMock{}
 // 			GetCoinsCountFunc: func(cond *postgres.CoinCondition) (int64, error) {
 // 				panic("mock out the GetCoinsCount method")
 // 			},
+// 			GetCurrencyRateAtFunc: func(currency string, t time.Time) (*dmodels.CurrencyRate, error) {
+// 				panic("mock out the GetCurrencyRateAt method")
+// 			},
+// 			GetCurrencyRatesBetweenFunc: func(currency string, from time.Time, to time.Time) ([]*dmodels.CurrencyRate, error) {
+// 				panic("mock out the GetCurrencyRatesBetween method")
+// 			},
+// 			GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+// 				panic("mock out the GetDEFIHistory method")
+// 			},
 // 			GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 // 				panic("mock out the GetDEFIs method")
 // 			},
+// 			GetDataVersionFunc: func(types []string) (time.Time, error) {
+// 				panic("mock out the GetDataVersion method")
+// 			},
 // 			GetGovernanceFunc: func(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error) {
 // 				panic("mock out the GetGovernance method")
 // 			},
 // 			GetGovernanceCountFunc: func(cond *postgres.GovernanceCondition) (int64, error) {
 // 				panic("mock out the GetGovernanceCount method")
 // 			},
+// 			GetGovernanceSuppliesHistoryFunc: func(governanceIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error) {
+// 				panic("mock out the GetGovernanceSuppliesHistory method")
+// 			},
+// 			GetGovernanceSupplyAtFunc: func(governanceID uuid.UUID, t time.Time) (*dmodels.GovernanceSupply, error) {
+// 				panic("mock out the GetGovernanceSupplyAt method")
+// 			},
+// 			GetGovernanceSupplyHistoryFunc: func(governanceID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error) {
+// 				panic("mock out the GetGovernanceSupplyHistory method")
+// 			},
 // 			GetLastEpochPoolDataFunc: func(PoolID uuid.UUID, currentEpoch uint64) (*dmodels.PoolData, error) {
 // 				panic("mock out the GetLastEpochPoolData method")
 // 			},
@@ -60,6 +127,12 @@ var _ Postgres = &PostgresMock{}
 // 			GetLastPoolDataWithApyForTenEpochFunc: func(poolID uuid.UUID) (*dmodels.PoolData, error) {
 // 				panic("mock out the GetLastPoolDataWithApyForTenEpoch method")
 // 			},
+// 			GetLastPoolPegFunc: func(poolID uuid.UUID) (*dmodels.PoolPeg, error) {
+// 				panic("mock out the GetLastPoolPeg method")
+// 			},
+// 			GetLastPoolsDataFunc: func(poolIDs []uuid.UUID) ([]*dmodels.PoolData, error) {
+// 				panic("mock out the GetLastPoolsData method")
+// 			},
 // 			GetLiquidityPoolFunc: func(cond *postgres.Condition) (*dmodels.LiquidityPool, error) {
 // 				panic("mock out the GetLiquidityPool method")
 // 			},
@@ -75,6 +148,12 @@ var _ Postgres = &PostgresMock{}
 // 			GetPoolCountFunc: func(condition *postgres.Condition) (int64, error) {
 // 				panic("mock out the GetPoolCount method")
 // 			},
+// 			GetPoolExchangeRateFunc: func(poolID uuid.UUID, epoch uint64) (*dmodels.PoolExchangeRate, error) {
+// 				panic("mock out the GetPoolExchangeRate method")
+// 			},
+// 			GetPoolPegsFunc: func(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolPeg, error) {
+// 				panic("mock out the GetPoolPegs method")
+// 			},
 // 			GetPoolStatisticFunc: func(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error) {
 // 				panic("mock out the GetPoolStatistic method")
 // 			},
@@ -84,6 +163,18 @@ var _ Postgres = &PostgresMock{}
 // 			GetPoolsFunc: func(condition *postgres.PoolCondition) ([]*dmodels.Pool, error) {
 // 				panic("mock out the GetPools method")
 // 			},
+// 			GetPoolsExchangeRateWindowsFunc: func(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error) {
+// 				panic("mock out the GetPoolsExchangeRateWindows method")
+// 			},
+// 			GetPoolsStatisticFunc: func(poolIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error) {
+// 				panic("mock out the GetPoolsStatistic method")
+// 			},
+// 			GetPriceHistoryFunc: func(assetID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PriceHistory, error) {
+// 				panic("mock out the GetPriceHistory method")
+// 			},
+// 			GetPricesAtFunc: func(assetIDs []uuid.UUID, points []time.Time) ([]*dmodels.PriceHistory, error) {
+// 				panic("mock out the GetPricesAt method")
+// 			},
 // 			GetSlotTimeFunc: func(cond *postgres.SlotTimeCondition) ([]*dmodels.SlotTime, error) {
 // 				panic("mock out the GetSlotTime method")
 // 			},
@@ -96,12 +187,24 @@ var _ Postgres = &PostgresMock{}
 // 			GetValidatorCountFunc: func(condition *postgres.ValidatorCondition, epoch uint64) (int64, error) {
 // 				panic("mock out the GetValidatorCount method")
 // 			},
+// 			GetValidatorDataFunc: func(condition *postgres.ValidatorDataCondition) ([]*dmodels.ValidatorData, error) {
+// 				panic("mock out the GetValidatorData method")
+// 			},
 // 			GetValidatorDataCountFunc: func(condition *postgres.PoolValidatorDataCondition, epoch uint64) (int64, error) {
 // 				panic("mock out the GetValidatorDataCount method")
 // 			},
+// 			GetValidatorDataCountsFunc: func(poolDataIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+// 				panic("mock out the GetValidatorDataCounts method")
+// 			},
 // 			GetValidatorsFunc: func(condition *postgres.ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error) {
 // 				panic("mock out the GetValidators method")
 // 			},
+// 			GetValidatorsDelinquencyFunc: func() (map[string]bool, error) {
+// 				panic("mock out the GetValidatorsDelinquency method")
+// 			},
+// 			ReplaceDEFIsFunc: func(liquidityPoolID uuid.UUID, defiData []*dmodels.DEFI, history []*dmodels.DEFIHistory) error {
+// 				panic("mock out the ReplaceDEFIs method")
+// 			},
 // 			SaveCoinFunc: func(coin ...*dmodels.Coin) error {
 // 				panic("mock out the SaveCoin method")
 // 			},
@@ -111,6 +214,18 @@ var _ Postgres = &PostgresMock{}
 // 			SaveGovernanceFunc: func(gov ...*dmodels.Governance) error {
 // 				panic("mock out the SaveGovernance method")
 // 			},
+// 			SavePoolExchangeRateFunc: func(rate *dmodels.PoolExchangeRate) error {
+// 				panic("mock out the SavePoolExchangeRate method")
+// 			},
+// 			SearchFunc: func(cond *postgres.SearchCondition) ([]*dmodels.SearchResult, error) {
+// 				panic("mock out the Search method")
+// 			},
+// 			TouchDataVersionsFunc: func(types ...string) error {
+// 				panic("mock out the TouchDataVersions method")
+// 			},
+// 			UpdateLiquidityPoolStatusFunc: func(pool *dmodels.LiquidityPool) error {
+// 				panic("mock out the UpdateLiquidityPoolStatus method")
+// 			},
 // 			UpdatePoolDataFunc: func(poolData *dmodels.PoolData) error {
 // 				panic("mock out the UpdatePoolData method")
 // 			},
@@ -127,9 +242,30 @@ var _ Postgres = &PostgresMock{}
 //
 // 	}
 type PostgresMock struct {
+	// AddAPIKeyUsageFunc mocks the AddAPIKeyUsage method.
+	AddAPIKeyUsageFunc func(date time.Time, requests map[uuid.UUID]int64) error
+
+	// ApplyAuditedFunc mocks the ApplyAudited method.
+	ApplyAuditedFunc func(entity interface{}, audit *dmodels.AuditLog) error
+
+	// ApplyAuditedBatchFunc mocks the ApplyAuditedBatch method.
+	ApplyAuditedBatchFunc func(entities ...postgres.AuditedEntity) error
+
+	// CreateCurrencyRatesFunc mocks the CreateCurrencyRates method.
+	CreateCurrencyRatesFunc func(rates ...*dmodels.CurrencyRate) error
+
+	// CreateGovernanceSupplyFunc mocks the CreateGovernanceSupply method.
+	CreateGovernanceSupplyFunc func(supply ...*dmodels.GovernanceSupply) error
+
+	// CreatePoolPegFunc mocks the CreatePoolPeg method.
+	CreatePoolPegFunc func(pegs ...*dmodels.PoolPeg) error
+
 	// CreatePoolValidatorDataFunc mocks the CreatePoolValidatorData method.
 	CreatePoolValidatorDataFunc func(pools ...*dmodels.PoolValidatorData) error
 
+	// CreatePriceHistoryFunc mocks the CreatePriceHistory method.
+	CreatePriceHistoryFunc func(history ...*dmodels.PriceHistory) error
+
 	// CreateSlotTimeFunc mocks the CreateSlotTime method.
 	CreateSlotTimeFunc func(slotTime ...*dmodels.SlotTime) error
 
@@ -139,6 +275,30 @@ type PostgresMock struct {
 	// DeleteValidatorsFunc mocks the DeleteValidators method.
 	DeleteValidatorsFunc func(poolID uuid.UUID) error
 
+	// ExportPoolDataFunc mocks the ExportPoolData method.
+	ExportPoolDataFunc func(cond *postgres.ExportCondition, fn func(*dmodels.PoolDataExport) error) error
+
+	// ExportPoolValidatorDataFunc mocks the ExportPoolValidatorData method.
+	ExportPoolValidatorDataFunc func(cond *postgres.ExportCondition, fn func(*dmodels.PoolValidatorDataExport) error) error
+
+	// ExportValidatorDataFunc mocks the ExportValidatorData method.
+	ExportValidatorDataFunc func(cond *postgres.ExportCondition, fn func(*dmodels.ValidatorDataExport) error) error
+
+	// GetAPIKeyByHashFunc mocks the GetAPIKeyByHash method.
+	GetAPIKeyByHashFunc func(hash string) (*dmodels.APIKey, error)
+
+	// GetAPIKeyUsageFunc mocks the GetAPIKeyUsage method.
+	GetAPIKeyUsageFunc func(since time.Time) (map[uuid.UUID]int64, error)
+
+	// GetAPIKeysFunc mocks the GetAPIKeys method.
+	GetAPIKeysFunc func() ([]*dmodels.APIKey, error)
+
+	// GetAuditLogsFunc mocks the GetAuditLogs method.
+	GetAuditLogsFunc func(cond *postgres.AuditLogCondition) ([]*dmodels.AuditLog, error)
+
+	// GetAuditLogsCountFunc mocks the GetAuditLogsCount method.
+	GetAuditLogsCountFunc func(cond *postgres.AuditLogCondition) (int64, error)
+
 	// GetCoinByIDFunc mocks the GetCoinByID method.
 	GetCoinByIDFunc func(id uuid.UUID) (*dmodels.Coin, error)
 
@@ -148,15 +308,36 @@ type PostgresMock struct {
 	// GetCoinsCountFunc mocks the GetCoinsCount method.
 	GetCoinsCountFunc func(cond *postgres.CoinCondition) (int64, error)
 
+	// GetCurrencyRateAtFunc mocks the GetCurrencyRateAt method.
+	GetCurrencyRateAtFunc func(currency string, t time.Time) (*dmodels.CurrencyRate, error)
+
+	// GetCurrencyRatesBetweenFunc mocks the GetCurrencyRatesBetween method.
+	GetCurrencyRatesBetweenFunc func(currency string, from time.Time, to time.Time) ([]*dmodels.CurrencyRate, error)
+
+	// GetDEFIHistoryFunc mocks the GetDEFIHistory method.
+	GetDEFIHistoryFunc func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error)
+
 	// GetDEFIsFunc mocks the GetDEFIs method.
 	GetDEFIsFunc func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error)
 
+	// GetDataVersionFunc mocks the GetDataVersion method.
+	GetDataVersionFunc func(types []string) (time.Time, error)
+
 	// GetGovernanceFunc mocks the GetGovernance method.
 	GetGovernanceFunc func(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error)
 
 	// GetGovernanceCountFunc mocks the GetGovernanceCount method.
 	GetGovernanceCountFunc func(cond *postgres.GovernanceCondition) (int64, error)
 
+	// GetGovernanceSuppliesHistoryFunc mocks the GetGovernanceSuppliesHistory method.
+	GetGovernanceSuppliesHistoryFunc func(governanceIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error)
+
+	// GetGovernanceSupplyAtFunc mocks the GetGovernanceSupplyAt method.
+	GetGovernanceSupplyAtFunc func(governanceID uuid.UUID, t time.Time) (*dmodels.GovernanceSupply, error)
+
+	// GetGovernanceSupplyHistoryFunc mocks the GetGovernanceSupplyHistory method.
+	GetGovernanceSupplyHistoryFunc func(governanceID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error)
+
 	// GetLastEpochPoolDataFunc mocks the GetLastEpochPoolData method.
 	GetLastEpochPoolDataFunc func(PoolID uuid.UUID, currentEpoch uint64) (*dmodels.PoolData, error)
 
@@ -166,6 +347,12 @@ type PostgresMock struct {
 	// GetLastPoolDataWithApyForTenEpochFunc mocks the GetLastPoolDataWithApyForTenEpoch method.
 	GetLastPoolDataWithApyForTenEpochFunc func(poolID uuid.UUID) (*dmodels.PoolData, error)
 
+	// GetLastPoolPegFunc mocks the GetLastPoolPeg method.
+	GetLastPoolPegFunc func(poolID uuid.UUID) (*dmodels.PoolPeg, error)
+
+	// GetLastPoolsDataFunc mocks the GetLastPoolsData method.
+	GetLastPoolsDataFunc func(poolIDs []uuid.UUID) ([]*dmodels.PoolData, error)
+
 	// GetLiquidityPoolFunc mocks the GetLiquidityPool method.
 	GetLiquidityPoolFunc func(cond *postgres.Condition) (*dmodels.LiquidityPool, error)
 
@@ -181,6 +368,12 @@ type PostgresMock struct {
 	// GetPoolCountFunc mocks the GetPoolCount method.
 	GetPoolCountFunc func(condition *postgres.Condition) (int64, error)
 
+	// GetPoolExchangeRateFunc mocks the GetPoolExchangeRate method.
+	GetPoolExchangeRateFunc func(poolID uuid.UUID, epoch uint64) (*dmodels.PoolExchangeRate, error)
+
+	// GetPoolPegsFunc mocks the GetPoolPegs method.
+	GetPoolPegsFunc func(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolPeg, error)
+
 	// GetPoolStatisticFunc mocks the GetPoolStatistic method.
 	GetPoolStatisticFunc func(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error)
 
@@ -190,6 +383,18 @@ type PostgresMock struct {
 	// GetPoolsFunc mocks the GetPools method.
 	GetPoolsFunc func(condition *postgres.PoolCondition) ([]*dmodels.Pool, error)
 
+	// GetPoolsExchangeRateWindowsFunc mocks the GetPoolsExchangeRateWindows method.
+	GetPoolsExchangeRateWindowsFunc func(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error)
+
+	// GetPoolsStatisticFunc mocks the GetPoolsStatistic method.
+	GetPoolsStatisticFunc func(poolIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error)
+
+	// GetPriceHistoryFunc mocks the GetPriceHistory method.
+	GetPriceHistoryFunc func(assetID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PriceHistory, error)
+
+	// GetPricesAtFunc mocks the GetPricesAt method.
+	GetPricesAtFunc func(assetIDs []uuid.UUID, points []time.Time) ([]*dmodels.PriceHistory, error)
+
 	// GetSlotTimeFunc mocks the GetSlotTime method.
 	GetSlotTimeFunc func(cond *postgres.SlotTimeCondition) ([]*dmodels.SlotTime, error)
 
@@ -202,12 +407,24 @@ type PostgresMock struct {
 	// GetValidatorCountFunc mocks the GetValidatorCount method.
 	GetValidatorCountFunc func(condition *postgres.ValidatorCondition, epoch uint64) (int64, error)
 
+	// GetValidatorDataFunc mocks the GetValidatorData method.
+	GetValidatorDataFunc func(condition *postgres.ValidatorDataCondition) ([]*dmodels.ValidatorData, error)
+
 	// GetValidatorDataCountFunc mocks the GetValidatorDataCount method.
 	GetValidatorDataCountFunc func(condition *postgres.PoolValidatorDataCondition, epoch uint64) (int64, error)
 
+	// GetValidatorDataCountsFunc mocks the GetValidatorDataCounts method.
+	GetValidatorDataCountsFunc func(poolDataIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+
 	// GetValidatorsFunc mocks the GetValidators method.
 	GetValidatorsFunc func(condition *postgres.ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error)
 
+	// GetValidatorsDelinquencyFunc mocks the GetValidatorsDelinquency method.
+	GetValidatorsDelinquencyFunc func() (map[string]bool, error)
+
+	// ReplaceDEFIsFunc mocks the ReplaceDEFIs method.
+	ReplaceDEFIsFunc func(liquidityPoolID uuid.UUID, defiData []*dmodels.DEFI, history []*dmodels.DEFIHistory) error
+
 	// SaveCoinFunc mocks the SaveCoin method.
 	SaveCoinFunc func(coin ...*dmodels.Coin) error
 
@@ -217,6 +434,18 @@ type PostgresMock struct {
 	// SaveGovernanceFunc mocks the SaveGovernance method.
 	SaveGovernanceFunc func(gov ...*dmodels.Governance) error
 
+	// SavePoolExchangeRateFunc mocks the SavePoolExchangeRate method.
+	SavePoolExchangeRateFunc func(rate *dmodels.PoolExchangeRate) error
+
+	// SearchFunc mocks the Search method.
+	SearchFunc func(cond *postgres.SearchCondition) ([]*dmodels.SearchResult, error)
+
+	// TouchDataVersionsFunc mocks the TouchDataVersions method.
+	TouchDataVersionsFunc func(types ...string) error
+
+	// UpdateLiquidityPoolStatusFunc mocks the UpdateLiquidityPoolStatus method.
+	UpdateLiquidityPoolStatusFunc func(pool *dmodels.LiquidityPool) error
+
 	// UpdatePoolDataFunc mocks the UpdatePoolData method.
 	UpdatePoolDataFunc func(poolData *dmodels.PoolData) error
 
@@ -228,11 +457,50 @@ type PostgresMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddAPIKeyUsage holds details about calls to the AddAPIKeyUsage method.
+		AddAPIKeyUsage []struct {
+			// Date is the date argument value.
+			Date time.Time
+			// Requests is the requests argument value.
+			Requests map[uuid.UUID]int64
+		}
+		// ApplyAudited holds details about calls to the ApplyAudited method.
+		ApplyAudited []struct {
+			// Entity is the entity argument value.
+			Entity interface{}
+			// Audit is the audit argument value.
+			Audit *dmodels.AuditLog
+		}
+		// ApplyAuditedBatch holds details about calls to the ApplyAuditedBatch method.
+		ApplyAuditedBatch []struct {
+			// Entities is the entities argument value.
+			Entities []postgres.AuditedEntity
+		}
+		// CreateCurrencyRates holds details about calls to the CreateCurrencyRates method.
+		CreateCurrencyRates []struct {
+			// Rates is the rates argument value.
+			Rates []*dmodels.CurrencyRate
+		}
+		// CreateGovernanceSupply holds details about calls to the CreateGovernanceSupply method.
+		CreateGovernanceSupply []struct {
+			// Supply is the supply argument value.
+			Supply []*dmodels.GovernanceSupply
+		}
+		// CreatePoolPeg holds details about calls to the CreatePoolPeg method.
+		CreatePoolPeg []struct {
+			// Pegs is the pegs argument value.
+			Pegs []*dmodels.PoolPeg
+		}
 		// CreatePoolValidatorData holds details about calls to the CreatePoolValidatorData method.
 		CreatePoolValidatorData []struct {
 			// Pools is the pools argument value.
 			Pools []*dmodels.PoolValidatorData
 		}
+		// CreatePriceHistory holds details about calls to the CreatePriceHistory method.
+		CreatePriceHistory []struct {
+			// History is the history argument value.
+			History []*dmodels.PriceHistory
+		}
 		// CreateSlotTime holds details about calls to the CreateSlotTime method.
 		CreateSlotTime []struct {
 			// SlotTime is the slotTime argument value.
@@ -248,6 +516,50 @@ type PostgresMock struct {
 			// PoolID is the poolID argument value.
 			PoolID uuid.UUID
 		}
+		// ExportPoolData holds details about calls to the ExportPoolData method.
+		ExportPoolData []struct {
+			// Cond is the cond argument value.
+			Cond *postgres.ExportCondition
+			// Fn is the fn argument value.
+			Fn func(*dmodels.PoolDataExport) error
+		}
+		// ExportPoolValidatorData holds details about calls to the ExportPoolValidatorData method.
+		ExportPoolValidatorData []struct {
+			// Cond is the cond argument value.
+			Cond *postgres.ExportCondition
+			// Fn is the fn argument value.
+			Fn func(*dmodels.PoolValidatorDataExport) error
+		}
+		// ExportValidatorData holds details about calls to the ExportValidatorData method.
+		ExportValidatorData []struct {
+			// Cond is the cond argument value.
+			Cond *postgres.ExportCondition
+			// Fn is the fn argument value.
+			Fn func(*dmodels.ValidatorDataExport) error
+		}
+		// GetAPIKeyByHash holds details about calls to the GetAPIKeyByHash method.
+		GetAPIKeyByHash []struct {
+			// Hash is the hash argument value.
+			Hash string
+		}
+		// GetAPIKeyUsage holds details about calls to the GetAPIKeyUsage method.
+		GetAPIKeyUsage []struct {
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// GetAPIKeys holds details about calls to the GetAPIKeys method.
+		GetAPIKeys []struct {
+		}
+		// GetAuditLogs holds details about calls to the GetAuditLogs method.
+		GetAuditLogs []struct {
+			// Cond is the cond argument value.
+			Cond *postgres.AuditLogCondition
+		}
+		// GetAuditLogsCount holds details about calls to the GetAuditLogsCount method.
+		GetAuditLogsCount []struct {
+			// Cond is the cond argument value.
+			Cond *postgres.AuditLogCondition
+		}
 		// GetCoinByID holds details about calls to the GetCoinByID method.
 		GetCoinByID []struct {
 			// ID is the id argument value.
@@ -263,11 +575,37 @@ type PostgresMock struct {
 			// Cond is the cond argument value.
 			Cond *postgres.CoinCondition
 		}
+		// GetCurrencyRateAt holds details about calls to the GetCurrencyRateAt method.
+		GetCurrencyRateAt []struct {
+			// Currency is the currency argument value.
+			Currency string
+			// T is the t argument value.
+			T time.Time
+		}
+		// GetCurrencyRatesBetween holds details about calls to the GetCurrencyRatesBetween method.
+		GetCurrencyRatesBetween []struct {
+			// Currency is the currency argument value.
+			Currency string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// GetDEFIHistory holds details about calls to the GetDEFIHistory method.
+		GetDEFIHistory []struct {
+			// Cond is the cond argument value.
+			Cond *postgres.DeFiHistoryCondition
+		}
 		// GetDEFIs holds details about calls to the GetDEFIs method.
 		GetDEFIs []struct {
 			// Cond is the cond argument value.
 			Cond *postgres.DeFiCondition
 		}
+		// GetDataVersion holds details about calls to the GetDataVersion method.
+		GetDataVersion []struct {
+			// Types is the types argument value.
+			Types []string
+		}
 		// GetGovernance holds details about calls to the GetGovernance method.
 		GetGovernance []struct {
 			// Cond is the cond argument value.
@@ -278,6 +616,27 @@ type PostgresMock struct {
 			// Cond is the cond argument value.
 			Cond *postgres.GovernanceCondition
 		}
+		// GetGovernanceSuppliesHistory holds details about calls to the GetGovernanceSuppliesHistory method.
+		GetGovernanceSuppliesHistory []struct {
+			// GovernanceIDs is the governanceIDs argument value.
+			GovernanceIDs []uuid.UUID
+			// Aggregate is the aggregate argument value.
+			Aggregate postgres.Aggregate
+		}
+		// GetGovernanceSupplyAt holds details about calls to the GetGovernanceSupplyAt method.
+		GetGovernanceSupplyAt []struct {
+			// GovernanceID is the governanceID argument value.
+			GovernanceID uuid.UUID
+			// T is the t argument value.
+			T time.Time
+		}
+		// GetGovernanceSupplyHistory holds details about calls to the GetGovernanceSupplyHistory method.
+		GetGovernanceSupplyHistory []struct {
+			// GovernanceID is the governanceID argument value.
+			GovernanceID uuid.UUID
+			// Aggregate is the aggregate argument value.
+			Aggregate postgres.Aggregate
+		}
 		// GetLastEpochPoolData holds details about calls to the GetLastEpochPoolData method.
 		GetLastEpochPoolData []struct {
 			// PoolID is the PoolID argument value.
@@ -295,6 +654,16 @@ type PostgresMock struct {
 			// PoolID is the poolID argument value.
 			PoolID uuid.UUID
 		}
+		// GetLastPoolPeg holds details about calls to the GetLastPoolPeg method.
+		GetLastPoolPeg []struct {
+			// PoolID is the poolID argument value.
+			PoolID uuid.UUID
+		}
+		// GetLastPoolsData holds details about calls to the GetLastPoolsData method.
+		GetLastPoolsData []struct {
+			// PoolIDs is the poolIDs argument value.
+			PoolIDs []uuid.UUID
+		}
 		// GetLiquidityPool holds details about calls to the GetLiquidityPool method.
 		GetLiquidityPool []struct {
 			// Cond is the cond argument value.
@@ -320,6 +689,20 @@ type PostgresMock struct {
 			// Condition is the condition argument value.
 			Condition *postgres.Condition
 		}
+		// GetPoolExchangeRate holds details about calls to the GetPoolExchangeRate method.
+		GetPoolExchangeRate []struct {
+			// PoolID is the poolID argument value.
+			PoolID uuid.UUID
+			// Epoch is the epoch argument value.
+			Epoch uint64
+		}
+		// GetPoolPegs holds details about calls to the GetPoolPegs method.
+		GetPoolPegs []struct {
+			// PoolID is the poolID argument value.
+			PoolID uuid.UUID
+			// Aggregate is the aggregate argument value.
+			Aggregate postgres.Aggregate
+		}
 		// GetPoolStatistic holds details about calls to the GetPoolStatistic method.
 		GetPoolStatistic []struct {
 			// PoolID is the poolID argument value.
@@ -339,6 +722,36 @@ type PostgresMock struct {
 			// Condition is the condition argument value.
 			Condition *postgres.PoolCondition
 		}
+		// GetPoolsExchangeRateWindows holds details about calls to the GetPoolsExchangeRateWindows method.
+		GetPoolsExchangeRateWindows []struct {
+			// Epochs is the epochs argument value.
+			Epochs map[uuid.UUID]uint64
+			// EpochWindows is the epochWindows argument value.
+			EpochWindows []uint64
+			// DayWindows is the dayWindows argument value.
+			DayWindows []uint64
+		}
+		// GetPoolsStatistic holds details about calls to the GetPoolsStatistic method.
+		GetPoolsStatistic []struct {
+			// PoolIDs is the poolIDs argument value.
+			PoolIDs []uuid.UUID
+			// Aggregate is the aggregate argument value.
+			Aggregate postgres.Aggregate
+		}
+		// GetPriceHistory holds details about calls to the GetPriceHistory method.
+		GetPriceHistory []struct {
+			// AssetID is the assetID argument value.
+			AssetID uuid.UUID
+			// Aggregate is the aggregate argument value.
+			Aggregate postgres.Aggregate
+		}
+		// GetPricesAt holds details about calls to the GetPricesAt method.
+		GetPricesAt []struct {
+			// AssetIDs is the assetIDs argument value.
+			AssetIDs []uuid.UUID
+			// Points is the points argument value.
+			Points []time.Time
+		}
 		// GetSlotTime holds details about calls to the GetSlotTime method.
 		GetSlotTime []struct {
 			// Cond is the cond argument value.
@@ -363,6 +776,11 @@ type PostgresMock struct {
 			// Epoch is the epoch argument value.
 			Epoch uint64
 		}
+		// GetValidatorData holds details about calls to the GetValidatorData method.
+		GetValidatorData []struct {
+			// Condition is the condition argument value.
+			Condition *postgres.ValidatorDataCondition
+		}
 		// GetValidatorDataCount holds details about calls to the GetValidatorDataCount method.
 		GetValidatorDataCount []struct {
 			// Condition is the condition argument value.
@@ -370,6 +788,11 @@ type PostgresMock struct {
 			// Epoch is the epoch argument value.
 			Epoch uint64
 		}
+		// GetValidatorDataCounts holds details about calls to the GetValidatorDataCounts method.
+		GetValidatorDataCounts []struct {
+			// PoolDataIDs is the poolDataIDs argument value.
+			PoolDataIDs []uuid.UUID
+		}
 		// GetValidators holds details about calls to the GetValidators method.
 		GetValidators []struct {
 			// Condition is the condition argument value.
@@ -377,6 +800,18 @@ type PostgresMock struct {
 			// Epoch is the epoch argument value.
 			Epoch uint64
 		}
+		// GetValidatorsDelinquency holds details about calls to the GetValidatorsDelinquency method.
+		GetValidatorsDelinquency []struct {
+		}
+		// ReplaceDEFIs holds details about calls to the ReplaceDEFIs method.
+		ReplaceDEFIs []struct {
+			// LiquidityPoolID is the liquidityPoolID argument value.
+			LiquidityPoolID uuid.UUID
+			// DefiData is the defiData argument value.
+			DefiData []*dmodels.DEFI
+			// History is the history argument value.
+			History []*dmodels.DEFIHistory
+		}
 		// SaveCoin holds details about calls to the SaveCoin method.
 		SaveCoin []struct {
 			// Coin is the coin argument value.
@@ -392,6 +827,26 @@ type PostgresMock struct {
 			// Gov is the gov argument value.
 			Gov []*dmodels.Governance
 		}
+		// SavePoolExchangeRate holds details about calls to the SavePoolExchangeRate method.
+		SavePoolExchangeRate []struct {
+			// Rate is the rate argument value.
+			Rate *dmodels.PoolExchangeRate
+		}
+		// Search holds details about calls to the Search method.
+		Search []struct {
+			// Cond is the cond argument value.
+			Cond *postgres.SearchCondition
+		}
+		// TouchDataVersions holds details about calls to the TouchDataVersions method.
+		TouchDataVersions []struct {
+			// Types is the types argument value.
+			Types []string
+		}
+		// UpdateLiquidityPoolStatus holds details about calls to the UpdateLiquidityPoolStatus method.
+		UpdateLiquidityPoolStatus []struct {
+			// Pool is the pool argument value.
+			Pool *dmodels.LiquidityPool
+		}
 		// UpdatePoolData holds details about calls to the UpdatePoolData method.
 		UpdatePoolData []struct {
 			// PoolData is the poolData argument value.
@@ -408,41 +863,273 @@ type PostgresMock struct {
 			Data []*dmodels.ValidatorData
 		}
 	}
+	lockAddAPIKeyUsage                    sync.RWMutex
+	lockApplyAudited                      sync.RWMutex
+	lockApplyAuditedBatch                 sync.RWMutex
+	lockCreateCurrencyRates               sync.RWMutex
+	lockCreateGovernanceSupply            sync.RWMutex
+	lockCreatePoolPeg                     sync.RWMutex
 	lockCreatePoolValidatorData           sync.RWMutex
+	lockCreatePriceHistory                sync.RWMutex
 	lockCreateSlotTime                    sync.RWMutex
 	lockDeleteDeFis                       sync.RWMutex
 	lockDeleteValidators                  sync.RWMutex
+	lockExportPoolData                    sync.RWMutex
+	lockExportPoolValidatorData           sync.RWMutex
+	lockExportValidatorData               sync.RWMutex
+	lockGetAPIKeyByHash                   sync.RWMutex
+	lockGetAPIKeyUsage                    sync.RWMutex
+	lockGetAPIKeys                        sync.RWMutex
+	lockGetAuditLogs                      sync.RWMutex
+	lockGetAuditLogsCount                 sync.RWMutex
 	lockGetCoinByID                       sync.RWMutex
 	lockGetCoins                          sync.RWMutex
 	lockGetCoinsCount                     sync.RWMutex
+	lockGetCurrencyRateAt                 sync.RWMutex
+	lockGetCurrencyRatesBetween           sync.RWMutex
+	lockGetDEFIHistory                    sync.RWMutex
 	lockGetDEFIs                          sync.RWMutex
+	lockGetDataVersion                    sync.RWMutex
 	lockGetGovernance                     sync.RWMutex
 	lockGetGovernanceCount                sync.RWMutex
+	lockGetGovernanceSuppliesHistory      sync.RWMutex
+	lockGetGovernanceSupplyAt             sync.RWMutex
+	lockGetGovernanceSupplyHistory        sync.RWMutex
 	lockGetLastEpochPoolData              sync.RWMutex
 	lockGetLastPoolData                   sync.RWMutex
 	lockGetLastPoolDataWithApyForTenEpoch sync.RWMutex
+	lockGetLastPoolPeg                    sync.RWMutex
+	lockGetLastPoolsData                  sync.RWMutex
 	lockGetLiquidityPool                  sync.RWMutex
 	lockGetLiquidityPools                 sync.RWMutex
 	lockGetLiquidityPoolsCount            sync.RWMutex
 	lockGetPool                           sync.RWMutex
 	lockGetPoolCount                      sync.RWMutex
+	lockGetPoolExchangeRate               sync.RWMutex
+	lockGetPoolPegs                       sync.RWMutex
 	lockGetPoolStatistic                  sync.RWMutex
 	lockGetPoolValidatorData              sync.RWMutex
 	lockGetPools                          sync.RWMutex
+	lockGetPoolsExchangeRateWindows       sync.RWMutex
+	lockGetPoolsStatistic                 sync.RWMutex
+	lockGetPriceHistory                   sync.RWMutex
+	lockGetPricesAt                       sync.RWMutex
 	lockGetSlotTime                       sync.RWMutex
 	lockGetValidator                      sync.RWMutex
 	lockGetValidatorByVotePK              sync.RWMutex
 	lockGetValidatorCount                 sync.RWMutex
+	lockGetValidatorData                  sync.RWMutex
 	lockGetValidatorDataCount             sync.RWMutex
+	lockGetValidatorDataCounts            sync.RWMutex
 	lockGetValidators                     sync.RWMutex
+	lockGetValidatorsDelinquency          sync.RWMutex
+	lockReplaceDEFIs                      sync.RWMutex
 	lockSaveCoin                          sync.RWMutex
 	lockSaveDEFIs                         sync.RWMutex
 	lockSaveGovernance                    sync.RWMutex
+	lockSavePoolExchangeRate              sync.RWMutex
+	lockSearch                            sync.RWMutex
+	lockTouchDataVersions                 sync.RWMutex
+	lockUpdateLiquidityPoolStatus         sync.RWMutex
 	lockUpdatePoolData                    sync.RWMutex
 	lockUpdateValidators                  sync.RWMutex
 	lockUpdateValidatorsData              sync.RWMutex
 }
 
+// AddAPIKeyUsage calls AddAPIKeyUsageFunc.
+func (mock *PostgresMock) AddAPIKeyUsage(date time.Time, requests map[uuid.UUID]int64) error {
+	if mock.AddAPIKeyUsageFunc == nil {
+		panic("PostgresMock.AddAPIKeyUsageFunc: method is nil but Postgres.AddAPIKeyUsage was just called")
+	}
+	callInfo := struct {
+		Date     time.Time
+		Requests map[uuid.UUID]int64
+	}{
+		Date:     date,
+		Requests: requests,
+	}
+	mock.lockAddAPIKeyUsage.Lock()
+	mock.calls.AddAPIKeyUsage = append(mock.calls.AddAPIKeyUsage, callInfo)
+	mock.lockAddAPIKeyUsage.Unlock()
+	return mock.AddAPIKeyUsageFunc(date, requests)
+}
+
+// AddAPIKeyUsageCalls gets all the calls that were made to AddAPIKeyUsage.
+// Check the length with:
+//     len(mockedPostgres.AddAPIKeyUsageCalls())
+func (mock *PostgresMock) AddAPIKeyUsageCalls() []struct {
+	Date     time.Time
+	Requests map[uuid.UUID]int64
+} {
+	var calls []struct {
+		Date     time.Time
+		Requests map[uuid.UUID]int64
+	}
+	mock.lockAddAPIKeyUsage.RLock()
+	calls = mock.calls.AddAPIKeyUsage
+	mock.lockAddAPIKeyUsage.RUnlock()
+	return calls
+}
+
+// ApplyAudited calls ApplyAuditedFunc.
+func (mock *PostgresMock) ApplyAudited(entity interface{}, audit *dmodels.AuditLog) error {
+	if mock.ApplyAuditedFunc == nil {
+		panic("PostgresMock.ApplyAuditedFunc: method is nil but Postgres.ApplyAudited was just called")
+	}
+	callInfo := struct {
+		Entity interface{}
+		Audit  *dmodels.AuditLog
+	}{
+		Entity: entity,
+		Audit:  audit,
+	}
+	mock.lockApplyAudited.Lock()
+	mock.calls.ApplyAudited = append(mock.calls.ApplyAudited, callInfo)
+	mock.lockApplyAudited.Unlock()
+	return mock.ApplyAuditedFunc(entity, audit)
+}
+
+// ApplyAuditedCalls gets all the calls that were made to ApplyAudited.
+// Check the length with:
+//     len(mockedPostgres.ApplyAuditedCalls())
+func (mock *PostgresMock) ApplyAuditedCalls() []struct {
+	Entity interface{}
+	Audit  *dmodels.AuditLog
+} {
+	var calls []struct {
+		Entity interface{}
+		Audit  *dmodels.AuditLog
+	}
+	mock.lockApplyAudited.RLock()
+	calls = mock.calls.ApplyAudited
+	mock.lockApplyAudited.RUnlock()
+	return calls
+}
+
+// ApplyAuditedBatch calls ApplyAuditedBatchFunc.
+func (mock *PostgresMock) ApplyAuditedBatch(entities ...postgres.AuditedEntity) error {
+	if mock.ApplyAuditedBatchFunc == nil {
+		panic("PostgresMock.ApplyAuditedBatchFunc: method is nil but Postgres.ApplyAuditedBatch was just called")
+	}
+	callInfo := struct {
+		Entities []postgres.AuditedEntity
+	}{
+		Entities: entities,
+	}
+	mock.lockApplyAuditedBatch.Lock()
+	mock.calls.ApplyAuditedBatch = append(mock.calls.ApplyAuditedBatch, callInfo)
+	mock.lockApplyAuditedBatch.Unlock()
+	return mock.ApplyAuditedBatchFunc(entities...)
+}
+
+// ApplyAuditedBatchCalls gets all the calls that were made to ApplyAuditedBatch.
+// Check the length with:
+//     len(mockedPostgres.ApplyAuditedBatchCalls())
+func (mock *PostgresMock) ApplyAuditedBatchCalls() []struct {
+	Entities []postgres.AuditedEntity
+} {
+	var calls []struct {
+		Entities []postgres.AuditedEntity
+	}
+	mock.lockApplyAuditedBatch.RLock()
+	calls = mock.calls.ApplyAuditedBatch
+	mock.lockApplyAuditedBatch.RUnlock()
+	return calls
+}
+
+// CreateCurrencyRates calls CreateCurrencyRatesFunc.
+func (mock *PostgresMock) CreateCurrencyRates(rates ...*dmodels.CurrencyRate) error {
+	if mock.CreateCurrencyRatesFunc == nil {
+		panic("PostgresMock.CreateCurrencyRatesFunc: method is nil but Postgres.CreateCurrencyRates was just called")
+	}
+	callInfo := struct {
+		Rates []*dmodels.CurrencyRate
+	}{
+		Rates: rates,
+	}
+	mock.lockCreateCurrencyRates.Lock()
+	mock.calls.CreateCurrencyRates = append(mock.calls.CreateCurrencyRates, callInfo)
+	mock.lockCreateCurrencyRates.Unlock()
+	return mock.CreateCurrencyRatesFunc(rates...)
+}
+
+// CreateCurrencyRatesCalls gets all the calls that were made to CreateCurrencyRates.
+// Check the length with:
+//     len(mockedPostgres.CreateCurrencyRatesCalls())
+func (mock *PostgresMock) CreateCurrencyRatesCalls() []struct {
+	Rates []*dmodels.CurrencyRate
+} {
+	var calls []struct {
+		Rates []*dmodels.CurrencyRate
+	}
+	mock.lockCreateCurrencyRates.RLock()
+	calls = mock.calls.CreateCurrencyRates
+	mock.lockCreateCurrencyRates.RUnlock()
+	return calls
+}
+
+// CreateGovernanceSupply calls CreateGovernanceSupplyFunc.
+func (mock *PostgresMock) CreateGovernanceSupply(supply ...*dmodels.GovernanceSupply) error {
+	if mock.CreateGovernanceSupplyFunc == nil {
+		panic("PostgresMock.CreateGovernanceSupplyFunc: method is nil but Postgres.CreateGovernanceSupply was just called")
+	}
+	callInfo := struct {
+		Supply []*dmodels.GovernanceSupply
+	}{
+		Supply: supply,
+	}
+	mock.lockCreateGovernanceSupply.Lock()
+	mock.calls.CreateGovernanceSupply = append(mock.calls.CreateGovernanceSupply, callInfo)
+	mock.lockCreateGovernanceSupply.Unlock()
+	return mock.CreateGovernanceSupplyFunc(supply...)
+}
+
+// CreateGovernanceSupplyCalls gets all the calls that were made to CreateGovernanceSupply.
+// Check the length with:
+//     len(mockedPostgres.CreateGovernanceSupplyCalls())
+func (mock *PostgresMock) CreateGovernanceSupplyCalls() []struct {
+	Supply []*dmodels.GovernanceSupply
+} {
+	var calls []struct {
+		Supply []*dmodels.GovernanceSupply
+	}
+	mock.lockCreateGovernanceSupply.RLock()
+	calls = mock.calls.CreateGovernanceSupply
+	mock.lockCreateGovernanceSupply.RUnlock()
+	return calls
+}
+
+// CreatePoolPeg calls CreatePoolPegFunc.
+func (mock *PostgresMock) CreatePoolPeg(pegs ...*dmodels.PoolPeg) error {
+	if mock.CreatePoolPegFunc == nil {
+		panic("PostgresMock.CreatePoolPegFunc: method is nil but Postgres.CreatePoolPeg was just called")
+	}
+	callInfo := struct {
+		Pegs []*dmodels.PoolPeg
+	}{
+		Pegs: pegs,
+	}
+	mock.lockCreatePoolPeg.Lock()
+	mock.calls.CreatePoolPeg = append(mock.calls.CreatePoolPeg, callInfo)
+	mock.lockCreatePoolPeg.Unlock()
+	return mock.CreatePoolPegFunc(pegs...)
+}
+
+// CreatePoolPegCalls gets all the calls that were made to CreatePoolPeg.
+// Check the length with:
+//     len(mockedPostgres.CreatePoolPegCalls())
+func (mock *PostgresMock) CreatePoolPegCalls() []struct {
+	Pegs []*dmodels.PoolPeg
+} {
+	var calls []struct {
+		Pegs []*dmodels.PoolPeg
+	}
+	mock.lockCreatePoolPeg.RLock()
+	calls = mock.calls.CreatePoolPeg
+	mock.lockCreatePoolPeg.RUnlock()
+	return calls
+}
+
 // CreatePoolValidatorData calls CreatePoolValidatorDataFunc.
 func (mock *PostgresMock) CreatePoolValidatorData(pools ...*dmodels.PoolValidatorData) error {
 	if mock.CreatePoolValidatorDataFunc == nil {
@@ -474,6 +1161,37 @@ func (mock *PostgresMock) CreatePoolValidatorDataCalls() []struct {
 	return calls
 }
 
+// CreatePriceHistory calls CreatePriceHistoryFunc.
+func (mock *PostgresMock) CreatePriceHistory(history ...*dmodels.PriceHistory) error {
+	if mock.CreatePriceHistoryFunc == nil {
+		panic("PostgresMock.CreatePriceHistoryFunc: method is nil but Postgres.CreatePriceHistory was just called")
+	}
+	callInfo := struct {
+		History []*dmodels.PriceHistory
+	}{
+		History: history,
+	}
+	mock.lockCreatePriceHistory.Lock()
+	mock.calls.CreatePriceHistory = append(mock.calls.CreatePriceHistory, callInfo)
+	mock.lockCreatePriceHistory.Unlock()
+	return mock.CreatePriceHistoryFunc(history...)
+}
+
+// CreatePriceHistoryCalls gets all the calls that were made to CreatePriceHistory.
+// Check the length with:
+//     len(mockedPostgres.CreatePriceHistoryCalls())
+func (mock *PostgresMock) CreatePriceHistoryCalls() []struct {
+	History []*dmodels.PriceHistory
+} {
+	var calls []struct {
+		History []*dmodels.PriceHistory
+	}
+	mock.lockCreatePriceHistory.RLock()
+	calls = mock.calls.CreatePriceHistory
+	mock.lockCreatePriceHistory.RUnlock()
+	return calls
+}
+
 // CreateSlotTime calls CreateSlotTimeFunc.
 func (mock *PostgresMock) CreateSlotTime(slotTime ...*dmodels.SlotTime) error {
 	if mock.CreateSlotTimeFunc == nil {
@@ -567,6 +1285,261 @@ func (mock *PostgresMock) DeleteValidatorsCalls() []struct {
 	return calls
 }
 
+// ExportPoolData calls ExportPoolDataFunc.
+func (mock *PostgresMock) ExportPoolData(cond *postgres.ExportCondition, fn func(*dmodels.PoolDataExport) error) error {
+	if mock.ExportPoolDataFunc == nil {
+		panic("PostgresMock.ExportPoolDataFunc: method is nil but Postgres.ExportPoolData was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.ExportCondition
+		Fn   func(*dmodels.PoolDataExport) error
+	}{
+		Cond: cond,
+		Fn:   fn,
+	}
+	mock.lockExportPoolData.Lock()
+	mock.calls.ExportPoolData = append(mock.calls.ExportPoolData, callInfo)
+	mock.lockExportPoolData.Unlock()
+	return mock.ExportPoolDataFunc(cond, fn)
+}
+
+// ExportPoolDataCalls gets all the calls that were made to ExportPoolData.
+// Check the length with:
+//     len(mockedPostgres.ExportPoolDataCalls())
+func (mock *PostgresMock) ExportPoolDataCalls() []struct {
+	Cond *postgres.ExportCondition
+	Fn   func(*dmodels.PoolDataExport) error
+} {
+	var calls []struct {
+		Cond *postgres.ExportCondition
+		Fn   func(*dmodels.PoolDataExport) error
+	}
+	mock.lockExportPoolData.RLock()
+	calls = mock.calls.ExportPoolData
+	mock.lockExportPoolData.RUnlock()
+	return calls
+}
+
+// ExportPoolValidatorData calls ExportPoolValidatorDataFunc.
+func (mock *PostgresMock) ExportPoolValidatorData(cond *postgres.ExportCondition, fn func(*dmodels.PoolValidatorDataExport) error) error {
+	if mock.ExportPoolValidatorDataFunc == nil {
+		panic("PostgresMock.ExportPoolValidatorDataFunc: method is nil but Postgres.ExportPoolValidatorData was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.ExportCondition
+		Fn   func(*dmodels.PoolValidatorDataExport) error
+	}{
+		Cond: cond,
+		Fn:   fn,
+	}
+	mock.lockExportPoolValidatorData.Lock()
+	mock.calls.ExportPoolValidatorData = append(mock.calls.ExportPoolValidatorData, callInfo)
+	mock.lockExportPoolValidatorData.Unlock()
+	return mock.ExportPoolValidatorDataFunc(cond, fn)
+}
+
+// ExportPoolValidatorDataCalls gets all the calls that were made to ExportPoolValidatorData.
+// Check the length with:
+//     len(mockedPostgres.ExportPoolValidatorDataCalls())
+func (mock *PostgresMock) ExportPoolValidatorDataCalls() []struct {
+	Cond *postgres.ExportCondition
+	Fn   func(*dmodels.PoolValidatorDataExport) error
+} {
+	var calls []struct {
+		Cond *postgres.ExportCondition
+		Fn   func(*dmodels.PoolValidatorDataExport) error
+	}
+	mock.lockExportPoolValidatorData.RLock()
+	calls = mock.calls.ExportPoolValidatorData
+	mock.lockExportPoolValidatorData.RUnlock()
+	return calls
+}
+
+// ExportValidatorData calls ExportValidatorDataFunc.
+func (mock *PostgresMock) ExportValidatorData(cond *postgres.ExportCondition, fn func(*dmodels.ValidatorDataExport) error) error {
+	if mock.ExportValidatorDataFunc == nil {
+		panic("PostgresMock.ExportValidatorDataFunc: method is nil but Postgres.ExportValidatorData was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.ExportCondition
+		Fn   func(*dmodels.ValidatorDataExport) error
+	}{
+		Cond: cond,
+		Fn:   fn,
+	}
+	mock.lockExportValidatorData.Lock()
+	mock.calls.ExportValidatorData = append(mock.calls.ExportValidatorData, callInfo)
+	mock.lockExportValidatorData.Unlock()
+	return mock.ExportValidatorDataFunc(cond, fn)
+}
+
+// ExportValidatorDataCalls gets all the calls that were made to ExportValidatorData.
+// Check the length with:
+//     len(mockedPostgres.ExportValidatorDataCalls())
+func (mock *PostgresMock) ExportValidatorDataCalls() []struct {
+	Cond *postgres.ExportCondition
+	Fn   func(*dmodels.ValidatorDataExport) error
+} {
+	var calls []struct {
+		Cond *postgres.ExportCondition
+		Fn   func(*dmodels.ValidatorDataExport) error
+	}
+	mock.lockExportValidatorData.RLock()
+	calls = mock.calls.ExportValidatorData
+	mock.lockExportValidatorData.RUnlock()
+	return calls
+}
+
+// GetAPIKeyByHash calls GetAPIKeyByHashFunc.
+func (mock *PostgresMock) GetAPIKeyByHash(hash string) (*dmodels.APIKey, error) {
+	if mock.GetAPIKeyByHashFunc == nil {
+		panic("PostgresMock.GetAPIKeyByHashFunc: method is nil but Postgres.GetAPIKeyByHash was just called")
+	}
+	callInfo := struct {
+		Hash string
+	}{
+		Hash: hash,
+	}
+	mock.lockGetAPIKeyByHash.Lock()
+	mock.calls.GetAPIKeyByHash = append(mock.calls.GetAPIKeyByHash, callInfo)
+	mock.lockGetAPIKeyByHash.Unlock()
+	return mock.GetAPIKeyByHashFunc(hash)
+}
+
+// GetAPIKeyByHashCalls gets all the calls that were made to GetAPIKeyByHash.
+// Check the length with:
+//     len(mockedPostgres.GetAPIKeyByHashCalls())
+func (mock *PostgresMock) GetAPIKeyByHashCalls() []struct {
+	Hash string
+} {
+	var calls []struct {
+		Hash string
+	}
+	mock.lockGetAPIKeyByHash.RLock()
+	calls = mock.calls.GetAPIKeyByHash
+	mock.lockGetAPIKeyByHash.RUnlock()
+	return calls
+}
+
+// GetAPIKeyUsage calls GetAPIKeyUsageFunc.
+func (mock *PostgresMock) GetAPIKeyUsage(since time.Time) (map[uuid.UUID]int64, error) {
+	if mock.GetAPIKeyUsageFunc == nil {
+		panic("PostgresMock.GetAPIKeyUsageFunc: method is nil but Postgres.GetAPIKeyUsage was just called")
+	}
+	callInfo := struct {
+		Since time.Time
+	}{
+		Since: since,
+	}
+	mock.lockGetAPIKeyUsage.Lock()
+	mock.calls.GetAPIKeyUsage = append(mock.calls.GetAPIKeyUsage, callInfo)
+	mock.lockGetAPIKeyUsage.Unlock()
+	return mock.GetAPIKeyUsageFunc(since)
+}
+
+// GetAPIKeyUsageCalls gets all the calls that were made to GetAPIKeyUsage.
+// Check the length with:
+//     len(mockedPostgres.GetAPIKeyUsageCalls())
+func (mock *PostgresMock) GetAPIKeyUsageCalls() []struct {
+	Since time.Time
+} {
+	var calls []struct {
+		Since time.Time
+	}
+	mock.lockGetAPIKeyUsage.RLock()
+	calls = mock.calls.GetAPIKeyUsage
+	mock.lockGetAPIKeyUsage.RUnlock()
+	return calls
+}
+
+// GetAPIKeys calls GetAPIKeysFunc.
+func (mock *PostgresMock) GetAPIKeys() ([]*dmodels.APIKey, error) {
+	if mock.GetAPIKeysFunc == nil {
+		panic("PostgresMock.GetAPIKeysFunc: method is nil but Postgres.GetAPIKeys was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetAPIKeys.Lock()
+	mock.calls.GetAPIKeys = append(mock.calls.GetAPIKeys, callInfo)
+	mock.lockGetAPIKeys.Unlock()
+	return mock.GetAPIKeysFunc()
+}
+
+// GetAPIKeysCalls gets all the calls that were made to GetAPIKeys.
+// Check the length with:
+//     len(mockedPostgres.GetAPIKeysCalls())
+func (mock *PostgresMock) GetAPIKeysCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetAPIKeys.RLock()
+	calls = mock.calls.GetAPIKeys
+	mock.lockGetAPIKeys.RUnlock()
+	return calls
+}
+
+// GetAuditLogs calls GetAuditLogsFunc.
+func (mock *PostgresMock) GetAuditLogs(cond *postgres.AuditLogCondition) ([]*dmodels.AuditLog, error) {
+	if mock.GetAuditLogsFunc == nil {
+		panic("PostgresMock.GetAuditLogsFunc: method is nil but Postgres.GetAuditLogs was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.AuditLogCondition
+	}{
+		Cond: cond,
+	}
+	mock.lockGetAuditLogs.Lock()
+	mock.calls.GetAuditLogs = append(mock.calls.GetAuditLogs, callInfo)
+	mock.lockGetAuditLogs.Unlock()
+	return mock.GetAuditLogsFunc(cond)
+}
+
+// GetAuditLogsCalls gets all the calls that were made to GetAuditLogs.
+// Check the length with:
+//     len(mockedPostgres.GetAuditLogsCalls())
+func (mock *PostgresMock) GetAuditLogsCalls() []struct {
+	Cond *postgres.AuditLogCondition
+} {
+	var calls []struct {
+		Cond *postgres.AuditLogCondition
+	}
+	mock.lockGetAuditLogs.RLock()
+	calls = mock.calls.GetAuditLogs
+	mock.lockGetAuditLogs.RUnlock()
+	return calls
+}
+
+// GetAuditLogsCount calls GetAuditLogsCountFunc.
+func (mock *PostgresMock) GetAuditLogsCount(cond *postgres.AuditLogCondition) (int64, error) {
+	if mock.GetAuditLogsCountFunc == nil {
+		panic("PostgresMock.GetAuditLogsCountFunc: method is nil but Postgres.GetAuditLogsCount was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.AuditLogCondition
+	}{
+		Cond: cond,
+	}
+	mock.lockGetAuditLogsCount.Lock()
+	mock.calls.GetAuditLogsCount = append(mock.calls.GetAuditLogsCount, callInfo)
+	mock.lockGetAuditLogsCount.Unlock()
+	return mock.GetAuditLogsCountFunc(cond)
+}
+
+// GetAuditLogsCountCalls gets all the calls that were made to GetAuditLogsCount.
+// Check the length with:
+//     len(mockedPostgres.GetAuditLogsCountCalls())
+func (mock *PostgresMock) GetAuditLogsCountCalls() []struct {
+	Cond *postgres.AuditLogCondition
+} {
+	var calls []struct {
+		Cond *postgres.AuditLogCondition
+	}
+	mock.lockGetAuditLogsCount.RLock()
+	calls = mock.calls.GetAuditLogsCount
+	mock.lockGetAuditLogsCount.RUnlock()
+	return calls
+}
+
 // GetCoinByID calls GetCoinByIDFunc.
 func (mock *PostgresMock) GetCoinByID(id uuid.UUID) (*dmodels.Coin, error) {
 	if mock.GetCoinByIDFunc == nil {
@@ -660,6 +1633,111 @@ func (mock *PostgresMock) GetCoinsCountCalls() []struct {
 	return calls
 }
 
+// GetCurrencyRateAt calls GetCurrencyRateAtFunc.
+func (mock *PostgresMock) GetCurrencyRateAt(currency string, t time.Time) (*dmodels.CurrencyRate, error) {
+	if mock.GetCurrencyRateAtFunc == nil {
+		panic("PostgresMock.GetCurrencyRateAtFunc: method is nil but Postgres.GetCurrencyRateAt was just called")
+	}
+	callInfo := struct {
+		Currency string
+		T        time.Time
+	}{
+		Currency: currency,
+		T:        t,
+	}
+	mock.lockGetCurrencyRateAt.Lock()
+	mock.calls.GetCurrencyRateAt = append(mock.calls.GetCurrencyRateAt, callInfo)
+	mock.lockGetCurrencyRateAt.Unlock()
+	return mock.GetCurrencyRateAtFunc(currency, t)
+}
+
+// GetCurrencyRateAtCalls gets all the calls that were made to GetCurrencyRateAt.
+// Check the length with:
+//     len(mockedPostgres.GetCurrencyRateAtCalls())
+func (mock *PostgresMock) GetCurrencyRateAtCalls() []struct {
+	Currency string
+	T        time.Time
+} {
+	var calls []struct {
+		Currency string
+		T        time.Time
+	}
+	mock.lockGetCurrencyRateAt.RLock()
+	calls = mock.calls.GetCurrencyRateAt
+	mock.lockGetCurrencyRateAt.RUnlock()
+	return calls
+}
+
+// GetCurrencyRatesBetween calls GetCurrencyRatesBetweenFunc.
+func (mock *PostgresMock) GetCurrencyRatesBetween(currency string, from time.Time, to time.Time) ([]*dmodels.CurrencyRate, error) {
+	if mock.GetCurrencyRatesBetweenFunc == nil {
+		panic("PostgresMock.GetCurrencyRatesBetweenFunc: method is nil but Postgres.GetCurrencyRatesBetween was just called")
+	}
+	callInfo := struct {
+		Currency string
+		From     time.Time
+		To       time.Time
+	}{
+		Currency: currency,
+		From:     from,
+		To:       to,
+	}
+	mock.lockGetCurrencyRatesBetween.Lock()
+	mock.calls.GetCurrencyRatesBetween = append(mock.calls.GetCurrencyRatesBetween, callInfo)
+	mock.lockGetCurrencyRatesBetween.Unlock()
+	return mock.GetCurrencyRatesBetweenFunc(currency, from, to)
+}
+
+// GetCurrencyRatesBetweenCalls gets all the calls that were made to GetCurrencyRatesBetween.
+// Check the length with:
+//     len(mockedPostgres.GetCurrencyRatesBetweenCalls())
+func (mock *PostgresMock) GetCurrencyRatesBetweenCalls() []struct {
+	Currency string
+	From     time.Time
+	To       time.Time
+} {
+	var calls []struct {
+		Currency string
+		From     time.Time
+		To       time.Time
+	}
+	mock.lockGetCurrencyRatesBetween.RLock()
+	calls = mock.calls.GetCurrencyRatesBetween
+	mock.lockGetCurrencyRatesBetween.RUnlock()
+	return calls
+}
+
+// GetDEFIHistory calls GetDEFIHistoryFunc.
+func (mock *PostgresMock) GetDEFIHistory(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+	if mock.GetDEFIHistoryFunc == nil {
+		panic("PostgresMock.GetDEFIHistoryFunc: method is nil but Postgres.GetDEFIHistory was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.DeFiHistoryCondition
+	}{
+		Cond: cond,
+	}
+	mock.lockGetDEFIHistory.Lock()
+	mock.calls.GetDEFIHistory = append(mock.calls.GetDEFIHistory, callInfo)
+	mock.lockGetDEFIHistory.Unlock()
+	return mock.GetDEFIHistoryFunc(cond)
+}
+
+// GetDEFIHistoryCalls gets all the calls that were made to GetDEFIHistory.
+// Check the length with:
+//     len(mockedPostgres.GetDEFIHistoryCalls())
+func (mock *PostgresMock) GetDEFIHistoryCalls() []struct {
+	Cond *postgres.DeFiHistoryCondition
+} {
+	var calls []struct {
+		Cond *postgres.DeFiHistoryCondition
+	}
+	mock.lockGetDEFIHistory.RLock()
+	calls = mock.calls.GetDEFIHistory
+	mock.lockGetDEFIHistory.RUnlock()
+	return calls
+}
+
 // GetDEFIs calls GetDEFIsFunc.
 func (mock *PostgresMock) GetDEFIs(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 	if mock.GetDEFIsFunc == nil {
@@ -691,6 +1769,37 @@ func (mock *PostgresMock) GetDEFIsCalls() []struct {
 	return calls
 }
 
+// GetDataVersion calls GetDataVersionFunc.
+func (mock *PostgresMock) GetDataVersion(types []string) (time.Time, error) {
+	if mock.GetDataVersionFunc == nil {
+		panic("PostgresMock.GetDataVersionFunc: method is nil but Postgres.GetDataVersion was just called")
+	}
+	callInfo := struct {
+		Types []string
+	}{
+		Types: types,
+	}
+	mock.lockGetDataVersion.Lock()
+	mock.calls.GetDataVersion = append(mock.calls.GetDataVersion, callInfo)
+	mock.lockGetDataVersion.Unlock()
+	return mock.GetDataVersionFunc(types)
+}
+
+// GetDataVersionCalls gets all the calls that were made to GetDataVersion.
+// Check the length with:
+//     len(mockedPostgres.GetDataVersionCalls())
+func (mock *PostgresMock) GetDataVersionCalls() []struct {
+	Types []string
+} {
+	var calls []struct {
+		Types []string
+	}
+	mock.lockGetDataVersion.RLock()
+	calls = mock.calls.GetDataVersion
+	mock.lockGetDataVersion.RUnlock()
+	return calls
+}
+
 // GetGovernance calls GetGovernanceFunc.
 func (mock *PostgresMock) GetGovernance(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error) {
 	if mock.GetGovernanceFunc == nil {
@@ -714,42 +1823,147 @@ func (mock *PostgresMock) GetGovernanceCalls() []struct {
 	Cond *postgres.GovernanceCondition
 } {
 	var calls []struct {
-		Cond *postgres.GovernanceCondition
+		Cond *postgres.GovernanceCondition
+	}
+	mock.lockGetGovernance.RLock()
+	calls = mock.calls.GetGovernance
+	mock.lockGetGovernance.RUnlock()
+	return calls
+}
+
+// GetGovernanceCount calls GetGovernanceCountFunc.
+func (mock *PostgresMock) GetGovernanceCount(cond *postgres.GovernanceCondition) (int64, error) {
+	if mock.GetGovernanceCountFunc == nil {
+		panic("PostgresMock.GetGovernanceCountFunc: method is nil but Postgres.GetGovernanceCount was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.GovernanceCondition
+	}{
+		Cond: cond,
+	}
+	mock.lockGetGovernanceCount.Lock()
+	mock.calls.GetGovernanceCount = append(mock.calls.GetGovernanceCount, callInfo)
+	mock.lockGetGovernanceCount.Unlock()
+	return mock.GetGovernanceCountFunc(cond)
+}
+
+// GetGovernanceCountCalls gets all the calls that were made to GetGovernanceCount.
+// Check the length with:
+//     len(mockedPostgres.GetGovernanceCountCalls())
+func (mock *PostgresMock) GetGovernanceCountCalls() []struct {
+	Cond *postgres.GovernanceCondition
+} {
+	var calls []struct {
+		Cond *postgres.GovernanceCondition
+	}
+	mock.lockGetGovernanceCount.RLock()
+	calls = mock.calls.GetGovernanceCount
+	mock.lockGetGovernanceCount.RUnlock()
+	return calls
+}
+
+// GetGovernanceSuppliesHistory calls GetGovernanceSuppliesHistoryFunc.
+func (mock *PostgresMock) GetGovernanceSuppliesHistory(governanceIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error) {
+	if mock.GetGovernanceSuppliesHistoryFunc == nil {
+		panic("PostgresMock.GetGovernanceSuppliesHistoryFunc: method is nil but Postgres.GetGovernanceSuppliesHistory was just called")
+	}
+	callInfo := struct {
+		GovernanceIDs []uuid.UUID
+		Aggregate     postgres.Aggregate
+	}{
+		GovernanceIDs: governanceIDs,
+		Aggregate:     aggregate,
+	}
+	mock.lockGetGovernanceSuppliesHistory.Lock()
+	mock.calls.GetGovernanceSuppliesHistory = append(mock.calls.GetGovernanceSuppliesHistory, callInfo)
+	mock.lockGetGovernanceSuppliesHistory.Unlock()
+	return mock.GetGovernanceSuppliesHistoryFunc(governanceIDs, aggregate)
+}
+
+// GetGovernanceSuppliesHistoryCalls gets all the calls that were made to GetGovernanceSuppliesHistory.
+// Check the length with:
+//     len(mockedPostgres.GetGovernanceSuppliesHistoryCalls())
+func (mock *PostgresMock) GetGovernanceSuppliesHistoryCalls() []struct {
+	GovernanceIDs []uuid.UUID
+	Aggregate     postgres.Aggregate
+} {
+	var calls []struct {
+		GovernanceIDs []uuid.UUID
+		Aggregate     postgres.Aggregate
+	}
+	mock.lockGetGovernanceSuppliesHistory.RLock()
+	calls = mock.calls.GetGovernanceSuppliesHistory
+	mock.lockGetGovernanceSuppliesHistory.RUnlock()
+	return calls
+}
+
+// GetGovernanceSupplyAt calls GetGovernanceSupplyAtFunc.
+func (mock *PostgresMock) GetGovernanceSupplyAt(governanceID uuid.UUID, t time.Time) (*dmodels.GovernanceSupply, error) {
+	if mock.GetGovernanceSupplyAtFunc == nil {
+		panic("PostgresMock.GetGovernanceSupplyAtFunc: method is nil but Postgres.GetGovernanceSupplyAt was just called")
+	}
+	callInfo := struct {
+		GovernanceID uuid.UUID
+		T            time.Time
+	}{
+		GovernanceID: governanceID,
+		T:            t,
+	}
+	mock.lockGetGovernanceSupplyAt.Lock()
+	mock.calls.GetGovernanceSupplyAt = append(mock.calls.GetGovernanceSupplyAt, callInfo)
+	mock.lockGetGovernanceSupplyAt.Unlock()
+	return mock.GetGovernanceSupplyAtFunc(governanceID, t)
+}
+
+// GetGovernanceSupplyAtCalls gets all the calls that were made to GetGovernanceSupplyAt.
+// Check the length with:
+//     len(mockedPostgres.GetGovernanceSupplyAtCalls())
+func (mock *PostgresMock) GetGovernanceSupplyAtCalls() []struct {
+	GovernanceID uuid.UUID
+	T            time.Time
+} {
+	var calls []struct {
+		GovernanceID uuid.UUID
+		T            time.Time
 	}
-	mock.lockGetGovernance.RLock()
-	calls = mock.calls.GetGovernance
-	mock.lockGetGovernance.RUnlock()
+	mock.lockGetGovernanceSupplyAt.RLock()
+	calls = mock.calls.GetGovernanceSupplyAt
+	mock.lockGetGovernanceSupplyAt.RUnlock()
 	return calls
 }
 
-// GetGovernanceCount calls GetGovernanceCountFunc.
-func (mock *PostgresMock) GetGovernanceCount(cond *postgres.GovernanceCondition) (int64, error) {
-	if mock.GetGovernanceCountFunc == nil {
-		panic("PostgresMock.GetGovernanceCountFunc: method is nil but Postgres.GetGovernanceCount was just called")
+// GetGovernanceSupplyHistory calls GetGovernanceSupplyHistoryFunc.
+func (mock *PostgresMock) GetGovernanceSupplyHistory(governanceID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error) {
+	if mock.GetGovernanceSupplyHistoryFunc == nil {
+		panic("PostgresMock.GetGovernanceSupplyHistoryFunc: method is nil but Postgres.GetGovernanceSupplyHistory was just called")
 	}
 	callInfo := struct {
-		Cond *postgres.GovernanceCondition
+		GovernanceID uuid.UUID
+		Aggregate    postgres.Aggregate
 	}{
-		Cond: cond,
+		GovernanceID: governanceID,
+		Aggregate:    aggregate,
 	}
-	mock.lockGetGovernanceCount.Lock()
-	mock.calls.GetGovernanceCount = append(mock.calls.GetGovernanceCount, callInfo)
-	mock.lockGetGovernanceCount.Unlock()
-	return mock.GetGovernanceCountFunc(cond)
+	mock.lockGetGovernanceSupplyHistory.Lock()
+	mock.calls.GetGovernanceSupplyHistory = append(mock.calls.GetGovernanceSupplyHistory, callInfo)
+	mock.lockGetGovernanceSupplyHistory.Unlock()
+	return mock.GetGovernanceSupplyHistoryFunc(governanceID, aggregate)
 }
 
-// GetGovernanceCountCalls gets all the calls that were made to GetGovernanceCount.
+// GetGovernanceSupplyHistoryCalls gets all the calls that were made to GetGovernanceSupplyHistory.
 // Check the length with:
-//     len(mockedPostgres.GetGovernanceCountCalls())
-func (mock *PostgresMock) GetGovernanceCountCalls() []struct {
-	Cond *postgres.GovernanceCondition
+//     len(mockedPostgres.GetGovernanceSupplyHistoryCalls())
+func (mock *PostgresMock) GetGovernanceSupplyHistoryCalls() []struct {
+	GovernanceID uuid.UUID
+	Aggregate    postgres.Aggregate
 } {
 	var calls []struct {
-		Cond *postgres.GovernanceCondition
+		GovernanceID uuid.UUID
+		Aggregate    postgres.Aggregate
 	}
-	mock.lockGetGovernanceCount.RLock()
-	calls = mock.calls.GetGovernanceCount
-	mock.lockGetGovernanceCount.RUnlock()
+	mock.lockGetGovernanceSupplyHistory.RLock()
+	calls = mock.calls.GetGovernanceSupplyHistory
+	mock.lockGetGovernanceSupplyHistory.RUnlock()
 	return calls
 }
 
@@ -850,6 +2064,68 @@ func (mock *PostgresMock) GetLastPoolDataWithApyForTenEpochCalls() []struct {
 	return calls
 }
 
+// GetLastPoolPeg calls GetLastPoolPegFunc.
+func (mock *PostgresMock) GetLastPoolPeg(poolID uuid.UUID) (*dmodels.PoolPeg, error) {
+	if mock.GetLastPoolPegFunc == nil {
+		panic("PostgresMock.GetLastPoolPegFunc: method is nil but Postgres.GetLastPoolPeg was just called")
+	}
+	callInfo := struct {
+		PoolID uuid.UUID
+	}{
+		PoolID: poolID,
+	}
+	mock.lockGetLastPoolPeg.Lock()
+	mock.calls.GetLastPoolPeg = append(mock.calls.GetLastPoolPeg, callInfo)
+	mock.lockGetLastPoolPeg.Unlock()
+	return mock.GetLastPoolPegFunc(poolID)
+}
+
+// GetLastPoolPegCalls gets all the calls that were made to GetLastPoolPeg.
+// Check the length with:
+//     len(mockedPostgres.GetLastPoolPegCalls())
+func (mock *PostgresMock) GetLastPoolPegCalls() []struct {
+	PoolID uuid.UUID
+} {
+	var calls []struct {
+		PoolID uuid.UUID
+	}
+	mock.lockGetLastPoolPeg.RLock()
+	calls = mock.calls.GetLastPoolPeg
+	mock.lockGetLastPoolPeg.RUnlock()
+	return calls
+}
+
+// GetLastPoolsData calls GetLastPoolsDataFunc.
+func (mock *PostgresMock) GetLastPoolsData(poolIDs []uuid.UUID) ([]*dmodels.PoolData, error) {
+	if mock.GetLastPoolsDataFunc == nil {
+		panic("PostgresMock.GetLastPoolsDataFunc: method is nil but Postgres.GetLastPoolsData was just called")
+	}
+	callInfo := struct {
+		PoolIDs []uuid.UUID
+	}{
+		PoolIDs: poolIDs,
+	}
+	mock.lockGetLastPoolsData.Lock()
+	mock.calls.GetLastPoolsData = append(mock.calls.GetLastPoolsData, callInfo)
+	mock.lockGetLastPoolsData.Unlock()
+	return mock.GetLastPoolsDataFunc(poolIDs)
+}
+
+// GetLastPoolsDataCalls gets all the calls that were made to GetLastPoolsData.
+// Check the length with:
+//     len(mockedPostgres.GetLastPoolsDataCalls())
+func (mock *PostgresMock) GetLastPoolsDataCalls() []struct {
+	PoolIDs []uuid.UUID
+} {
+	var calls []struct {
+		PoolIDs []uuid.UUID
+	}
+	mock.lockGetLastPoolsData.RLock()
+	calls = mock.calls.GetLastPoolsData
+	mock.lockGetLastPoolsData.RUnlock()
+	return calls
+}
+
 // GetLiquidityPool calls GetLiquidityPoolFunc.
 func (mock *PostgresMock) GetLiquidityPool(cond *postgres.Condition) (*dmodels.LiquidityPool, error) {
 	if mock.GetLiquidityPoolFunc == nil {
@@ -1005,6 +2281,76 @@ func (mock *PostgresMock) GetPoolCountCalls() []struct {
 	return calls
 }
 
+// GetPoolExchangeRate calls GetPoolExchangeRateFunc.
+func (mock *PostgresMock) GetPoolExchangeRate(poolID uuid.UUID, epoch uint64) (*dmodels.PoolExchangeRate, error) {
+	if mock.GetPoolExchangeRateFunc == nil {
+		panic("PostgresMock.GetPoolExchangeRateFunc: method is nil but Postgres.GetPoolExchangeRate was just called")
+	}
+	callInfo := struct {
+		PoolID uuid.UUID
+		Epoch  uint64
+	}{
+		PoolID: poolID,
+		Epoch:  epoch,
+	}
+	mock.lockGetPoolExchangeRate.Lock()
+	mock.calls.GetPoolExchangeRate = append(mock.calls.GetPoolExchangeRate, callInfo)
+	mock.lockGetPoolExchangeRate.Unlock()
+	return mock.GetPoolExchangeRateFunc(poolID, epoch)
+}
+
+// GetPoolExchangeRateCalls gets all the calls that were made to GetPoolExchangeRate.
+// Check the length with:
+//     len(mockedPostgres.GetPoolExchangeRateCalls())
+func (mock *PostgresMock) GetPoolExchangeRateCalls() []struct {
+	PoolID uuid.UUID
+	Epoch  uint64
+} {
+	var calls []struct {
+		PoolID uuid.UUID
+		Epoch  uint64
+	}
+	mock.lockGetPoolExchangeRate.RLock()
+	calls = mock.calls.GetPoolExchangeRate
+	mock.lockGetPoolExchangeRate.RUnlock()
+	return calls
+}
+
+// GetPoolPegs calls GetPoolPegsFunc.
+func (mock *PostgresMock) GetPoolPegs(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolPeg, error) {
+	if mock.GetPoolPegsFunc == nil {
+		panic("PostgresMock.GetPoolPegsFunc: method is nil but Postgres.GetPoolPegs was just called")
+	}
+	callInfo := struct {
+		PoolID    uuid.UUID
+		Aggregate postgres.Aggregate
+	}{
+		PoolID:    poolID,
+		Aggregate: aggregate,
+	}
+	mock.lockGetPoolPegs.Lock()
+	mock.calls.GetPoolPegs = append(mock.calls.GetPoolPegs, callInfo)
+	mock.lockGetPoolPegs.Unlock()
+	return mock.GetPoolPegsFunc(poolID, aggregate)
+}
+
+// GetPoolPegsCalls gets all the calls that were made to GetPoolPegs.
+// Check the length with:
+//     len(mockedPostgres.GetPoolPegsCalls())
+func (mock *PostgresMock) GetPoolPegsCalls() []struct {
+	PoolID    uuid.UUID
+	Aggregate postgres.Aggregate
+} {
+	var calls []struct {
+		PoolID    uuid.UUID
+		Aggregate postgres.Aggregate
+	}
+	mock.lockGetPoolPegs.RLock()
+	calls = mock.calls.GetPoolPegs
+	mock.lockGetPoolPegs.RUnlock()
+	return calls
+}
+
 // GetPoolStatistic calls GetPoolStatisticFunc.
 func (mock *PostgresMock) GetPoolStatistic(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error) {
 	if mock.GetPoolStatisticFunc == nil {
@@ -1106,6 +2452,150 @@ func (mock *PostgresMock) GetPoolsCalls() []struct {
 	return calls
 }
 
+// GetPoolsExchangeRateWindows calls GetPoolsExchangeRateWindowsFunc.
+func (mock *PostgresMock) GetPoolsExchangeRateWindows(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error) {
+	if mock.GetPoolsExchangeRateWindowsFunc == nil {
+		panic("PostgresMock.GetPoolsExchangeRateWindowsFunc: method is nil but Postgres.GetPoolsExchangeRateWindows was just called")
+	}
+	callInfo := struct {
+		Epochs       map[uuid.UUID]uint64
+		EpochWindows []uint64
+		DayWindows   []uint64
+	}{
+		Epochs:       epochs,
+		EpochWindows: epochWindows,
+		DayWindows:   dayWindows,
+	}
+	mock.lockGetPoolsExchangeRateWindows.Lock()
+	mock.calls.GetPoolsExchangeRateWindows = append(mock.calls.GetPoolsExchangeRateWindows, callInfo)
+	mock.lockGetPoolsExchangeRateWindows.Unlock()
+	return mock.GetPoolsExchangeRateWindowsFunc(epochs, epochWindows, dayWindows)
+}
+
+// GetPoolsExchangeRateWindowsCalls gets all the calls that were made to GetPoolsExchangeRateWindows.
+// Check the length with:
+//     len(mockedPostgres.GetPoolsExchangeRateWindowsCalls())
+func (mock *PostgresMock) GetPoolsExchangeRateWindowsCalls() []struct {
+	Epochs       map[uuid.UUID]uint64
+	EpochWindows []uint64
+	DayWindows   []uint64
+} {
+	var calls []struct {
+		Epochs       map[uuid.UUID]uint64
+		EpochWindows []uint64
+		DayWindows   []uint64
+	}
+	mock.lockGetPoolsExchangeRateWindows.RLock()
+	calls = mock.calls.GetPoolsExchangeRateWindows
+	mock.lockGetPoolsExchangeRateWindows.RUnlock()
+	return calls
+}
+
+// GetPoolsStatistic calls GetPoolsStatisticFunc.
+func (mock *PostgresMock) GetPoolsStatistic(poolIDs []uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error) {
+	if mock.GetPoolsStatisticFunc == nil {
+		panic("PostgresMock.GetPoolsStatisticFunc: method is nil but Postgres.GetPoolsStatistic was just called")
+	}
+	callInfo := struct {
+		PoolIDs   []uuid.UUID
+		Aggregate postgres.Aggregate
+	}{
+		PoolIDs:   poolIDs,
+		Aggregate: aggregate,
+	}
+	mock.lockGetPoolsStatistic.Lock()
+	mock.calls.GetPoolsStatistic = append(mock.calls.GetPoolsStatistic, callInfo)
+	mock.lockGetPoolsStatistic.Unlock()
+	return mock.GetPoolsStatisticFunc(poolIDs, aggregate)
+}
+
+// GetPoolsStatisticCalls gets all the calls that were made to GetPoolsStatistic.
+// Check the length with:
+//     len(mockedPostgres.GetPoolsStatisticCalls())
+func (mock *PostgresMock) GetPoolsStatisticCalls() []struct {
+	PoolIDs   []uuid.UUID
+	Aggregate postgres.Aggregate
+} {
+	var calls []struct {
+		PoolIDs   []uuid.UUID
+		Aggregate postgres.Aggregate
+	}
+	mock.lockGetPoolsStatistic.RLock()
+	calls = mock.calls.GetPoolsStatistic
+	mock.lockGetPoolsStatistic.RUnlock()
+	return calls
+}
+
+// GetPriceHistory calls GetPriceHistoryFunc.
+func (mock *PostgresMock) GetPriceHistory(assetID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PriceHistory, error) {
+	if mock.GetPriceHistoryFunc == nil {
+		panic("PostgresMock.GetPriceHistoryFunc: method is nil but Postgres.GetPriceHistory was just called")
+	}
+	callInfo := struct {
+		AssetID   uuid.UUID
+		Aggregate postgres.Aggregate
+	}{
+		AssetID:   assetID,
+		Aggregate: aggregate,
+	}
+	mock.lockGetPriceHistory.Lock()
+	mock.calls.GetPriceHistory = append(mock.calls.GetPriceHistory, callInfo)
+	mock.lockGetPriceHistory.Unlock()
+	return mock.GetPriceHistoryFunc(assetID, aggregate)
+}
+
+// GetPriceHistoryCalls gets all the calls that were made to GetPriceHistory.
+// Check the length with:
+//     len(mockedPostgres.GetPriceHistoryCalls())
+func (mock *PostgresMock) GetPriceHistoryCalls() []struct {
+	AssetID   uuid.UUID
+	Aggregate postgres.Aggregate
+} {
+	var calls []struct {
+		AssetID   uuid.UUID
+		Aggregate postgres.Aggregate
+	}
+	mock.lockGetPriceHistory.RLock()
+	calls = mock.calls.GetPriceHistory
+	mock.lockGetPriceHistory.RUnlock()
+	return calls
+}
+
+// GetPricesAt calls GetPricesAtFunc.
+func (mock *PostgresMock) GetPricesAt(assetIDs []uuid.UUID, points []time.Time) ([]*dmodels.PriceHistory, error) {
+	if mock.GetPricesAtFunc == nil {
+		panic("PostgresMock.GetPricesAtFunc: method is nil but Postgres.GetPricesAt was just called")
+	}
+	callInfo := struct {
+		AssetIDs []uuid.UUID
+		Points   []time.Time
+	}{
+		AssetIDs: assetIDs,
+		Points:   points,
+	}
+	mock.lockGetPricesAt.Lock()
+	mock.calls.GetPricesAt = append(mock.calls.GetPricesAt, callInfo)
+	mock.lockGetPricesAt.Unlock()
+	return mock.GetPricesAtFunc(assetIDs, points)
+}
+
+// GetPricesAtCalls gets all the calls that were made to GetPricesAt.
+// Check the length with:
+//     len(mockedPostgres.GetPricesAtCalls())
+func (mock *PostgresMock) GetPricesAtCalls() []struct {
+	AssetIDs []uuid.UUID
+	Points   []time.Time
+} {
+	var calls []struct {
+		AssetIDs []uuid.UUID
+		Points   []time.Time
+	}
+	mock.lockGetPricesAt.RLock()
+	calls = mock.calls.GetPricesAt
+	mock.lockGetPricesAt.RUnlock()
+	return calls
+}
+
 // GetSlotTime calls GetSlotTimeFunc.
 func (mock *PostgresMock) GetSlotTime(cond *postgres.SlotTimeCondition) ([]*dmodels.SlotTime, error) {
 	if mock.GetSlotTimeFunc == nil {
@@ -1238,6 +2728,37 @@ func (mock *PostgresMock) GetValidatorCountCalls() []struct {
 	return calls
 }
 
+// GetValidatorData calls GetValidatorDataFunc.
+func (mock *PostgresMock) GetValidatorData(condition *postgres.ValidatorDataCondition) ([]*dmodels.ValidatorData, error) {
+	if mock.GetValidatorDataFunc == nil {
+		panic("PostgresMock.GetValidatorDataFunc: method is nil but Postgres.GetValidatorData was just called")
+	}
+	callInfo := struct {
+		Condition *postgres.ValidatorDataCondition
+	}{
+		Condition: condition,
+	}
+	mock.lockGetValidatorData.Lock()
+	mock.calls.GetValidatorData = append(mock.calls.GetValidatorData, callInfo)
+	mock.lockGetValidatorData.Unlock()
+	return mock.GetValidatorDataFunc(condition)
+}
+
+// GetValidatorDataCalls gets all the calls that were made to GetValidatorData.
+// Check the length with:
+//     len(mockedPostgres.GetValidatorDataCalls())
+func (mock *PostgresMock) GetValidatorDataCalls() []struct {
+	Condition *postgres.ValidatorDataCondition
+} {
+	var calls []struct {
+		Condition *postgres.ValidatorDataCondition
+	}
+	mock.lockGetValidatorData.RLock()
+	calls = mock.calls.GetValidatorData
+	mock.lockGetValidatorData.RUnlock()
+	return calls
+}
+
 // GetValidatorDataCount calls GetValidatorDataCountFunc.
 func (mock *PostgresMock) GetValidatorDataCount(condition *postgres.PoolValidatorDataCondition, epoch uint64) (int64, error) {
 	if mock.GetValidatorDataCountFunc == nil {
@@ -1273,6 +2794,37 @@ func (mock *PostgresMock) GetValidatorDataCountCalls() []struct {
 	return calls
 }
 
+// GetValidatorDataCounts calls GetValidatorDataCountsFunc.
+func (mock *PostgresMock) GetValidatorDataCounts(poolDataIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	if mock.GetValidatorDataCountsFunc == nil {
+		panic("PostgresMock.GetValidatorDataCountsFunc: method is nil but Postgres.GetValidatorDataCounts was just called")
+	}
+	callInfo := struct {
+		PoolDataIDs []uuid.UUID
+	}{
+		PoolDataIDs: poolDataIDs,
+	}
+	mock.lockGetValidatorDataCounts.Lock()
+	mock.calls.GetValidatorDataCounts = append(mock.calls.GetValidatorDataCounts, callInfo)
+	mock.lockGetValidatorDataCounts.Unlock()
+	return mock.GetValidatorDataCountsFunc(poolDataIDs)
+}
+
+// GetValidatorDataCountsCalls gets all the calls that were made to GetValidatorDataCounts.
+// Check the length with:
+//     len(mockedPostgres.GetValidatorDataCountsCalls())
+func (mock *PostgresMock) GetValidatorDataCountsCalls() []struct {
+	PoolDataIDs []uuid.UUID
+} {
+	var calls []struct {
+		PoolDataIDs []uuid.UUID
+	}
+	mock.lockGetValidatorDataCounts.RLock()
+	calls = mock.calls.GetValidatorDataCounts
+	mock.lockGetValidatorDataCounts.RUnlock()
+	return calls
+}
+
 // GetValidators calls GetValidatorsFunc.
 func (mock *PostgresMock) GetValidators(condition *postgres.ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error) {
 	if mock.GetValidatorsFunc == nil {
@@ -1308,6 +2860,71 @@ func (mock *PostgresMock) GetValidatorsCalls() []struct {
 	return calls
 }
 
+// GetValidatorsDelinquency calls GetValidatorsDelinquencyFunc.
+func (mock *PostgresMock) GetValidatorsDelinquency() (map[string]bool, error) {
+	if mock.GetValidatorsDelinquencyFunc == nil {
+		panic("PostgresMock.GetValidatorsDelinquencyFunc: method is nil but Postgres.GetValidatorsDelinquency was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetValidatorsDelinquency.Lock()
+	mock.calls.GetValidatorsDelinquency = append(mock.calls.GetValidatorsDelinquency, callInfo)
+	mock.lockGetValidatorsDelinquency.Unlock()
+	return mock.GetValidatorsDelinquencyFunc()
+}
+
+// GetValidatorsDelinquencyCalls gets all the calls that were made to GetValidatorsDelinquency.
+// Check the length with:
+//     len(mockedPostgres.GetValidatorsDelinquencyCalls())
+func (mock *PostgresMock) GetValidatorsDelinquencyCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetValidatorsDelinquency.RLock()
+	calls = mock.calls.GetValidatorsDelinquency
+	mock.lockGetValidatorsDelinquency.RUnlock()
+	return calls
+}
+
+// ReplaceDEFIs calls ReplaceDEFIsFunc.
+func (mock *PostgresMock) ReplaceDEFIs(liquidityPoolID uuid.UUID, defiData []*dmodels.DEFI, history []*dmodels.DEFIHistory) error {
+	if mock.ReplaceDEFIsFunc == nil {
+		panic("PostgresMock.ReplaceDEFIsFunc: method is nil but Postgres.ReplaceDEFIs was just called")
+	}
+	callInfo := struct {
+		LiquidityPoolID uuid.UUID
+		DefiData        []*dmodels.DEFI
+		History         []*dmodels.DEFIHistory
+	}{
+		LiquidityPoolID: liquidityPoolID,
+		DefiData:        defiData,
+		History:         history,
+	}
+	mock.lockReplaceDEFIs.Lock()
+	mock.calls.ReplaceDEFIs = append(mock.calls.ReplaceDEFIs, callInfo)
+	mock.lockReplaceDEFIs.Unlock()
+	return mock.ReplaceDEFIsFunc(liquidityPoolID, defiData, history)
+}
+
+// ReplaceDEFIsCalls gets all the calls that were made to ReplaceDEFIs.
+// Check the length with:
+//     len(mockedPostgres.ReplaceDEFIsCalls())
+func (mock *PostgresMock) ReplaceDEFIsCalls() []struct {
+	LiquidityPoolID uuid.UUID
+	DefiData        []*dmodels.DEFI
+	History         []*dmodels.DEFIHistory
+} {
+	var calls []struct {
+		LiquidityPoolID uuid.UUID
+		DefiData        []*dmodels.DEFI
+		History         []*dmodels.DEFIHistory
+	}
+	mock.lockReplaceDEFIs.RLock()
+	calls = mock.calls.ReplaceDEFIs
+	mock.lockReplaceDEFIs.RUnlock()
+	return calls
+}
+
 // SaveCoin calls SaveCoinFunc.
 func (mock *PostgresMock) SaveCoin(coin ...*dmodels.Coin) error {
 	if mock.SaveCoinFunc == nil {
@@ -1401,6 +3018,130 @@ func (mock *PostgresMock) SaveGovernanceCalls() []struct {
 	return calls
 }
 
+// SavePoolExchangeRate calls SavePoolExchangeRateFunc.
+func (mock *PostgresMock) SavePoolExchangeRate(rate *dmodels.PoolExchangeRate) error {
+	if mock.SavePoolExchangeRateFunc == nil {
+		panic("PostgresMock.SavePoolExchangeRateFunc: method is nil but Postgres.SavePoolExchangeRate was just called")
+	}
+	callInfo := struct {
+		Rate *dmodels.PoolExchangeRate
+	}{
+		Rate: rate,
+	}
+	mock.lockSavePoolExchangeRate.Lock()
+	mock.calls.SavePoolExchangeRate = append(mock.calls.SavePoolExchangeRate, callInfo)
+	mock.lockSavePoolExchangeRate.Unlock()
+	return mock.SavePoolExchangeRateFunc(rate)
+}
+
+// SavePoolExchangeRateCalls gets all the calls that were made to SavePoolExchangeRate.
+// Check the length with:
+//     len(mockedPostgres.SavePoolExchangeRateCalls())
+func (mock *PostgresMock) SavePoolExchangeRateCalls() []struct {
+	Rate *dmodels.PoolExchangeRate
+} {
+	var calls []struct {
+		Rate *dmodels.PoolExchangeRate
+	}
+	mock.lockSavePoolExchangeRate.RLock()
+	calls = mock.calls.SavePoolExchangeRate
+	mock.lockSavePoolExchangeRate.RUnlock()
+	return calls
+}
+
+// Search calls SearchFunc.
+func (mock *PostgresMock) Search(cond *postgres.SearchCondition) ([]*dmodels.SearchResult, error) {
+	if mock.SearchFunc == nil {
+		panic("PostgresMock.SearchFunc: method is nil but Postgres.Search was just called")
+	}
+	callInfo := struct {
+		Cond *postgres.SearchCondition
+	}{
+		Cond: cond,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	return mock.SearchFunc(cond)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+// Check the length with:
+//     len(mockedPostgres.SearchCalls())
+func (mock *PostgresMock) SearchCalls() []struct {
+	Cond *postgres.SearchCondition
+} {
+	var calls []struct {
+		Cond *postgres.SearchCondition
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
+
+// TouchDataVersions calls TouchDataVersionsFunc.
+func (mock *PostgresMock) TouchDataVersions(types ...string) error {
+	if mock.TouchDataVersionsFunc == nil {
+		panic("PostgresMock.TouchDataVersionsFunc: method is nil but Postgres.TouchDataVersions was just called")
+	}
+	callInfo := struct {
+		Types []string
+	}{
+		Types: types,
+	}
+	mock.lockTouchDataVersions.Lock()
+	mock.calls.TouchDataVersions = append(mock.calls.TouchDataVersions, callInfo)
+	mock.lockTouchDataVersions.Unlock()
+	return mock.TouchDataVersionsFunc(types...)
+}
+
+// TouchDataVersionsCalls gets all the calls that were made to TouchDataVersions.
+// Check the length with:
+//     len(mockedPostgres.TouchDataVersionsCalls())
+func (mock *PostgresMock) TouchDataVersionsCalls() []struct {
+	Types []string
+} {
+	var calls []struct {
+		Types []string
+	}
+	mock.lockTouchDataVersions.RLock()
+	calls = mock.calls.TouchDataVersions
+	mock.lockTouchDataVersions.RUnlock()
+	return calls
+}
+
+// UpdateLiquidityPoolStatus calls UpdateLiquidityPoolStatusFunc.
+func (mock *PostgresMock) UpdateLiquidityPoolStatus(pool *dmodels.LiquidityPool) error {
+	if mock.UpdateLiquidityPoolStatusFunc == nil {
+		panic("PostgresMock.UpdateLiquidityPoolStatusFunc: method is nil but Postgres.UpdateLiquidityPoolStatus was just called")
+	}
+	callInfo := struct {
+		Pool *dmodels.LiquidityPool
+	}{
+		Pool: pool,
+	}
+	mock.lockUpdateLiquidityPoolStatus.Lock()
+	mock.calls.UpdateLiquidityPoolStatus = append(mock.calls.UpdateLiquidityPoolStatus, callInfo)
+	mock.lockUpdateLiquidityPoolStatus.Unlock()
+	return mock.UpdateLiquidityPoolStatusFunc(pool)
+}
+
+// UpdateLiquidityPoolStatusCalls gets all the calls that were made to UpdateLiquidityPoolStatus.
+// Check the length with:
+//     len(mockedPostgres.UpdateLiquidityPoolStatusCalls())
+func (mock *PostgresMock) UpdateLiquidityPoolStatusCalls() []struct {
+	Pool *dmodels.LiquidityPool
+} {
+	var calls []struct {
+		Pool *dmodels.LiquidityPool
+	}
+	mock.lockUpdateLiquidityPoolStatus.RLock()
+	calls = mock.calls.UpdateLiquidityPoolStatus
+	mock.lockUpdateLiquidityPoolStatus.RUnlock()
+	return calls
+}
+
 // UpdatePoolData calls UpdatePoolDataFunc.
 func (mock *PostgresMock) UpdatePoolData(poolData *dmodels.PoolData) error {
 	if mock.UpdatePoolDataFunc == nil {
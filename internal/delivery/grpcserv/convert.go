@@ -0,0 +1,160 @@
+package grpcserv
+
+import (
+	"github.com/everstake/solana-pools/internal/delivery/grpcserv/pb"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newPool(p *smodels.Pool) *pb.Pool {
+	return &pb.Pool{
+		Address:          p.Address,
+		Name:             p.Name,
+		Image:            p.Image,
+		Currency:         p.Currency,
+		ActiveStake:      p.ActiveStake.InexactFloat64(),
+		TokensSupply:     p.TokensSupply.InexactFloat64(),
+		TotalSol:         p.TotalLamports.InexactFloat64(),
+		Apy:              p.APY.InexactFloat64(),
+		AvgSkippedSlots:  p.AVGSkippedSlots.InexactFloat64(),
+		AvgScore:         p.AVGScore,
+		StakingAccounts:  p.StakingAccounts,
+		Delinquent:       p.Delinquent,
+		UnstakeLiquidity: p.UnstakeLiquidity.InexactFloat64(),
+		DepositFee:       p.DepossitFee.InexactFloat64(),
+		WithdrawalFee:    p.WithdrawalFee.InexactFloat64(),
+		RewardsFee:       p.RewardsFee.InexactFloat64(),
+		Validators:       p.ValidatorCount,
+		CreatedAt:        timestamppb.New(p.CreatedAt),
+	}
+}
+
+func newValidator(v *smodels.Validator) *pb.Validator {
+	return &pb.Validator{
+		VotePk:           v.VotePK,
+		NodePk:           v.NodePK,
+		Name:             v.Name,
+		Image:            v.Image,
+		Delinquent:       v.Delinquent,
+		Apy:              v.APY.InexactFloat64(),
+		TotalActiveStake: v.TotalActiveStake.InexactFloat64(),
+		StakingAccounts:  v.StakingAccounts,
+		Fee:              v.Fee.InexactFloat64(),
+		Score:            v.Score,
+		SkippedSlots:     v.SkippedSlots.InexactFloat64(),
+		DataCenter:       v.DataCenter,
+		Epoch:            v.Epoch,
+	}
+}
+
+func newValidatorDetails(v *smodels.ValidatorDetails) *pb.ValidatorDetails {
+	details := &pb.ValidatorDetails{
+		Validator: newValidator(&v.Validator),
+		History:   make([]*pb.ValidatorEpoch, len(v.History)),
+		Pools:     make([]*pb.ValidatorPool, len(v.Pools)),
+	}
+	for i, e := range v.History {
+		details.History[i] = &pb.ValidatorEpoch{
+			Epoch:           e.Epoch,
+			Apy:             e.APY.InexactFloat64(),
+			StakingAccounts: e.StakingAccounts,
+			ActiveStake:     e.ActiveStake.InexactFloat64(),
+			Fee:             e.Fee.InexactFloat64(),
+			Score:           e.Score,
+			SkippedSlots:    e.SkippedSlots.InexactFloat64(),
+			CreatedAt:       timestamppb.New(e.CreatedAt),
+		}
+	}
+	for i, p := range v.Pools {
+		details.Pools[i] = &pb.ValidatorPool{
+			Name:        p.Name,
+			Address:     p.Address,
+			Image:       p.Image,
+			ActiveStake: p.ActiveStake.InexactFloat64(),
+			PoolShare:   p.PoolShare.InexactFloat64(),
+		}
+	}
+	return details
+}
+
+// newValidatorFilter converts the filter like the REST query parameters, with the stakes from SOL to lamports.
+func newValidatorFilter(f *pb.ValidatorFilter) smodels.ValidatorFilter {
+	if f == nil {
+		return smodels.ValidatorFilter{}
+	}
+	return smodels.ValidatorFilter{
+		MinFee:          decimalOrNil(f.MinFee),
+		MaxFee:          decimalOrNil(f.MaxFee),
+		MinAPY:          decimalOrNil(f.MinApy),
+		MaxAPY:          decimalOrNil(f.MaxApy),
+		MinScore:        f.MinScore,
+		MaxSkippedSlots: decimalOrNil(f.MaxSkippedSlots),
+		Delinquent:      f.Delinquent,
+		DataCenters:     f.DataCenters,
+		MinStake:        lamportsOrNil(f.MinStake),
+		MaxStake:        lamportsOrNil(f.MaxStake),
+	}
+}
+
+func decimalOrNil(f *float64) *decimal.Decimal {
+	if f == nil {
+		return nil
+	}
+	d := decimal.NewFromFloat(*f)
+	return &d
+}
+
+func lamportsOrNil(stake *float64) *uint64 {
+	if stake == nil {
+		return nil
+	}
+	l := uint64(decimal.Max(decimal.NewFromFloat(*stake).Shift(9), decimal.Zero).IntPart())
+	return &l
+}
+
+func newPoolValidatorData(v *smodels.PoolValidatorData) *pb.PoolValidatorData {
+	return &pb.PoolValidatorData{
+		Validator: newValidator(&smodels.Validator{
+			Image:            v.Image,
+			Name:             v.Name,
+			Delinquent:       v.Delinquent,
+			StakingAccounts:  v.StakingAccounts,
+			NodePK:           v.NodePK,
+			APY:              v.APY,
+			VotePK:           v.VotePK,
+			TotalActiveStake: v.TotalActiveStake,
+			Fee:              v.Fee,
+			Score:            v.Score,
+			SkippedSlots:     v.SkippedSlots,
+			DataCenter:       v.DataCenter,
+			Epoch:            v.Epoch,
+		}),
+		PoolActiveStake: v.PoolActiveStake.InexactFloat64(),
+	}
+}
+
+func newEpochInfo(e *smodels.EpochInfo) *pb.EpochInfo {
+	return &pb.EpochInfo{
+		Epoch:        e.Epoch,
+		SlotsInEpoch: e.SlotsInEpoch,
+		Sps:          e.SPS,
+		EndEpoch:     timestamppb.New(e.EndEpoch),
+		Progress:     uint32(e.Progress),
+	}
+}
+
+func newStatistic(s *smodels.Statistic) *pb.Statistic {
+	return &pb.Statistic{
+		Pools:            s.Pools,
+		ActiveStake:      s.ActiveStake.InexactFloat64(),
+		TotalSupply:      s.TotalSupply.InexactFloat64(),
+		AvgSkippedSlots:  s.AVGSkippedSlots.InexactFloat64(),
+		MaxPoolsApy:      s.MAXPoolsApy.InexactFloat64(),
+		MaxScore:         s.MAXScore,
+		AvgScore:         s.AVGScore,
+		MinScore:         s.MINScore,
+		Delinquent:       s.Delinquent,
+		UnstakeLiquidity: s.UnstakeLiquidity.InexactFloat64(),
+	}
+}
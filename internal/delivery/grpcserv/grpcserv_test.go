@@ -0,0 +1,190 @@
+package grpcserv_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/delivery/grpcserv"
+	"github.com/everstake/solana-pools/internal/delivery/grpcserv/pb"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/models/sol"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gotest.tools/assert"
+	"net"
+	"sync"
+	"testing"
+)
+
+// newService serves the Eversol pool with the APY apy returns and delivers the events of bus.
+func newService(bus *events.Bus, apy func() float64) *services.ServiceMock {
+	return &services.ServiceMock{
+		GetPoolFunc: func(name string, epoch uint64) (*smodels.PoolDetails, error) {
+			switch name {
+			case "Eversol":
+				return &smodels.PoolDetails{Pool: smodels.Pool{Name: "Eversol", APY: decimal.NewFromFloat(apy())}}, nil
+			case "broken":
+				return nil, fmt.Errorf("DAO.GetPool: connection refused")
+			case "panic":
+				panic("nil pointer dereference")
+			}
+			return nil, fmt.Errorf("DAO.GetPool(%s): %w", name, postgres.ErrorRecordNotFounded)
+		},
+		SubscribeFunc: bus.Subscribe,
+	}
+}
+
+func dial(t *testing.T, svc services.Service) pb.SolanaPoolsClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpcserv.New(config.Env{}, svc, zap.NewNop())
+	srv := grpc.NewServer(s.ServerOptions()...)
+	pb.RegisterSolanaPoolsServer(srv, s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+	)
+	assert.NilError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewSolanaPoolsClient(conn)
+}
+
+func TestGetPool(t *testing.T) {
+	svc := newService(events.NewBus(), func() float64 { return 0.07 })
+	client := dial(t, svc)
+
+	tests := []struct {
+		name  string
+		req   *pb.GetPoolRequest
+		code  codes.Code
+		apy   float64
+		epoch uint64
+	}{
+		{name: "default epoch", req: &pb.GetPoolRequest{Name: "Eversol"}, code: codes.OK, apy: 0.07, epoch: 10},
+		{name: "epoch", req: &pb.GetPoolRequest{Name: "Eversol", Epoch: 1}, code: codes.OK, apy: 0.07, epoch: 1},
+		{name: "not found", req: &pb.GetPoolRequest{Name: "unknown"}, code: codes.NotFound, epoch: 10},
+		{name: "internal", req: &pb.GetPoolRequest{Name: "broken"}, code: codes.Internal, epoch: 10},
+		{name: "panic", req: &pb.GetPoolRequest{Name: "panic"}, code: codes.Internal, epoch: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := len(svc.GetPoolCalls())
+			p, err := client.GetPool(context.Background(), tt.req)
+			assert.Equal(t, status.Code(err), tt.code)
+			assert.Equal(t, len(svc.GetPoolCalls()), calls+1)
+			assert.Equal(t, svc.GetPoolCalls()[calls].Epoch, tt.epoch)
+			if tt.code == codes.OK {
+				assert.Equal(t, p.Apy, tt.apy)
+			}
+		})
+	}
+}
+
+func TestWatchPool(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		apy = 0.07
+	)
+	bus := events.NewBus()
+	client := dial(t, newService(bus, func() float64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return apy
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.WatchPool(ctx, &pb.GetPoolRequest{Name: "Eversol"})
+	assert.NilError(t, err)
+
+	p, err := stream.Recv()
+	assert.NilError(t, err)
+	assert.Equal(t, p.Apy, 0.07)
+
+	// neither events of other pools, even more than a stream buffers, nor an update that changes nothing is sent
+	for i := 0; i < 100; i++ {
+		bus.Publish(events.Event{Topic: events.PoolTopic("Marinade")})
+	}
+	bus.Publish(events.Event{Topic: events.PoolTopic("Eversol")})
+	mu.Lock()
+	apy = 0.08
+	mu.Unlock()
+	bus.Publish(events.Event{Topic: events.PoolTopic("Eversol")})
+
+	p, err = stream.Recv()
+	assert.NilError(t, err)
+	assert.Equal(t, p.Apy, 0.08)
+}
+
+func TestGetValidators(t *testing.T) {
+	svc := &services.ServiceMock{
+		GetAllValidatorsFunc: func(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) ([]*smodels.Validator, uint64, error) {
+			return []*smodels.Validator{{VotePK: "vote"}}, 1, nil
+		},
+		GetPoolValidatorsFunc: func(poolName string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) ([]*smodels.PoolValidatorData, uint64, error) {
+			return nil, 0, nil
+		},
+	}
+	client := dial(t, svc)
+
+	minFee, maxStake, delinquent, minScore := 0.05, 1.5, false, int64(80)
+	filter := &pb.ValidatorFilter{MinFee: &minFee, Delinquent: &delinquent, MinScore: &minScore, MaxStake: &maxStake, DataCenters: []string{"DE"}}
+	resp, err := client.GetValidators(context.Background(), &pb.GetValidatorsRequest{Filter: filter, DelegatedBy: []string{"Eversol"}})
+	assert.NilError(t, err)
+	assert.Equal(t, resp.Validators[0].VotePk, "vote")
+	_, err = client.GetPoolValidators(context.Background(), &pb.GetPoolValidatorsRequest{PoolName: "Eversol", Filter: filter})
+	assert.NilError(t, err)
+	_, err = client.GetValidators(context.Background(), &pb.GetValidatorsRequest{})
+	assert.NilError(t, err)
+
+	got := svc.GetAllValidatorsCalls()[0].Filter
+	assert.Equal(t, got.MinFee.String(), "0.05")
+	assert.Assert(t, got.MaxFee == nil && got.MinAPY == nil && got.MaxAPY == nil && got.MaxSkippedSlots == nil && got.MinStake == nil)
+	assert.Equal(t, *got.Delinquent, false)
+	assert.Equal(t, *got.MinScore, int64(80))
+	assert.Equal(t, *got.MaxStake, uint64(1500000000))
+	assert.DeepEqual(t, got.DataCenters, []string{"DE"})
+	assert.DeepEqual(t, got.DelegatedBy, []string{"Eversol"})
+	assert.Equal(t, *svc.GetPoolValidatorsCalls()[0].Filter.MaxStake, uint64(1500000000))
+	// an unset filter filters nothing
+	assert.DeepEqual(t, svc.GetAllValidatorsCalls()[1].Filter, smodels.ValidatorFilter{})
+}
+
+func TestGetValidator(t *testing.T) {
+	var stake sol.SOL
+	stake.SetLamports(2500000000)
+	svc := &services.ServiceMock{
+		GetValidatorFunc: func(votePK string, epoch uint64, historyLimit uint64) (*smodels.ValidatorDetails, error) {
+			return &smodels.ValidatorDetails{
+				Validator: smodels.Validator{VotePK: votePK},
+				History:   []*smodels.ValidatorEpoch{{Epoch: 300, APY: decimal.NewFromFloat(0.07), ActiveStake: stake}},
+				Pools:     []*smodels.ValidatorPool{{Name: "Eversol", ActiveStake: stake, PoolShare: decimal.NewFromFloat(0.25)}},
+			}, nil
+		},
+	}
+	client := dial(t, svc)
+
+	v, err := client.GetValidator(context.Background(), &pb.GetValidatorRequest{VotePk: "vote"})
+	assert.NilError(t, err)
+	assert.Equal(t, v.Validator.VotePk, "vote")
+	assert.Equal(t, len(v.History), 1)
+	assert.Equal(t, v.History[0].Epoch, uint64(300))
+	assert.Equal(t, v.History[0].ActiveStake, 2.5)
+	assert.Equal(t, len(v.Pools), 1)
+	assert.Equal(t, v.Pools[0].Name, "Eversol")
+	assert.Equal(t, v.Pools[0].PoolShare, 0.25)
+	assert.Equal(t, svc.GetValidatorCalls()[0].HistoryLimit, uint64(10))
+
+	_, err = client.GetValidator(context.Background(), &pb.GetValidatorRequest{VotePk: "vote", HistoryLimit: 3})
+	assert.NilError(t, err)
+	assert.Equal(t, svc.GetValidatorCalls()[1].HistoryLimit, uint64(3))
+}
@@ -0,0 +1,84 @@
+package grpcserv
+
+import (
+	"context"
+	"github.com/everstake/solana-pools/internal/delivery/grpcserv/pb"
+)
+
+func (s *Server) GetEpoch(context.Context, *pb.GetEpochRequest) (*pb.EpochInfo, error) {
+	e, err := s.svc.GetEpoch()
+	if err != nil {
+		return nil, s.status("GetEpoch", err)
+	}
+	return newEpochInfo(e), nil
+}
+
+func (s *Server) GetPool(_ context.Context, req *pb.GetPoolRequest) (*pb.Pool, error) {
+	return s.pool(req)
+}
+
+func (s *Server) pool(req *pb.GetPoolRequest) (*pb.Pool, error) {
+	p, err := s.svc.GetPool(req.Name, epochOrDefault(req.Epoch))
+	if err != nil {
+		return nil, s.status("GetPool", err)
+	}
+	return newPool(&p.Pool), nil
+}
+
+func (s *Server) GetPools(_ context.Context, req *pb.GetPoolsRequest) (*pb.GetPoolsResponse, error) {
+	pools, total, err := s.svc.GetPools(req.Name, sortOrDefault(req.Sort), req.Desc, epochOrDefault(req.Epoch), limitOrDefault(req.Limit), req.Offset)
+	if err != nil {
+		return nil, s.status("GetPools", err)
+	}
+	resp := &pb.GetPoolsResponse{Pools: make([]*pb.Pool, len(pools)), Total: total}
+	for i, p := range pools {
+		resp.Pools[i] = newPool(&p.Pool)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetPoolValidators(_ context.Context, req *pb.GetPoolValidatorsRequest) (*pb.GetPoolValidatorsResponse, error) {
+	validators, total, err := s.svc.GetPoolValidators(req.PoolName, req.ValidatorName, sortOrDefault(req.Sort), req.Desc, epochOrDefault(req.Epoch), newValidatorFilter(req.Filter), limitOrDefault(req.Limit), req.Offset)
+	if err != nil {
+		return nil, s.status("GetPoolValidators", err)
+	}
+	resp := &pb.GetPoolValidatorsResponse{Validators: make([]*pb.PoolValidatorData, len(validators)), Total: total}
+	for i, v := range validators {
+		resp.Validators[i] = newPoolValidatorData(v)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetValidator(_ context.Context, req *pb.GetValidatorRequest) (*pb.ValidatorDetails, error) {
+	v, err := s.svc.GetValidator(req.VotePk, epochOrDefault(req.Epoch), limitOrDefault(req.HistoryLimit))
+	if err != nil {
+		return nil, s.status("GetValidator", err)
+	}
+	return newValidatorDetails(v), nil
+}
+
+func (s *Server) GetValidators(_ context.Context, req *pb.GetValidatorsRequest) (*pb.GetValidatorsResponse, error) {
+	filter := newValidatorFilter(req.Filter)
+	filter.DelegatedBy, filter.Undelegated = req.DelegatedBy, req.Undelegated
+	validators, total, err := s.svc.GetAllValidators(req.Name, sortOrDefault(req.Sort), req.Desc, epochOrDefault(req.Epoch), req.Epochs, filter, limitOrDefault(req.Limit), req.Offset)
+	if err != nil {
+		return nil, s.status("GetValidators", err)
+	}
+	resp := &pb.GetValidatorsResponse{Validators: make([]*pb.Validator, len(validators)), Total: total}
+	for i, v := range validators {
+		resp.Validators[i] = newValidator(v)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetStatistic(_ context.Context, req *pb.GetStatisticRequest) (*pb.Statistic, error) {
+	return s.statistic(req)
+}
+
+func (s *Server) statistic(req *pb.GetStatisticRequest) (*pb.Statistic, error) {
+	st, err := s.svc.GetPoolsCurrentStatistic(epochOrDefault(req.Epoch))
+	if err != nil {
+		return nil, s.status("GetStatistic", err)
+	}
+	return newStatistic(st), nil
+}
@@ -0,0 +1,45 @@
+package grpcserv
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// unaryInterceptor logs every call like the HTTP access log and turns a panic of the handler into Internal.
+func (s *Server) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	start := time.Now()
+	defer func() {
+		err = s.recovered(info.FullMethod, recover(), err)
+		s.logCall(info.FullMethod, start, err)
+	}()
+	return handler(ctx, req)
+}
+
+// streamInterceptor does the same for streams, logging them once they end.
+func (s *Server) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	start := time.Now()
+	defer func() {
+		err = s.recovered(info.FullMethod, recover(), err)
+		s.logCall(info.FullMethod, start, err)
+	}()
+	return handler(srv, stream)
+}
+
+func (s *Server) recovered(method string, r interface{}, err error) error {
+	if r == nil {
+		return err
+	}
+	s.log.Error("gRPC panic", zap.String("method", method), zap.Any("panic", r), zap.Stack("stack"))
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func (s *Server) logCall(method string, start time.Time, err error) {
+	s.log.Info(method,
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("latency", time.Since(start)),
+	)
+}
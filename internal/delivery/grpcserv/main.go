@@ -0,0 +1,86 @@
+package grpcserv
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/delivery/grpcserv/pb"
+	"github.com/everstake/solana-pools/internal/services"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+)
+
+const (
+	defaultEpoch = 10
+	defaultSort  = "apy"
+	defaultLimit = 10
+)
+
+// Server serves the API of the internal backend services over gRPC, next to the HTTP API and on the same service.
+type Server struct {
+	pb.UnimplementedSolanaPoolsServer
+	cfg config.Env
+	svc services.Service
+	log *zap.Logger
+}
+
+func New(cfg config.Env, svc services.Service, log *zap.Logger) *Server {
+	return &Server{
+		cfg: cfg,
+		svc: svc,
+		log: log,
+	}
+}
+
+// ServerOptions installs the logging and recovery interceptors of the server.
+func (s *Server) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(s.unaryInterceptor),
+		grpc.StreamInterceptor(s.streamInterceptor),
+	}
+}
+
+func (s *Server) Run() error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.cfg.GRPCPort))
+	if err != nil {
+		return fmt.Errorf("net.Listen: %w", err)
+	}
+	srv := grpc.NewServer(s.ServerOptions()...)
+	pb.RegisterSolanaPoolsServer(srv, s)
+	s.log.Info("Listening gRPC server", zap.Uint64("port", s.cfg.GRPCPort))
+	return srv.Serve(lis)
+}
+
+// status maps a missing record to NotFound and logs anything else behind a bare Internal code.
+func (s *Server) status(method string, err error) error {
+	if errors.Is(err, postgres.ErrorRecordNotFounded) {
+		return status.Error(codes.NotFound, "not found")
+	}
+	s.log.Error("gRPC "+method, zap.Error(err))
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func epochOrDefault(epoch uint64) uint64 {
+	if epoch == 0 {
+		return defaultEpoch
+	}
+	return epoch
+}
+
+func sortOrDefault(sort string) string {
+	if sort == "" {
+		return defaultSort
+	}
+	return sort
+}
+
+func limitOrDefault(limit uint64) uint64 {
+	if limit == 0 {
+		return defaultLimit
+	}
+	return limit
+}
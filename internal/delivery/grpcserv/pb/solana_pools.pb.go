@@ -0,0 +1,2291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.4
+// source: solana_pools.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image            string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	ActiveStake      float64                `protobuf:"fixed64,5,opt,name=active_stake,json=activeStake,proto3" json:"active_stake,omitempty"`
+	TokensSupply     float64                `protobuf:"fixed64,6,opt,name=tokens_supply,json=tokensSupply,proto3" json:"tokens_supply,omitempty"`
+	TotalSol         float64                `protobuf:"fixed64,7,opt,name=total_sol,json=totalSol,proto3" json:"total_sol,omitempty"`
+	Apy              float64                `protobuf:"fixed64,8,opt,name=apy,proto3" json:"apy,omitempty"`
+	AvgSkippedSlots  float64                `protobuf:"fixed64,9,opt,name=avg_skipped_slots,json=avgSkippedSlots,proto3" json:"avg_skipped_slots,omitempty"`
+	AvgScore         int64                  `protobuf:"varint,10,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
+	StakingAccounts  uint64                 `protobuf:"varint,11,opt,name=staking_accounts,json=stakingAccounts,proto3" json:"staking_accounts,omitempty"`
+	Delinquent       uint64                 `protobuf:"varint,12,opt,name=delinquent,proto3" json:"delinquent,omitempty"`
+	UnstakeLiquidity float64                `protobuf:"fixed64,13,opt,name=unstake_liquidity,json=unstakeLiquidity,proto3" json:"unstake_liquidity,omitempty"`
+	DepositFee       float64                `protobuf:"fixed64,14,opt,name=deposit_fee,json=depositFee,proto3" json:"deposit_fee,omitempty"`
+	WithdrawalFee    float64                `protobuf:"fixed64,15,opt,name=withdrawal_fee,json=withdrawalFee,proto3" json:"withdrawal_fee,omitempty"`
+	RewardsFee       float64                `protobuf:"fixed64,16,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
+	Validators       int64                  `protobuf:"varint,17,opt,name=validators,proto3" json:"validators,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pool) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pool) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Pool) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Pool) GetActiveStake() float64 {
+	if x != nil {
+		return x.ActiveStake
+	}
+	return 0
+}
+
+func (x *Pool) GetTokensSupply() float64 {
+	if x != nil {
+		return x.TokensSupply
+	}
+	return 0
+}
+
+func (x *Pool) GetTotalSol() float64 {
+	if x != nil {
+		return x.TotalSol
+	}
+	return 0
+}
+
+func (x *Pool) GetApy() float64 {
+	if x != nil {
+		return x.Apy
+	}
+	return 0
+}
+
+func (x *Pool) GetAvgSkippedSlots() float64 {
+	if x != nil {
+		return x.AvgSkippedSlots
+	}
+	return 0
+}
+
+func (x *Pool) GetAvgScore() int64 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *Pool) GetStakingAccounts() uint64 {
+	if x != nil {
+		return x.StakingAccounts
+	}
+	return 0
+}
+
+func (x *Pool) GetDelinquent() uint64 {
+	if x != nil {
+		return x.Delinquent
+	}
+	return 0
+}
+
+func (x *Pool) GetUnstakeLiquidity() float64 {
+	if x != nil {
+		return x.UnstakeLiquidity
+	}
+	return 0
+}
+
+func (x *Pool) GetDepositFee() float64 {
+	if x != nil {
+		return x.DepositFee
+	}
+	return 0
+}
+
+func (x *Pool) GetWithdrawalFee() float64 {
+	if x != nil {
+		return x.WithdrawalFee
+	}
+	return 0
+}
+
+func (x *Pool) GetRewardsFee() float64 {
+	if x != nil {
+		return x.RewardsFee
+	}
+	return 0
+}
+
+func (x *Pool) GetValidators() int64 {
+	if x != nil {
+		return x.Validators
+	}
+	return 0
+}
+
+func (x *Pool) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VotePk           string  `protobuf:"bytes,1,opt,name=vote_pk,json=votePk,proto3" json:"vote_pk,omitempty"`
+	NodePk           string  `protobuf:"bytes,2,opt,name=node_pk,json=nodePk,proto3" json:"node_pk,omitempty"`
+	Name             string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Image            string  `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	Delinquent       bool    `protobuf:"varint,5,opt,name=delinquent,proto3" json:"delinquent,omitempty"`
+	Apy              float64 `protobuf:"fixed64,6,opt,name=apy,proto3" json:"apy,omitempty"`
+	TotalActiveStake float64 `protobuf:"fixed64,7,opt,name=total_active_stake,json=totalActiveStake,proto3" json:"total_active_stake,omitempty"`
+	StakingAccounts  uint64  `protobuf:"varint,8,opt,name=staking_accounts,json=stakingAccounts,proto3" json:"staking_accounts,omitempty"`
+	Fee              float64 `protobuf:"fixed64,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	Score            int64   `protobuf:"varint,10,opt,name=score,proto3" json:"score,omitempty"`
+	SkippedSlots     float64 `protobuf:"fixed64,11,opt,name=skipped_slots,json=skippedSlots,proto3" json:"skipped_slots,omitempty"`
+	DataCenter       string  `protobuf:"bytes,12,opt,name=data_center,json=dataCenter,proto3" json:"data_center,omitempty"`
+	Epoch            uint64  `protobuf:"varint,13,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator) ProtoMessage() {}
+
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Validator) GetVotePk() string {
+	if x != nil {
+		return x.VotePk
+	}
+	return ""
+}
+
+func (x *Validator) GetNodePk() string {
+	if x != nil {
+		return x.NodePk
+	}
+	return ""
+}
+
+func (x *Validator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Validator) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Validator) GetDelinquent() bool {
+	if x != nil {
+		return x.Delinquent
+	}
+	return false
+}
+
+func (x *Validator) GetApy() float64 {
+	if x != nil {
+		return x.Apy
+	}
+	return 0
+}
+
+func (x *Validator) GetTotalActiveStake() float64 {
+	if x != nil {
+		return x.TotalActiveStake
+	}
+	return 0
+}
+
+func (x *Validator) GetStakingAccounts() uint64 {
+	if x != nil {
+		return x.StakingAccounts
+	}
+	return 0
+}
+
+func (x *Validator) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Validator) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Validator) GetSkippedSlots() float64 {
+	if x != nil {
+		return x.SkippedSlots
+	}
+	return 0
+}
+
+func (x *Validator) GetDataCenter() string {
+	if x != nil {
+		return x.DataCenter
+	}
+	return ""
+}
+
+func (x *Validator) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+// ValidatorDetails is the validator with its epoch history, newest first, and the pools delegating to it.
+type ValidatorDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator *Validator        `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	History   []*ValidatorEpoch `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	Pools     []*ValidatorPool  `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (x *ValidatorDetails) Reset() {
+	*x = ValidatorDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorDetails) ProtoMessage() {}
+
+func (x *ValidatorDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorDetails.ProtoReflect.Descriptor instead.
+func (*ValidatorDetails) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorDetails) GetValidator() *Validator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *ValidatorDetails) GetHistory() []*ValidatorEpoch {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *ValidatorDetails) GetPools() []*ValidatorPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type ValidatorEpoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch           uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Apy             float64                `protobuf:"fixed64,2,opt,name=apy,proto3" json:"apy,omitempty"`
+	StakingAccounts uint64                 `protobuf:"varint,3,opt,name=staking_accounts,json=stakingAccounts,proto3" json:"staking_accounts,omitempty"`
+	ActiveStake     float64                `protobuf:"fixed64,4,opt,name=active_stake,json=activeStake,proto3" json:"active_stake,omitempty"`
+	Fee             float64                `protobuf:"fixed64,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Score           int64                  `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	SkippedSlots    float64                `protobuf:"fixed64,7,opt,name=skipped_slots,json=skippedSlots,proto3" json:"skipped_slots,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ValidatorEpoch) Reset() {
+	*x = ValidatorEpoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorEpoch) ProtoMessage() {}
+
+func (x *ValidatorEpoch) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorEpoch.ProtoReflect.Descriptor instead.
+func (*ValidatorEpoch) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidatorEpoch) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorEpoch) GetApy() float64 {
+	if x != nil {
+		return x.Apy
+	}
+	return 0
+}
+
+func (x *ValidatorEpoch) GetStakingAccounts() uint64 {
+	if x != nil {
+		return x.StakingAccounts
+	}
+	return 0
+}
+
+func (x *ValidatorEpoch) GetActiveStake() float64 {
+	if x != nil {
+		return x.ActiveStake
+	}
+	return 0
+}
+
+func (x *ValidatorEpoch) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *ValidatorEpoch) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ValidatorEpoch) GetSkippedSlots() float64 {
+	if x != nil {
+		return x.SkippedSlots
+	}
+	return 0
+}
+
+func (x *ValidatorEpoch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ValidatorPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address     string  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Image       string  `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ActiveStake float64 `protobuf:"fixed64,4,opt,name=active_stake,json=activeStake,proto3" json:"active_stake,omitempty"`
+	PoolShare   float64 `protobuf:"fixed64,5,opt,name=pool_share,json=poolShare,proto3" json:"pool_share,omitempty"`
+}
+
+func (x *ValidatorPool) Reset() {
+	*x = ValidatorPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPool) ProtoMessage() {}
+
+func (x *ValidatorPool) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPool.ProtoReflect.Descriptor instead.
+func (*ValidatorPool) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidatorPool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ValidatorPool) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorPool) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ValidatorPool) GetActiveStake() float64 {
+	if x != nil {
+		return x.ActiveStake
+	}
+	return 0
+}
+
+func (x *ValidatorPool) GetPoolShare() float64 {
+	if x != nil {
+		return x.PoolShare
+	}
+	return 0
+}
+
+// ValidatorFilter takes the validator filters of the REST API; unset fields do not filter.
+type ValidatorFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// min_fee and max_fee are fractions.
+	MinFee          *float64 `protobuf:"fixed64,1,opt,name=min_fee,json=minFee,proto3,oneof" json:"min_fee,omitempty"`
+	MaxFee          *float64 `protobuf:"fixed64,2,opt,name=max_fee,json=maxFee,proto3,oneof" json:"max_fee,omitempty"`
+	MinApy          *float64 `protobuf:"fixed64,3,opt,name=min_apy,json=minApy,proto3,oneof" json:"min_apy,omitempty"`
+	MaxApy          *float64 `protobuf:"fixed64,4,opt,name=max_apy,json=maxApy,proto3,oneof" json:"max_apy,omitempty"`
+	MinScore        *int64   `protobuf:"varint,5,opt,name=min_score,json=minScore,proto3,oneof" json:"min_score,omitempty"`
+	MaxSkippedSlots *float64 `protobuf:"fixed64,6,opt,name=max_skipped_slots,json=maxSkippedSlots,proto3,oneof" json:"max_skipped_slots,omitempty"`
+	// delinquent keeps only delinquent validators when true and none when false.
+	Delinquent  *bool    `protobuf:"varint,7,opt,name=delinquent,proto3,oneof" json:"delinquent,omitempty"`
+	DataCenters []string `protobuf:"bytes,8,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
+	// min_stake and max_stake are the active stake in SOL.
+	MinStake *float64 `protobuf:"fixed64,9,opt,name=min_stake,json=minStake,proto3,oneof" json:"min_stake,omitempty"`
+	MaxStake *float64 `protobuf:"fixed64,10,opt,name=max_stake,json=maxStake,proto3,oneof" json:"max_stake,omitempty"`
+}
+
+func (x *ValidatorFilter) Reset() {
+	*x = ValidatorFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorFilter) ProtoMessage() {}
+
+func (x *ValidatorFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorFilter.ProtoReflect.Descriptor instead.
+func (*ValidatorFilter) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidatorFilter) GetMinFee() float64 {
+	if x != nil && x.MinFee != nil {
+		return *x.MinFee
+	}
+	return 0
+}
+
+func (x *ValidatorFilter) GetMaxFee() float64 {
+	if x != nil && x.MaxFee != nil {
+		return *x.MaxFee
+	}
+	return 0
+}
+
+func (x *ValidatorFilter) GetMinApy() float64 {
+	if x != nil && x.MinApy != nil {
+		return *x.MinApy
+	}
+	return 0
+}
+
+func (x *ValidatorFilter) GetMaxApy() float64 {
+	if x != nil && x.MaxApy != nil {
+		return *x.MaxApy
+	}
+	return 0
+}
+
+func (x *ValidatorFilter) GetMinScore() int64 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+func (x *ValidatorFilter) GetMaxSkippedSlots() float64 {
+	if x != nil && x.MaxSkippedSlots != nil {
+		return *x.MaxSkippedSlots
+	}
+	return 0
+}
+
+func (x *ValidatorFilter) GetDelinquent() bool {
+	if x != nil && x.Delinquent != nil {
+		return *x.Delinquent
+	}
+	return false
+}
+
+func (x *ValidatorFilter) GetDataCenters() []string {
+	if x != nil {
+		return x.DataCenters
+	}
+	return nil
+}
+
+func (x *ValidatorFilter) GetMinStake() float64 {
+	if x != nil && x.MinStake != nil {
+		return *x.MinStake
+	}
+	return 0
+}
+
+func (x *ValidatorFilter) GetMaxStake() float64 {
+	if x != nil && x.MaxStake != nil {
+		return *x.MaxStake
+	}
+	return 0
+}
+
+type PoolValidatorData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator       *Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	PoolActiveStake float64    `protobuf:"fixed64,2,opt,name=pool_active_stake,json=poolActiveStake,proto3" json:"pool_active_stake,omitempty"`
+}
+
+func (x *PoolValidatorData) Reset() {
+	*x = PoolValidatorData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolValidatorData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolValidatorData) ProtoMessage() {}
+
+func (x *PoolValidatorData) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolValidatorData.ProtoReflect.Descriptor instead.
+func (*PoolValidatorData) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PoolValidatorData) GetValidator() *Validator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *PoolValidatorData) GetPoolActiveStake() float64 {
+	if x != nil {
+		return x.PoolActiveStake
+	}
+	return 0
+}
+
+type EpochInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch        uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	SlotsInEpoch uint64                 `protobuf:"varint,2,opt,name=slots_in_epoch,json=slotsInEpoch,proto3" json:"slots_in_epoch,omitempty"`
+	Sps          float64                `protobuf:"fixed64,3,opt,name=sps,proto3" json:"sps,omitempty"`
+	EndEpoch     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Progress     uint32                 `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *EpochInfo) Reset() {
+	*x = EpochInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochInfo) ProtoMessage() {}
+
+func (x *EpochInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochInfo.ProtoReflect.Descriptor instead.
+func (*EpochInfo) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EpochInfo) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetSlotsInEpoch() uint64 {
+	if x != nil {
+		return x.SlotsInEpoch
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetSps() float64 {
+	if x != nil {
+		return x.Sps
+	}
+	return 0
+}
+
+func (x *EpochInfo) GetEndEpoch() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return nil
+}
+
+func (x *EpochInfo) GetProgress() uint32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type Statistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools            uint64  `protobuf:"varint,1,opt,name=pools,proto3" json:"pools,omitempty"`
+	ActiveStake      float64 `protobuf:"fixed64,2,opt,name=active_stake,json=activeStake,proto3" json:"active_stake,omitempty"`
+	TotalSupply      float64 `protobuf:"fixed64,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	AvgSkippedSlots  float64 `protobuf:"fixed64,4,opt,name=avg_skipped_slots,json=avgSkippedSlots,proto3" json:"avg_skipped_slots,omitempty"`
+	MaxPoolsApy      float64 `protobuf:"fixed64,5,opt,name=max_pools_apy,json=maxPoolsApy,proto3" json:"max_pools_apy,omitempty"`
+	MaxScore         int64   `protobuf:"varint,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	AvgScore         int64   `protobuf:"varint,7,opt,name=avg_score,json=avgScore,proto3" json:"avg_score,omitempty"`
+	MinScore         int64   `protobuf:"varint,8,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	Delinquent       uint64  `protobuf:"varint,9,opt,name=delinquent,proto3" json:"delinquent,omitempty"`
+	UnstakeLiquidity float64 `protobuf:"fixed64,10,opt,name=unstake_liquidity,json=unstakeLiquidity,proto3" json:"unstake_liquidity,omitempty"`
+}
+
+func (x *Statistic) Reset() {
+	*x = Statistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Statistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statistic) ProtoMessage() {}
+
+func (x *Statistic) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statistic.ProtoReflect.Descriptor instead.
+func (*Statistic) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Statistic) GetPools() uint64 {
+	if x != nil {
+		return x.Pools
+	}
+	return 0
+}
+
+func (x *Statistic) GetActiveStake() float64 {
+	if x != nil {
+		return x.ActiveStake
+	}
+	return 0
+}
+
+func (x *Statistic) GetTotalSupply() float64 {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return 0
+}
+
+func (x *Statistic) GetAvgSkippedSlots() float64 {
+	if x != nil {
+		return x.AvgSkippedSlots
+	}
+	return 0
+}
+
+func (x *Statistic) GetMaxPoolsApy() float64 {
+	if x != nil {
+		return x.MaxPoolsApy
+	}
+	return 0
+}
+
+func (x *Statistic) GetMaxScore() int64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Statistic) GetAvgScore() int64 {
+	if x != nil {
+		return x.AvgScore
+	}
+	return 0
+}
+
+func (x *Statistic) GetMinScore() int64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *Statistic) GetDelinquent() uint64 {
+	if x != nil {
+		return x.Delinquent
+	}
+	return 0
+}
+
+func (x *Statistic) GetUnstakeLiquidity() float64 {
+	if x != nil {
+		return x.UnstakeLiquidity
+	}
+	return 0
+}
+
+type GetEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetEpochRequest) Reset() {
+	*x = GetEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochRequest) ProtoMessage() {}
+
+func (x *GetEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochRequest.ProtoReflect.Descriptor instead.
+func (*GetEpochRequest) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{9}
+}
+
+type GetPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// epoch is the APY aggregation, 1 or 10 (the default).
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *GetPoolRequest) Reset() {
+	*x = GetPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolRequest) ProtoMessage() {}
+
+func (x *GetPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolRequest) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPoolRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPoolRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type GetPoolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// sort takes the values of the REST API: apy, pool stake, validators, score, skipped slot or token price.
+	Sort   string `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	Desc   bool   `protobuf:"varint,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Epoch  uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Limit  uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset uint64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetPoolsRequest) Reset() {
+	*x = GetPoolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolsRequest) ProtoMessage() {}
+
+func (x *GetPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolsRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPoolsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPoolsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetPoolsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *GetPoolsRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetPoolsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPoolsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetPoolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools []*Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Total uint64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetPoolsResponse) Reset() {
+	*x = GetPoolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolsResponse) ProtoMessage() {}
+
+func (x *GetPoolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolsResponse.ProtoReflect.Descriptor instead.
+func (*GetPoolsResponse) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPoolsResponse) GetPools() []*Pool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *GetPoolsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetPoolValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolName      string           `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	ValidatorName string           `protobuf:"bytes,2,opt,name=validator_name,json=validatorName,proto3" json:"validator_name,omitempty"`
+	Sort          string           `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Desc          bool             `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	Epoch         uint64           `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Limit         uint64           `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint64           `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filter        *ValidatorFilter `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetPoolValidatorsRequest) Reset() {
+	*x = GetPoolValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPoolValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolValidatorsRequest) ProtoMessage() {}
+
+func (x *GetPoolValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPoolValidatorsRequest) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+func (x *GetPoolValidatorsRequest) GetValidatorName() string {
+	if x != nil {
+		return x.ValidatorName
+	}
+	return ""
+}
+
+func (x *GetPoolValidatorsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetPoolValidatorsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *GetPoolValidatorsRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetPoolValidatorsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPoolValidatorsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPoolValidatorsRequest) GetFilter() *ValidatorFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetPoolValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*PoolValidatorData `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Total      uint64               `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetPoolValidatorsResponse) Reset() {
+	*x = GetPoolValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPoolValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolValidatorsResponse) ProtoMessage() {}
+
+func (x *GetPoolValidatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*GetPoolValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPoolValidatorsResponse) GetValidators() []*PoolValidatorData {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *GetPoolValidatorsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetValidatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VotePk string `protobuf:"bytes,1,opt,name=vote_pk,json=votePk,proto3" json:"vote_pk,omitempty"`
+	Epoch  uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// history_limit bounds the epoch history, 10 by default.
+	HistoryLimit uint64 `protobuf:"varint,3,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit,omitempty"`
+}
+
+func (x *GetValidatorRequest) Reset() {
+	*x = GetValidatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorRequest) ProtoMessage() {}
+
+func (x *GetValidatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetValidatorRequest) GetVotePk() string {
+	if x != nil {
+		return x.VotePk
+	}
+	return ""
+}
+
+func (x *GetValidatorRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetValidatorRequest) GetHistoryLimit() uint64 {
+	if x != nil {
+		return x.HistoryLimit
+	}
+	return 0
+}
+
+type GetValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sort   string           `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	Desc   bool             `protobuf:"varint,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Epoch  uint64           `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Epochs []uint64         `protobuf:"varint,5,rep,packed,name=epochs,proto3" json:"epochs,omitempty"`
+	Limit  uint64           `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset uint64           `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filter *ValidatorFilter `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// delegated_by keeps the validators some of the named pools delegate to, undelegated those no pool delegates to.
+	DelegatedBy []string `protobuf:"bytes,9,rep,name=delegated_by,json=delegatedBy,proto3" json:"delegated_by,omitempty"`
+	Undelegated bool     `protobuf:"varint,10,opt,name=undelegated,proto3" json:"undelegated,omitempty"`
+}
+
+func (x *GetValidatorsRequest) Reset() {
+	*x = GetValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorsRequest) ProtoMessage() {}
+
+func (x *GetValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetValidatorsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetValidatorsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetValidatorsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *GetValidatorsRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *GetValidatorsRequest) GetEpochs() []uint64 {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+func (x *GetValidatorsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetValidatorsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetValidatorsRequest) GetFilter() *ValidatorFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetValidatorsRequest) GetDelegatedBy() []string {
+	if x != nil {
+		return x.DelegatedBy
+	}
+	return nil
+}
+
+func (x *GetValidatorsRequest) GetUndelegated() bool {
+	if x != nil {
+		return x.Undelegated
+	}
+	return false
+}
+
+type GetValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Total      uint64       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetValidatorsResponse) Reset() {
+	*x = GetValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorsResponse) ProtoMessage() {}
+
+func (x *GetValidatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetValidatorsResponse) GetValidators() []*Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *GetValidatorsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetStatisticRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *GetStatisticRequest) Reset() {
+	*x = GetStatisticRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_solana_pools_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatisticRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticRequest) ProtoMessage() {}
+
+func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_solana_pools_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticRequest) Descriptor() ([]byte, []int) {
+	return file_solana_pools_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetStatisticRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+var File_solana_pools_proto protoreflect.FileDescriptor
+
+var file_solana_pools_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c,
+	0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x04, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x61, 0x70, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x61, 0x76, 0x67, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64,
+	0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf6, 0x02, 0x0a, 0x09, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x50,
+	0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71,
+	0x75, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x61, 0x70, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x38, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6f, 0x6c,
+	0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x22, 0x8e, 0x02, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x70, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0xe7, 0x03, 0x0a, 0x0f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x06,
+	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
+	0x5f, 0x61, 0x70, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x06, 0x6d, 0x69,
+	0x6e, 0x41, 0x70, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x70, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41,
+	0x70, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x53, 0x6c, 0x6f, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69,
+	0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x07, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x08, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x70, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c,
+	0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x22, 0x78, 0x0a, 0x11, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x22, 0xae, 0x01,
+	0x0a, 0x09, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x49, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x6e, 0x64,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xdb,
+	0x02, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53,
+	0x6c, 0x6f, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x73, 0x5f, 0x61, 0x70, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x41, 0x70, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x76, 0x67, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x75, 0x6e, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0x11, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x91, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x54, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x83, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x74, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x69, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x50,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xac, 0x02, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x32, 0xed, 0x05, 0x0a, 0x0b, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x46, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x4d, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x28, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x5c, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x73,
+	0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61,
+	0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x43, 0x0a, 0x09, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x30, 0x01, 0x12, 0x52,
+	0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x12, 0x23, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x30, 0x01, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x65, 0x76, 0x65, 0x72, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2f, 0x73, 0x6f, 0x6c, 0x61, 0x6e,
+	0x61, 0x2d, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x73, 0x65,
+	0x72, 0x76, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_solana_pools_proto_rawDescOnce sync.Once
+	file_solana_pools_proto_rawDescData = file_solana_pools_proto_rawDesc
+)
+
+func file_solana_pools_proto_rawDescGZIP() []byte {
+	file_solana_pools_proto_rawDescOnce.Do(func() {
+		file_solana_pools_proto_rawDescData = protoimpl.X.CompressGZIP(file_solana_pools_proto_rawDescData)
+	})
+	return file_solana_pools_proto_rawDescData
+}
+
+var file_solana_pools_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_solana_pools_proto_goTypes = []interface{}{
+	(*Pool)(nil),                      // 0: solanapools.v1.Pool
+	(*Validator)(nil),                 // 1: solanapools.v1.Validator
+	(*ValidatorDetails)(nil),          // 2: solanapools.v1.ValidatorDetails
+	(*ValidatorEpoch)(nil),            // 3: solanapools.v1.ValidatorEpoch
+	(*ValidatorPool)(nil),             // 4: solanapools.v1.ValidatorPool
+	(*ValidatorFilter)(nil),           // 5: solanapools.v1.ValidatorFilter
+	(*PoolValidatorData)(nil),         // 6: solanapools.v1.PoolValidatorData
+	(*EpochInfo)(nil),                 // 7: solanapools.v1.EpochInfo
+	(*Statistic)(nil),                 // 8: solanapools.v1.Statistic
+	(*GetEpochRequest)(nil),           // 9: solanapools.v1.GetEpochRequest
+	(*GetPoolRequest)(nil),            // 10: solanapools.v1.GetPoolRequest
+	(*GetPoolsRequest)(nil),           // 11: solanapools.v1.GetPoolsRequest
+	(*GetPoolsResponse)(nil),          // 12: solanapools.v1.GetPoolsResponse
+	(*GetPoolValidatorsRequest)(nil),  // 13: solanapools.v1.GetPoolValidatorsRequest
+	(*GetPoolValidatorsResponse)(nil), // 14: solanapools.v1.GetPoolValidatorsResponse
+	(*GetValidatorRequest)(nil),       // 15: solanapools.v1.GetValidatorRequest
+	(*GetValidatorsRequest)(nil),      // 16: solanapools.v1.GetValidatorsRequest
+	(*GetValidatorsResponse)(nil),     // 17: solanapools.v1.GetValidatorsResponse
+	(*GetStatisticRequest)(nil),       // 18: solanapools.v1.GetStatisticRequest
+	(*timestamppb.Timestamp)(nil),     // 19: google.protobuf.Timestamp
+}
+var file_solana_pools_proto_depIdxs = []int32{
+	19, // 0: solanapools.v1.Pool.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: solanapools.v1.ValidatorDetails.validator:type_name -> solanapools.v1.Validator
+	3,  // 2: solanapools.v1.ValidatorDetails.history:type_name -> solanapools.v1.ValidatorEpoch
+	4,  // 3: solanapools.v1.ValidatorDetails.pools:type_name -> solanapools.v1.ValidatorPool
+	19, // 4: solanapools.v1.ValidatorEpoch.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: solanapools.v1.PoolValidatorData.validator:type_name -> solanapools.v1.Validator
+	19, // 6: solanapools.v1.EpochInfo.end_epoch:type_name -> google.protobuf.Timestamp
+	0,  // 7: solanapools.v1.GetPoolsResponse.pools:type_name -> solanapools.v1.Pool
+	5,  // 8: solanapools.v1.GetPoolValidatorsRequest.filter:type_name -> solanapools.v1.ValidatorFilter
+	6,  // 9: solanapools.v1.GetPoolValidatorsResponse.validators:type_name -> solanapools.v1.PoolValidatorData
+	5,  // 10: solanapools.v1.GetValidatorsRequest.filter:type_name -> solanapools.v1.ValidatorFilter
+	1,  // 11: solanapools.v1.GetValidatorsResponse.validators:type_name -> solanapools.v1.Validator
+	9,  // 12: solanapools.v1.SolanaPools.GetEpoch:input_type -> solanapools.v1.GetEpochRequest
+	10, // 13: solanapools.v1.SolanaPools.GetPool:input_type -> solanapools.v1.GetPoolRequest
+	11, // 14: solanapools.v1.SolanaPools.GetPools:input_type -> solanapools.v1.GetPoolsRequest
+	13, // 15: solanapools.v1.SolanaPools.GetPoolValidators:input_type -> solanapools.v1.GetPoolValidatorsRequest
+	15, // 16: solanapools.v1.SolanaPools.GetValidator:input_type -> solanapools.v1.GetValidatorRequest
+	16, // 17: solanapools.v1.SolanaPools.GetValidators:input_type -> solanapools.v1.GetValidatorsRequest
+	18, // 18: solanapools.v1.SolanaPools.GetStatistic:input_type -> solanapools.v1.GetStatisticRequest
+	10, // 19: solanapools.v1.SolanaPools.WatchPool:input_type -> solanapools.v1.GetPoolRequest
+	18, // 20: solanapools.v1.SolanaPools.WatchStatistic:input_type -> solanapools.v1.GetStatisticRequest
+	7,  // 21: solanapools.v1.SolanaPools.GetEpoch:output_type -> solanapools.v1.EpochInfo
+	0,  // 22: solanapools.v1.SolanaPools.GetPool:output_type -> solanapools.v1.Pool
+	12, // 23: solanapools.v1.SolanaPools.GetPools:output_type -> solanapools.v1.GetPoolsResponse
+	14, // 24: solanapools.v1.SolanaPools.GetPoolValidators:output_type -> solanapools.v1.GetPoolValidatorsResponse
+	2,  // 25: solanapools.v1.SolanaPools.GetValidator:output_type -> solanapools.v1.ValidatorDetails
+	17, // 26: solanapools.v1.SolanaPools.GetValidators:output_type -> solanapools.v1.GetValidatorsResponse
+	8,  // 27: solanapools.v1.SolanaPools.GetStatistic:output_type -> solanapools.v1.Statistic
+	0,  // 28: solanapools.v1.SolanaPools.WatchPool:output_type -> solanapools.v1.Pool
+	8,  // 29: solanapools.v1.SolanaPools.WatchStatistic:output_type -> solanapools.v1.Statistic
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_solana_pools_proto_init() }
+func file_solana_pools_proto_init() {
+	if File_solana_pools_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_solana_pools_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorEpoch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolValidatorData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpochInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statistic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPoolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPoolsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPoolValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPoolValidatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_solana_pools_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatisticRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_solana_pools_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_solana_pools_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_solana_pools_proto_goTypes,
+		DependencyIndexes: file_solana_pools_proto_depIdxs,
+		MessageInfos:      file_solana_pools_proto_msgTypes,
+	}.Build()
+	File_solana_pools_proto = out.File
+	file_solana_pools_proto_rawDesc = nil
+	file_solana_pools_proto_goTypes = nil
+	file_solana_pools_proto_depIdxs = nil
+}
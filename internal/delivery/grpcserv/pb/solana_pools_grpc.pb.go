@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: solana_pools.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SolanaPoolsClient is the client API for SolanaPools service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SolanaPoolsClient interface {
+	GetEpoch(ctx context.Context, in *GetEpochRequest, opts ...grpc.CallOption) (*EpochInfo, error)
+	GetPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (*Pool, error)
+	GetPools(ctx context.Context, in *GetPoolsRequest, opts ...grpc.CallOption) (*GetPoolsResponse, error)
+	GetPoolValidators(ctx context.Context, in *GetPoolValidatorsRequest, opts ...grpc.CallOption) (*GetPoolValidatorsResponse, error)
+	GetValidator(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*ValidatorDetails, error)
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error)
+	GetStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (*Statistic, error)
+	// WatchPool sends the pool and then the pool every time an update changes it.
+	WatchPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (SolanaPools_WatchPoolClient, error)
+	// WatchStatistic sends the statistic of all pools and then the statistic every time an update changes it.
+	WatchStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (SolanaPools_WatchStatisticClient, error)
+}
+
+type solanaPoolsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSolanaPoolsClient(cc grpc.ClientConnInterface) SolanaPoolsClient {
+	return &solanaPoolsClient{cc}
+}
+
+func (c *solanaPoolsClient) GetEpoch(ctx context.Context, in *GetEpochRequest, opts ...grpc.CallOption) (*EpochInfo, error) {
+	out := new(EpochInfo)
+	err := c.cc.Invoke(ctx, "/solanapools.v1.SolanaPools/GetEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solanaPoolsClient) GetPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (*Pool, error) {
+	out := new(Pool)
+	err := c.cc.Invoke(ctx, "/solanapools.v1.SolanaPools/GetPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solanaPoolsClient) GetPools(ctx context.Context, in *GetPoolsRequest, opts ...grpc.CallOption) (*GetPoolsResponse, error) {
+	out := new(GetPoolsResponse)
+	err := c.cc.Invoke(ctx, "/solanapools.v1.SolanaPools/GetPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solanaPoolsClient) GetPoolValidators(ctx context.Context, in *GetPoolValidatorsRequest, opts ...grpc.CallOption) (*GetPoolValidatorsResponse, error) {
+	out := new(GetPoolValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/solanapools.v1.SolanaPools/GetPoolValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solanaPoolsClient) GetValidator(ctx context.Context, in *GetValidatorRequest, opts ...grpc.CallOption) (*ValidatorDetails, error) {
+	out := new(ValidatorDetails)
+	err := c.cc.Invoke(ctx, "/solanapools.v1.SolanaPools/GetValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solanaPoolsClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*GetValidatorsResponse, error) {
+	out := new(GetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/solanapools.v1.SolanaPools/GetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solanaPoolsClient) GetStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (*Statistic, error) {
+	out := new(Statistic)
+	err := c.cc.Invoke(ctx, "/solanapools.v1.SolanaPools/GetStatistic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solanaPoolsClient) WatchPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (SolanaPools_WatchPoolClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SolanaPools_ServiceDesc.Streams[0], "/solanapools.v1.SolanaPools/WatchPool", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &solanaPoolsWatchPoolClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SolanaPools_WatchPoolClient interface {
+	Recv() (*Pool, error)
+	grpc.ClientStream
+}
+
+type solanaPoolsWatchPoolClient struct {
+	grpc.ClientStream
+}
+
+func (x *solanaPoolsWatchPoolClient) Recv() (*Pool, error) {
+	m := new(Pool)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *solanaPoolsClient) WatchStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (SolanaPools_WatchStatisticClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SolanaPools_ServiceDesc.Streams[1], "/solanapools.v1.SolanaPools/WatchStatistic", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &solanaPoolsWatchStatisticClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SolanaPools_WatchStatisticClient interface {
+	Recv() (*Statistic, error)
+	grpc.ClientStream
+}
+
+type solanaPoolsWatchStatisticClient struct {
+	grpc.ClientStream
+}
+
+func (x *solanaPoolsWatchStatisticClient) Recv() (*Statistic, error) {
+	m := new(Statistic)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SolanaPoolsServer is the server API for SolanaPools service.
+// All implementations must embed UnimplementedSolanaPoolsServer
+// for forward compatibility
+type SolanaPoolsServer interface {
+	GetEpoch(context.Context, *GetEpochRequest) (*EpochInfo, error)
+	GetPool(context.Context, *GetPoolRequest) (*Pool, error)
+	GetPools(context.Context, *GetPoolsRequest) (*GetPoolsResponse, error)
+	GetPoolValidators(context.Context, *GetPoolValidatorsRequest) (*GetPoolValidatorsResponse, error)
+	GetValidator(context.Context, *GetValidatorRequest) (*ValidatorDetails, error)
+	GetValidators(context.Context, *GetValidatorsRequest) (*GetValidatorsResponse, error)
+	GetStatistic(context.Context, *GetStatisticRequest) (*Statistic, error)
+	// WatchPool sends the pool and then the pool every time an update changes it.
+	WatchPool(*GetPoolRequest, SolanaPools_WatchPoolServer) error
+	// WatchStatistic sends the statistic of all pools and then the statistic every time an update changes it.
+	WatchStatistic(*GetStatisticRequest, SolanaPools_WatchStatisticServer) error
+	mustEmbedUnimplementedSolanaPoolsServer()
+}
+
+// UnimplementedSolanaPoolsServer must be embedded to have forward compatible implementations.
+type UnimplementedSolanaPoolsServer struct {
+}
+
+func (UnimplementedSolanaPoolsServer) GetEpoch(context.Context, *GetEpochRequest) (*EpochInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpoch not implemented")
+}
+func (UnimplementedSolanaPoolsServer) GetPool(context.Context, *GetPoolRequest) (*Pool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPool not implemented")
+}
+func (UnimplementedSolanaPoolsServer) GetPools(context.Context, *GetPoolsRequest) (*GetPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPools not implemented")
+}
+func (UnimplementedSolanaPoolsServer) GetPoolValidators(context.Context, *GetPoolValidatorsRequest) (*GetPoolValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolValidators not implemented")
+}
+func (UnimplementedSolanaPoolsServer) GetValidator(context.Context, *GetValidatorRequest) (*ValidatorDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidator not implemented")
+}
+func (UnimplementedSolanaPoolsServer) GetValidators(context.Context, *GetValidatorsRequest) (*GetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+}
+func (UnimplementedSolanaPoolsServer) GetStatistic(context.Context, *GetStatisticRequest) (*Statistic, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatistic not implemented")
+}
+func (UnimplementedSolanaPoolsServer) WatchPool(*GetPoolRequest, SolanaPools_WatchPoolServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPool not implemented")
+}
+func (UnimplementedSolanaPoolsServer) WatchStatistic(*GetStatisticRequest, SolanaPools_WatchStatisticServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatistic not implemented")
+}
+func (UnimplementedSolanaPoolsServer) mustEmbedUnimplementedSolanaPoolsServer() {}
+
+// UnsafeSolanaPoolsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SolanaPoolsServer will
+// result in compilation errors.
+type UnsafeSolanaPoolsServer interface {
+	mustEmbedUnimplementedSolanaPoolsServer()
+}
+
+func RegisterSolanaPoolsServer(s grpc.ServiceRegistrar, srv SolanaPoolsServer) {
+	s.RegisterService(&SolanaPools_ServiceDesc, srv)
+}
+
+func _SolanaPools_GetEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolanaPoolsServer).GetEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/solanapools.v1.SolanaPools/GetEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolanaPoolsServer).GetEpoch(ctx, req.(*GetEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolanaPools_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolanaPoolsServer).GetPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/solanapools.v1.SolanaPools/GetPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolanaPoolsServer).GetPool(ctx, req.(*GetPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolanaPools_GetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolanaPoolsServer).GetPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/solanapools.v1.SolanaPools/GetPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolanaPoolsServer).GetPools(ctx, req.(*GetPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolanaPools_GetPoolValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolanaPoolsServer).GetPoolValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/solanapools.v1.SolanaPools/GetPoolValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolanaPoolsServer).GetPoolValidators(ctx, req.(*GetPoolValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolanaPools_GetValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolanaPoolsServer).GetValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/solanapools.v1.SolanaPools/GetValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolanaPoolsServer).GetValidator(ctx, req.(*GetValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolanaPools_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolanaPoolsServer).GetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/solanapools.v1.SolanaPools/GetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolanaPoolsServer).GetValidators(ctx, req.(*GetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolanaPools_GetStatistic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatisticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolanaPoolsServer).GetStatistic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/solanapools.v1.SolanaPools/GetStatistic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolanaPoolsServer).GetStatistic(ctx, req.(*GetStatisticRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SolanaPools_WatchPool_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPoolRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SolanaPoolsServer).WatchPool(m, &solanaPoolsWatchPoolServer{stream})
+}
+
+type SolanaPools_WatchPoolServer interface {
+	Send(*Pool) error
+	grpc.ServerStream
+}
+
+type solanaPoolsWatchPoolServer struct {
+	grpc.ServerStream
+}
+
+func (x *solanaPoolsWatchPoolServer) Send(m *Pool) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SolanaPools_WatchStatistic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetStatisticRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SolanaPoolsServer).WatchStatistic(m, &solanaPoolsWatchStatisticServer{stream})
+}
+
+type SolanaPools_WatchStatisticServer interface {
+	Send(*Statistic) error
+	grpc.ServerStream
+}
+
+type solanaPoolsWatchStatisticServer struct {
+	grpc.ServerStream
+}
+
+func (x *solanaPoolsWatchStatisticServer) Send(m *Statistic) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// SolanaPools_ServiceDesc is the grpc.ServiceDesc for SolanaPools service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SolanaPools_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "solanapools.v1.SolanaPools",
+	HandlerType: (*SolanaPoolsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEpoch",
+			Handler:    _SolanaPools_GetEpoch_Handler,
+		},
+		{
+			MethodName: "GetPool",
+			Handler:    _SolanaPools_GetPool_Handler,
+		},
+		{
+			MethodName: "GetPools",
+			Handler:    _SolanaPools_GetPools_Handler,
+		},
+		{
+			MethodName: "GetPoolValidators",
+			Handler:    _SolanaPools_GetPoolValidators_Handler,
+		},
+		{
+			MethodName: "GetValidator",
+			Handler:    _SolanaPools_GetValidator_Handler,
+		},
+		{
+			MethodName: "GetValidators",
+			Handler:    _SolanaPools_GetValidators_Handler,
+		},
+		{
+			MethodName: "GetStatistic",
+			Handler:    _SolanaPools_GetStatistic_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPool",
+			Handler:       _SolanaPools_WatchPool_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchStatistic",
+			Handler:       _SolanaPools_WatchStatistic_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "solana_pools.proto",
+}
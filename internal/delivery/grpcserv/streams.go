@@ -0,0 +1,59 @@
+package grpcserv
+
+import (
+	"github.com/everstake/solana-pools/internal/delivery/grpcserv/pb"
+	"github.com/everstake/solana-pools/internal/events"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// streamBuffer is one: every event of the topic only makes the stream re-read the message, so a pending event
+// already covers the events dropped behind it.
+const streamBuffer = 1
+
+func (s *Server) WatchPool(req *pb.GetPoolRequest, stream pb.SolanaPools_WatchPoolServer) error {
+	return s.watch(stream, events.PoolTopic(req.Name), func() (proto.Message, error) {
+		return s.pool(req)
+	})
+}
+
+func (s *Server) WatchStatistic(req *pb.GetStatisticRequest, stream pb.SolanaPools_WatchStatisticServer) error {
+	return s.watch(stream, events.TopicNetworkStats, func() (proto.Message, error) {
+		return s.statistic(req)
+	})
+}
+
+// watch sends the current message and then re-reads it on every event of the topic, sending it when it changed.
+func (s *Server) watch(stream grpc.ServerStream, topic string, load func() (proto.Message, error)) error {
+	ch, unsubscribe := s.svc.Subscribe(streamBuffer, topic)
+	defer unsubscribe()
+
+	last, err := load()
+	if err != nil {
+		return err
+	}
+	if err := stream.SendMsg(last); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case _, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			msg, err := load()
+			if err != nil {
+				return err
+			}
+			if proto.Equal(msg, last) {
+				continue
+			}
+			if err := stream.SendMsg(msg); err != nil {
+				return err
+			}
+			last = msg
+		}
+	}
+}
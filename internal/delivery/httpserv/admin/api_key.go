@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+	"time"
+)
+
+type apiKey struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Key         string    `json:"key,omitempty"`
+	Tier        string    `json:"tier"`
+	Active      bool      `json:"active"`
+	Requests30d int64     `json:"requests_30d"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (k *apiKey) Set(data *smodels.APIKey) *apiKey {
+	k.ID = data.ID
+	k.Name = data.Name
+	k.Key = data.Key
+	k.Tier = data.Tier
+	k.Active = data.Active
+	k.Requests30d = data.Requests30d
+	k.CreatedAt = data.CreatedAt
+	return k
+}
+
+func (h *Handler) GetAPIKeys(ctx *gin.Context) (interface{}, error) {
+	arr, err := h.svc.AdminGetAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*apiKey, len(arr))
+	for i, k := range arr {
+		data[i] = (&apiKey{}).Set(k)
+	}
+	return tools.ResponseData{Data: data}, nil
+}
+
+// CreateAPIKey returns the generated key, which is only shown once.
+func (h *Handler) CreateAPIKey(ctx *gin.Context) (interface{}, error) {
+	req := struct {
+		Name string `json:"name" binding:"required"`
+		Tier string `json:"tier" binding:"required"`
+	}{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("bad request %w", err))
+	}
+	k, err := h.svc.AdminCreateAPIKey(actor(ctx), req.Name, req.Tier)
+	if err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: (&apiKey{}).Set(k)}, nil
+}
+
+func (h *Handler) DeactivateAPIKey(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.AdminDeactivateAPIKey(actor(ctx), id); err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: id}, nil
+}
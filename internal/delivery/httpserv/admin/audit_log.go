@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+	"time"
+)
+
+type auditLog struct {
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Entity    string    `json:"entity"`
+	EntityID  uuid.UUID `json:"entity_id"`
+	Before    string    `json:"before"`
+	After     string    `json:"after"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (a *auditLog) Set(data *smodels.AuditLog) *auditLog {
+	a.Actor = data.Actor
+	a.Action = data.Action
+	a.Entity = data.Entity
+	a.EntityID = data.EntityID
+	a.Before = data.Before
+	a.After = data.After
+	a.CreatedAt = data.CreatedAt
+	return a
+}
+
+// GetAuditLogs returns the admin changes, newest first, optionally of one entity type (pool, coin, liquidity_pool, governance).
+func (h *Handler) GetAuditLogs(ctx *gin.Context) (interface{}, error) {
+	q := struct {
+		Entity string `form:"entity"`
+		Offset uint64 `form:"offset,default=0"`
+		Limit  uint64 `form:"limit,default=50"`
+	}{}
+	if err := ctx.ShouldBind(&q); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("bad request %w", err))
+	}
+
+	arr, amount, err := h.svc.GetAuditLogs(q.Entity, q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*auditLog, len(arr))
+	for i, l := range arr {
+		data[i] = (&auditLog{}).Set(l)
+	}
+	return tools.ResponseArrayData{
+		Data: data,
+		MetaData: &tools.MetaData{
+			Offset:      q.Offset,
+			Limit:       q.Limit,
+			TotalAmount: amount,
+		},
+	}, nil
+}
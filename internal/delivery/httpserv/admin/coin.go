@@ -0,0 +1,90 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+type coin struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name" binding:"required"`
+	GeckoKey   string    `json:"gecko_key"`
+	Address    string    `json:"address"`
+	ThumbImage string    `json:"thumb_image"`
+	SmallImage string    `json:"small_image"`
+	LargeImage string    `json:"large_image"`
+}
+
+func (c *coin) Set(data *smodels.AdminCoin) *coin {
+	c.ID = data.ID
+	c.Name = data.Name
+	c.GeckoKey = data.GeckoKey
+	c.Address = data.Address
+	c.ThumbImage = data.ThumbImage
+	c.SmallImage = data.SmallImage
+	c.LargeImage = data.LargeImage
+	return c
+}
+
+func (c *coin) model(id uuid.UUID) *smodels.AdminCoin {
+	return &smodels.AdminCoin{
+		ID:         id,
+		Name:       c.Name,
+		GeckoKey:   c.GeckoKey,
+		Address:    c.Address,
+		ThumbImage: c.ThumbImage,
+		SmallImage: c.SmallImage,
+		LargeImage: c.LargeImage,
+	}
+}
+
+func (h *Handler) GetCoins(ctx *gin.Context) (interface{}, error) {
+	arr, err := h.svc.AdminGetCoins()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*coin, len(arr))
+	for i, c := range arr {
+		data[i] = (&coin{}).Set(c)
+	}
+	return tools.ResponseData{Data: data}, nil
+}
+
+func (h *Handler) CreateCoin(ctx *gin.Context) (interface{}, error) {
+	return h.saveCoin(ctx, uuid.Nil)
+}
+
+func (h *Handler) UpdateCoin(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.saveCoin(ctx, id)
+}
+
+func (h *Handler) saveCoin(ctx *gin.Context, id uuid.UUID) (interface{}, error) {
+	var req coin
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("bad request %w", err))
+	}
+	c, err := h.svc.AdminSaveCoin(actor(ctx), req.model(id))
+	if err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: (&coin{}).Set(c)}, nil
+}
+
+func (h *Handler) DeleteCoin(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.AdminDeleteCoin(actor(ctx), id); err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: id}, nil
+}
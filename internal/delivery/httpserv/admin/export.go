@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/export"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+// Export streams a historical dataset (pool-data, validator-data, pool-validator-data) as CSV or Parquet.
+// Both ends of from_epoch..to_epoch or of from..to are required, so a request cannot dump a whole table.
+func (h *Handler) Export(ctx *gin.Context) {
+	q := struct {
+		Format     string    `form:"format,default=csv"`
+		Pools      []string  `form:"pools"`
+		Validators []string  `form:"validators"`
+		FromEpoch  uint64    `form:"from_epoch"`
+		ToEpoch    uint64    `form:"to_epoch"`
+		From       time.Time `form:"from"`
+		To         time.Time `form:"to"`
+	}{}
+	if err := ctx.ShouldBindQuery(&q); err != nil {
+		tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, err))
+		return
+	}
+	if (q.FromEpoch == 0 || q.ToEpoch == 0) && (q.From.IsZero() || q.To.IsZero()) {
+		tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, errors.New("from_epoch and to_epoch or from and to are required")))
+		return
+	}
+	format, err := export.ParseFormat(q.Format)
+	if err != nil {
+		tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, err))
+		return
+	}
+
+	dataset := ctx.Param("dataset")
+	ctx.Header("Content-Type", format.ContentType())
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, dataset, format))
+	err = h.svc.Export(dataset, format, smodels.ExportFilter{
+		Pools:      q.Pools,
+		Validators: q.Validators,
+		FromEpoch:  q.FromEpoch,
+		ToEpoch:    q.ToEpoch,
+		From:       q.From,
+		To:         q.To,
+	}, ctx.Writer)
+	if err == nil {
+		return
+	}
+	if errors.Is(err, services.ErrUnknownDataset) {
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.Writer.Header().Del("Content-Disposition")
+		tools.Abort(ctx, tools.NewStatus(http.StatusNotFound, fmt.Errorf("unknown dataset %s", dataset)))
+		return
+	}
+	h.log.Error("Admin Export", zap.Error(err))
+	// once rows were sent the status is out and the client is left with a truncated file
+	if !ctx.Writer.Written() {
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.Writer.Header().Del("Content-Disposition")
+		tools.Abort(ctx, tools.NewStatus(http.StatusInternalServerError, err))
+	}
+}
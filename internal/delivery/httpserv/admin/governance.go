@@ -0,0 +1,96 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+type governance struct {
+	ID              uuid.UUID `json:"id"`
+	Name            string    `json:"name" binding:"required"`
+	Symbol          string    `json:"symbol"`
+	VoteURL         string    `json:"vote_url"`
+	WebSiteURL      string    `json:"web_site_url"`
+	Image           string    `json:"image"`
+	GeckoKey        string    `json:"gecko_key"`
+	Blockchain      string    `json:"blockchain" binding:"required"`
+	ContractAddress string    `json:"contract_address" binding:"required"`
+}
+
+func (g *governance) Set(data *smodels.AdminGovernance) *governance {
+	g.ID = data.ID
+	g.Name = data.Name
+	g.Symbol = data.Symbol
+	g.VoteURL = data.VoteURL
+	g.WebSiteURL = data.WebSiteURL
+	g.Image = data.Image
+	g.GeckoKey = data.GeckoKey
+	g.Blockchain = data.Blockchain
+	g.ContractAddress = data.ContractAddress
+	return g
+}
+
+func (g *governance) model(id uuid.UUID) *smodels.AdminGovernance {
+	return &smodels.AdminGovernance{
+		ID:              id,
+		Name:            g.Name,
+		Symbol:          g.Symbol,
+		VoteURL:         g.VoteURL,
+		WebSiteURL:      g.WebSiteURL,
+		Image:           g.Image,
+		GeckoKey:        g.GeckoKey,
+		Blockchain:      g.Blockchain,
+		ContractAddress: g.ContractAddress,
+	}
+}
+
+func (h *Handler) GetGovernance(ctx *gin.Context) (interface{}, error) {
+	arr, err := h.svc.AdminGetGovernance()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*governance, len(arr))
+	for i, g := range arr {
+		data[i] = (&governance{}).Set(g)
+	}
+	return tools.ResponseData{Data: data}, nil
+}
+
+func (h *Handler) CreateGovernance(ctx *gin.Context) (interface{}, error) {
+	return h.saveGovernance(ctx, uuid.Nil)
+}
+
+func (h *Handler) UpdateGovernance(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.saveGovernance(ctx, id)
+}
+
+func (h *Handler) saveGovernance(ctx *gin.Context, id uuid.UUID) (interface{}, error) {
+	var req governance
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("bad request %w", err))
+	}
+	g, err := h.svc.AdminSaveGovernance(actor(ctx), req.model(id))
+	if err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: (&governance{}).Set(g)}, nil
+}
+
+func (h *Handler) DeleteGovernance(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.AdminDeleteGovernance(actor(ctx), id); err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: id}, nil
+}
@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// Handler serves the /admin/v1 routes; every request is authenticated by tools.BearerAuth.
+type Handler struct {
+	svc services.Service
+	log *zap.Logger
+}
+
+func New(svc services.Service, log *zap.Logger) *Handler {
+	return &Handler{
+		svc: svc,
+		log: log,
+	}
+}
+
+func actor(ctx *gin.Context) string {
+	return ctx.GetString(tools.ActorKey)
+}
+
+func pathID(ctx *gin.Context) (uuid.UUID, error) {
+	id, err := uuid.FromString(ctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("bad id %w", err))
+	}
+	return id, nil
+}
+
+// status maps service errors to the HTTP status returned to the admin.
+func status(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidEntity):
+		return tools.NewStatus(http.StatusBadRequest, err)
+	case errors.Is(err, postgres.ErrorRecordNotFounded):
+		return tools.NewStatus(http.StatusNotFound, err)
+	case errors.Is(err, postgres.ErrorRecordInUse), errors.Is(err, postgres.ErrorRecordDuplicated):
+		return tools.NewStatus(http.StatusConflict, err)
+	}
+	return err
+}
@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+type liquidityPool struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name" binding:"required"`
+	About string    `json:"about"`
+	Image string    `json:"image"`
+	URL   string    `json:"url"`
+}
+
+func (lp *liquidityPool) Set(data *smodels.AdminLiquidityPool) *liquidityPool {
+	lp.ID = data.ID
+	lp.Name = data.Name
+	lp.About = data.About
+	lp.Image = data.Image
+	lp.URL = data.URL
+	return lp
+}
+
+func (lp *liquidityPool) model(id uuid.UUID) *smodels.AdminLiquidityPool {
+	return &smodels.AdminLiquidityPool{
+		ID:    id,
+		Name:  lp.Name,
+		About: lp.About,
+		Image: lp.Image,
+		URL:   lp.URL,
+	}
+}
+
+func (h *Handler) GetLiquidityPools(ctx *gin.Context) (interface{}, error) {
+	arr, err := h.svc.AdminGetLiquidityPools()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*liquidityPool, len(arr))
+	for i, lp := range arr {
+		data[i] = (&liquidityPool{}).Set(lp)
+	}
+	return tools.ResponseData{Data: data}, nil
+}
+
+func (h *Handler) CreateLiquidityPool(ctx *gin.Context) (interface{}, error) {
+	return h.saveLiquidityPool(ctx, uuid.Nil)
+}
+
+func (h *Handler) UpdateLiquidityPool(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.saveLiquidityPool(ctx, id)
+}
+
+func (h *Handler) saveLiquidityPool(ctx *gin.Context, id uuid.UUID) (interface{}, error) {
+	var req liquidityPool
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("bad request %w", err))
+	}
+	lp, err := h.svc.AdminSaveLiquidityPool(actor(ctx), req.model(id))
+	if err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: (&liquidityPool{}).Set(lp)}, nil
+}
+
+func (h *Handler) DeleteLiquidityPool(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.AdminDeleteLiquidityPool(actor(ctx), id); err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: id}, nil
+}
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+type pool struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name" binding:"required"`
+	Active  bool      `json:"active"`
+	Coin    string    `json:"coin" binding:"required"`
+	Address string    `json:"address" binding:"required"`
+	Network string    `json:"network" binding:"required"`
+	Program string    `json:"program"`
+	Image   string    `json:"image"`
+}
+
+func (p *pool) Set(data *smodels.AdminPool) *pool {
+	p.ID = data.ID
+	p.Name = data.Name
+	p.Active = data.Active
+	p.Coin = data.Coin
+	p.Address = data.Address
+	p.Network = data.Network
+	p.Program = data.Program
+	p.Image = data.Image
+	return p
+}
+
+func (p *pool) model(id uuid.UUID) *smodels.AdminPool {
+	return &smodels.AdminPool{
+		ID:      id,
+		Name:    p.Name,
+		Active:  p.Active,
+		Coin:    p.Coin,
+		Address: p.Address,
+		Network: p.Network,
+		Program: p.Program,
+		Image:   p.Image,
+	}
+}
+
+func (h *Handler) GetPools(ctx *gin.Context) (interface{}, error) {
+	arr, err := h.svc.AdminGetPools()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pool, len(arr))
+	for i, p := range arr {
+		data[i] = (&pool{}).Set(p)
+	}
+	return tools.ResponseData{Data: data}, nil
+}
+
+func (h *Handler) CreatePool(ctx *gin.Context) (interface{}, error) {
+	return h.savePool(ctx, uuid.Nil)
+}
+
+func (h *Handler) UpdatePool(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.savePool(ctx, id)
+}
+
+func (h *Handler) savePool(ctx *gin.Context, id uuid.UUID) (interface{}, error) {
+	var req pool
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("bad request %w", err))
+	}
+	p, err := h.svc.AdminSavePool(actor(ctx), req.model(id))
+	if err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: (&pool{}).Set(p)}, nil
+}
+
+func (h *Handler) DeletePool(ctx *gin.Context) (interface{}, error) {
+	id, err := pathID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.AdminDeletePool(actor(ctx), id); err != nil {
+		return nil, status(err)
+	}
+	return tools.ResponseData{Data: id}, nil
+}
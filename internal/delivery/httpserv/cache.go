@@ -0,0 +1,94 @@
+package httpserv
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Topic types the data of the read endpoints is published under.
+var (
+	poolTypes          = []string{events.TypePool, events.TypeValidator}
+	poolPegTypes       = []string{events.TypePool, events.TypeValidator, services.DataPegs}
+	poolPriceTypes     = []string{events.TypePool, events.TypeValidator, events.TopicCoins, services.DataPrices}
+	poolStatisticTypes = []string{events.TypePool, events.TypeValidator, events.TopicNetworkStats, events.TopicCoins, services.DataPrices}
+	validatorTypes     = []string{events.TypeValidator, events.TypePool}
+	coinTypes          = []string{events.TopicCoins}
+	poolCoinTypes      = []string{events.TopicCoins, events.TypeLiquidityPool}
+	liquidityPoolTypes = []string{events.TypeLiquidityPool}
+	epochTypes         = []string{events.TopicEpoch}
+	governanceTypes    = []string{services.DataGovernance}
+)
+
+type cacheWriter struct {
+	gin.ResponseWriter
+}
+
+// cache lets clients and CDNs keep successful responses for maxAge. With data types it tags responses with the version
+// of their data, which changes with every write to one of the types, converted responses also with the version of the
+// currency rates, and answers requests for the current version with 304 Not Modified.
+func (api *API) cache(maxAge time.Duration, types ...string) gin.HandlerFunc {
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+	return func(ctx *gin.Context) {
+		ctx.Writer = &cacheWriter{ResponseWriter: ctx.Writer}
+		header := ctx.Writer.Header()
+		header.Set("Cache-Control", cacheControl)
+		if len(types) == 0 {
+			ctx.Next()
+			return
+		}
+
+		versioned := types
+		if ctx.Query("currency") != "" {
+			versioned = append(append([]string{}, types...), services.DataCurrencyRates)
+		}
+		id, at, err := api.svc.DataVersion(versioned...)
+		if err != nil {
+			api.log.Warn("API DataVersion", zap.Error(err))
+		}
+		if err != nil || at.IsZero() {
+			ctx.Next()
+			return
+		}
+		etag := fmt.Sprintf(`W/"%x"`, id)
+		header.Set("ETag", etag)
+		header.Set("Last-Modified", at.UTC().Format(http.TimeFormat))
+		if notModified(ctx.Request, etag, at) {
+			ctx.AbortWithStatus(http.StatusNotModified)
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// notModified evaluates If-None-Match, or If-Modified-Since without it, against the current version.
+func notModified(r *http.Request, etag string, modified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, tag := range strings.Split(match, ",") {
+			tag = strings.TrimSpace(tag)
+			// weak comparison, as the tags are weak
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	return err == nil && !modified.Truncate(time.Second).After(since)
+}
+
+// WriteHeader keeps errors out of caches.
+func (w *cacheWriter) WriteHeader(code int) {
+	if code != http.StatusOK && code != http.StatusNotModified {
+		header := w.Header()
+		header.Del("ETag")
+		header.Del("Last-Modified")
+		header.Set("Cache-Control", "no-store")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,193 @@
+package httpserv
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestCache(t *testing.T) {
+	changed := time.Date(2022, 3, 1, 12, 0, 0, 500, time.UTC)
+	version := uint64(changed.UnixNano())
+	etag := fmt.Sprintf(`W/"%x"`, version)
+
+	tests := []struct {
+		name         string
+		url          string
+		header       map[string]string
+		changed      time.Time
+		err          error
+		status       int
+		etag         string
+		lastModified string
+		cacheControl string
+		types        []string
+	}{
+		{
+			name:         "tagged",
+			url:          "/pools",
+			changed:      changed,
+			status:       http.StatusOK,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "matching etag",
+			url:          "/pools",
+			header:       map[string]string{"If-None-Match": etag},
+			changed:      changed,
+			status:       http.StatusNotModified,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "strong form of the etag in a list",
+			url:          "/pools",
+			header:       map[string]string{"If-None-Match": fmt.Sprintf(`"1", "%x"`, version)},
+			changed:      changed,
+			status:       http.StatusNotModified,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "any etag",
+			url:          "/pools",
+			header:       map[string]string{"If-None-Match": "*"},
+			changed:      changed,
+			status:       http.StatusNotModified,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name: "other etag wins over a later If-Modified-Since",
+			url:  "/pools",
+			header: map[string]string{
+				"If-None-Match":     `W/"1"`,
+				"If-Modified-Since": "Wed, 02 Mar 2022 12:00:00 GMT",
+			},
+			changed:      changed,
+			status:       http.StatusOK,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "not modified since",
+			url:          "/pools",
+			header:       map[string]string{"If-Modified-Since": "Tue, 01 Mar 2022 12:00:00 GMT"},
+			changed:      changed,
+			status:       http.StatusNotModified,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "modified since",
+			url:          "/pools",
+			header:       map[string]string{"If-Modified-Since": "Tue, 01 Mar 2022 11:59:59 GMT"},
+			changed:      changed,
+			status:       http.StatusOK,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "currency adds the rates version",
+			url:          "/pools?currency=EUR",
+			changed:      changed,
+			status:       http.StatusOK,
+			etag:         etag,
+			lastModified: "Tue, 01 Mar 2022 12:00:00 GMT",
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool, services.DataCurrencyRates},
+		},
+		{
+			name:         "never changed",
+			url:          "/pools",
+			header:       map[string]string{"If-None-Match": "*"},
+			status:       http.StatusOK,
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "version error",
+			url:          "/pools",
+			header:       map[string]string{"If-None-Match": "*"},
+			err:          errors.New("connection refused"),
+			status:       http.StatusOK,
+			cacheControl: "public, max-age=60",
+			types:        []string{events.TypePool},
+		},
+		{
+			name:         "error response",
+			url:          "/pools?fail=true",
+			changed:      changed,
+			status:       http.StatusInternalServerError,
+			cacheControl: "no-store",
+			types:        []string{events.TypePool},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var types []string
+			api := &API{
+				svc: &services.ServiceMock{
+					DataVersionFunc: func(ts ...string) (uint64, time.Time, error) {
+						types = ts
+						if tt.changed.IsZero() {
+							return 0, time.Time{}, tt.err
+						}
+						return uint64(tt.changed.UnixNano()), tt.changed, tt.err
+					},
+				},
+				log: zap.NewNop(),
+			}
+			router := gin.New()
+			router.GET("/pools", api.cache(time.Minute, events.TypePool), func(ctx *gin.Context) {
+				if ctx.Query("fail") != "" {
+					ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal"})
+					return
+				}
+				ctx.JSON(http.StatusOK, gin.H{"data": []string{}})
+			})
+
+			r := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			for k, v := range tt.header {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+			assert.Equal(t, w.Code, tt.status)
+			assert.Equal(t, w.Header().Get("ETag"), tt.etag)
+			assert.Equal(t, w.Header().Get("Last-Modified"), tt.lastModified)
+			assert.Equal(t, w.Header().Get("Cache-Control"), tt.cacheControl)
+			assert.DeepEqual(t, types, tt.types)
+			if tt.status == http.StatusNotModified {
+				assert.Equal(t, w.Body.Len(), 0)
+			}
+		})
+	}
+}
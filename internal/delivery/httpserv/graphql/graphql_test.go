@@ -0,0 +1,198 @@
+package graphql_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/graphql"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newService() *services.ServiceMock {
+	return &services.ServiceMock{
+		GetPoolFunc: func(name string, epoch uint64) (*smodels.PoolDetails, error) {
+			if name != "Eversol" {
+				return nil, fmt.Errorf("DAO.GetPool(%s): %w", name, postgres.ErrorRecordNotFounded)
+			}
+			return &smodels.PoolDetails{Pool: smodels.Pool{Name: "Eversol", Currency: "eSOL", APY: decimal.NewFromFloat(0.07)}}, nil
+		},
+		// GetPools returns limit pools named pool0, pool1...
+		GetPoolsFunc: func(name string, sort string, desc bool, epoch uint64, from uint64, to uint64) ([]*smodels.PoolDetails, uint64, error) {
+			pools := make([]*smodels.PoolDetails, from)
+			for i := range pools {
+				pools[i] = &smodels.PoolDetails{Pool: smodels.Pool{Name: fmt.Sprintf("pool%d", i)}}
+			}
+			return pools, 1000, nil
+		},
+		GetPoolsValidatorsFunc: func(names []string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) (map[string][]*smodels.PoolValidatorData, map[string]uint64, error) {
+			data, totals := make(map[string][]*smodels.PoolValidatorData), make(map[string]uint64)
+			for _, name := range names {
+				data[name] = []*smodels.PoolValidatorData{{VotePK: "a"}, {VotePK: "b"}, {VotePK: "c"}}
+				totals[name] = 42
+			}
+			return data, totals, nil
+		},
+		GetPoolsStatisticFunc: func(names []string, aggregate string) (map[string][]*smodels.Pool, error) {
+			stats := make(map[string][]*smodels.Pool)
+			for _, name := range names {
+				stats[name] = []*smodels.Pool{{Name: name}}
+			}
+			return stats, nil
+		},
+		GetValidatorsPoolsFunc: func(votePKs []string, epoch uint64) (map[string][]*smodels.ValidatorPool, error) {
+			return map[string][]*smodels.ValidatorPool{"b": {{Name: "Eversol"}}}, nil
+		},
+		GetCoinsFunc: func(name string, limit uint64, offset uint64) ([]*smodels.Coin, uint64, error) {
+			return []*smodels.Coin{{Name: "eSOL", USD: 42}}, 1, nil
+		},
+	}
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestServe(t *testing.T) {
+	svc := newService()
+	h, err := graphql.New(svc, zap.NewNop())
+	assert.NilError(t, err)
+	router := gin.New()
+	router.POST("/graphql", h.Serve)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"query": `query($name: String!) {
+			pool(name: $name) {
+				name apy coin { usd }
+				validators(sort: POOL_STAKE) { pageInfo { total } items { validator { votePk pools { activeStake } } } }
+			}
+			missing: pool(name: "Lido") { name }
+		}`,
+		"variables": map[string]interface{}{"name": "Eversol"},
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	assert.Equal(t, w.Code, http.StatusOK)
+
+	var resp struct {
+		Data struct {
+			Pool struct {
+				Name       string
+				APY        float64
+				Coin       struct{ USD float64 }
+				Validators struct {
+					PageInfo struct{ Total int }
+					Items    []struct {
+						Validator struct {
+							VotePK string
+							Pools  []struct{ ActiveStake float64 }
+						}
+					}
+				}
+			}
+			Missing *struct{ Name string }
+		}
+		Errors []interface{}
+	}
+	assert.NilError(t, json.Unmarshal(w.Body.Bytes(), &resp), w.Body.String())
+	assert.Equal(t, len(resp.Errors), 0, w.Body.String())
+	assert.Equal(t, resp.Data.Pool.Name, "Eversol")
+	assert.Equal(t, resp.Data.Pool.APY, 0.07)
+	assert.Equal(t, resp.Data.Pool.Coin.USD, 42.0)
+	assert.Equal(t, resp.Data.Pool.Validators.PageInfo.Total, 42)
+	assert.Equal(t, len(resp.Data.Pool.Validators.Items), 3)
+	assert.Equal(t, len(resp.Data.Pool.Validators.Items[1].Validator.Pools), 1)
+	assert.Assert(t, resp.Data.Missing == nil)
+
+	assert.Equal(t, len(svc.GetPoolsValidatorsCalls()), 1)
+	assert.Equal(t, svc.GetPoolsValidatorsCalls()[0].Sort, "pool stake")
+	assert.Equal(t, len(svc.GetValidatorsPoolsCalls()), 1, "validator pools are loaded in one batch")
+	assert.Equal(t, len(svc.GetValidatorsPoolsCalls()[0].VotePKs), 3)
+	assert.Equal(t, len(svc.GetCoinsCalls()), 1)
+}
+
+func post(t *testing.T, svc services.Service, query string) map[string]interface{} {
+	h, err := graphql.New(svc, zap.NewNop())
+	assert.NilError(t, err)
+	router := gin.New()
+	router.POST("/graphql", h.Serve)
+
+	body, _ := json.Marshal(map[string]interface{}{"query": query})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	assert.Equal(t, w.Code, http.StatusOK)
+	var resp map[string]interface{}
+	assert.NilError(t, json.Unmarshal(w.Body.Bytes(), &resp), w.Body.String())
+	return resp
+}
+
+func TestServePoolsBatch(t *testing.T) {
+	svc := newService()
+	resp := post(t, svc, `{ pools(limit: 5) {
+		pageInfo { limit }
+		items { name validators(limit: 2) { items { validator { votePk } } } history { apy } }
+	} }`)
+	assert.Assert(t, resp["errors"] == nil, resp)
+	assert.Equal(t, len(svc.GetPoolsValidatorsCalls()), 1, "validators of all pools are loaded in one batch")
+	assert.Equal(t, len(svc.GetPoolsStatisticCalls()), 1, "history of all pools is loaded in one batch")
+	assert.Equal(t, len(svc.GetPoolsValidatorsCalls()[0].Names), 5)
+	pools := resp["data"].(map[string]interface{})["pools"].(map[string]interface{})
+	assert.Equal(t, len(pools["items"].([]interface{})), 5)
+}
+
+func TestServeLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		limits []uint64
+		err    bool
+	}{
+		{name: "clamped up", query: `{ pools(limit: 0) { pageInfo { limit } } }`, limits: []uint64{1}},
+		{name: "clamped down", query: `{ pools(limit: 1000) { pageInfo { limit } } }`, limits: []uint64{100}},
+		{name: "complexity", query: `{ pools(limit: 100) { items { validators(limit: 100) { pageInfo { total } } } } }`, limits: []uint64{100}, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newService()
+			resp := post(t, svc, tt.query)
+			var limits []uint64
+			for _, c := range svc.GetPoolsCalls() {
+				limits = append(limits, c.From)
+			}
+			assert.DeepEqual(t, limits, tt.limits)
+			if tt.err {
+				assert.Assert(t, resp["errors"] != nil, resp)
+				assert.Assert(t, strings.Contains(fmt.Sprint(resp["errors"]), "complexity limit"), resp)
+				return
+			}
+			assert.Assert(t, resp["errors"] == nil, resp)
+			pageInfo := resp["data"].(map[string]interface{})["pools"].(map[string]interface{})["pageInfo"].(map[string]interface{})
+			assert.Equal(t, pageInfo["limit"], float64(tt.limits[0]))
+		})
+	}
+}
+
+func TestServeBadRequest(t *testing.T) {
+	h, err := graphql.New(newService(), zap.NewNop())
+	assert.NilError(t, err)
+	router := gin.New()
+	router.GET("/graphql", h.Serve)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+	assert.Equal(t, w.Code, http.StatusBadRequest)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql?query={nope}", nil))
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Assert(t, bytes.Contains(w.Body.Bytes(), []byte(`"errors"`)), w.Body.String())
+}
@@ -0,0 +1,71 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// maxDepth bounds the nesting of a query, so relationships cannot be followed back and forth indefinitely.
+const maxDepth = 8
+
+//go:embed schema.graphql
+var schema string
+
+type (
+	Handler struct {
+		schema *graphql.Schema
+		svc    services.Service
+		log    *zap.Logger
+	}
+	request struct {
+		Query         string                 `json:"query" form:"query"`
+		OperationName string                 `json:"operationName" form:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+)
+
+func New(svc services.Service, log *zap.Logger) (*Handler, error) {
+	s, err := graphql.ParseSchema(schema, &query{svc: svc, log: log},
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(maxDepth),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("graphql.ParseSchema: %w", err)
+	}
+	return &Handler{schema: s, svc: svc, log: log}, nil
+}
+
+// Serve executes queries sent as JSON by POST, or in the query string by GET.
+func (h *Handler) Serve(ctx *gin.Context) {
+	var req request
+	if ctx.Request.Method == http.MethodGet {
+		if err := ctx.ShouldBindQuery(&req); err != nil {
+			tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, err))
+			return
+		}
+		if v := ctx.Query("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("invalid variables: %w", err)))
+				return
+			}
+		}
+	} else if err := ctx.ShouldBindJSON(&req); err != nil {
+		tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, err))
+		return
+	}
+	if req.Query == "" {
+		tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("query is required")))
+		return
+	}
+
+	c := context.WithValue(ctx.Request.Context(), loadersKey{}, newLoaders(h.svc))
+	ctx.JSON(http.StatusOK, h.schema.Exec(c, req.Query, req.OperationName, req.Variables))
+}
@@ -0,0 +1,257 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// maxComplexity bounds the items a query may ask for: every list counts its limit and every history historyCost.
+	maxComplexity = 2000
+	historyCost   = 10
+)
+
+type (
+	loadersKey struct{}
+	// loaders live for one query and load related entities for all parents at once: coins, DeFi pairs and pools
+	// are read whole on first use; the pools of validators and the validators and history of pools and governance
+	// tokens are read for every parent the query returned.
+	loaders struct {
+		svc        services.Service
+		complexity int64
+
+		coinsOnce sync.Once
+		coins     map[string]*smodels.Coin
+		coinsErr  error
+
+		defiOnce sync.Once
+		defi     map[string][]*smodels.DeFi
+		defiErr  error
+
+		poolsOnce sync.Once
+		pools     map[string]*smodels.PoolDetails
+		poolsErr  error
+
+		mu             sync.Mutex
+		pendingVotes   map[uint64][]string
+		validatorPools map[uint64]map[string][]*smodels.ValidatorPool
+
+		poolValidators    *batch
+		poolHistory       *batch
+		governanceHistory *batch
+	}
+	poolValidators struct {
+		data  []*smodels.PoolValidatorData
+		total uint64
+	}
+	// batch loads a value per key and name; the first load of a key fetches every primed name with it.
+	batch struct {
+		mu     sync.Mutex
+		primed []string
+		loaded map[string]map[string]interface{}
+	}
+)
+
+func newLoaders(svc services.Service) *loaders {
+	return &loaders{
+		svc:               svc,
+		pendingVotes:      make(map[uint64][]string),
+		validatorPools:    make(map[uint64]map[string][]*smodels.ValidatorPool),
+		poolValidators:    newBatch(),
+		poolHistory:       newBatch(),
+		governanceHistory: newBatch(),
+	}
+}
+
+func newBatch() *batch {
+	return &batch{loaded: make(map[string]map[string]interface{})}
+}
+
+func (b *batch) prime(names ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.primed = append(b.primed, names...)
+}
+
+func (b *batch) load(key string, name string, fetch func(names []string) (map[string]interface{}, error)) (interface{}, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	loaded, ok := b.loaded[key]
+	if !ok {
+		loaded = make(map[string]interface{})
+		b.loaded[key] = loaded
+	}
+	if v, ok := loaded[name]; ok {
+		return v, nil
+	}
+
+	names := []string{name}
+	for _, n := range b.primed {
+		if _, ok := loaded[n]; !ok && !contains(names, n) {
+			names = append(names, n)
+		}
+	}
+	values, err := fetch(names)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range names {
+		loaded[n] = values[n]
+	}
+	return values[name], nil
+}
+
+// charge adds n to the complexity of the query and fails once it exceeds maxComplexity.
+func (l *loaders) charge(n int) error {
+	if atomic.AddInt64(&l.complexity, int64(n)) > maxComplexity {
+		return fmt.Errorf("query exceeds the complexity limit of %d", maxComplexity)
+	}
+	return nil
+}
+
+// primePools queues pools whose validators and history are loaded together with the first pool asked for.
+func (l *loaders) primePools(names ...string) {
+	l.poolValidators.prime(names...)
+	l.poolHistory.prime(names...)
+}
+
+func (l *loaders) primeGovernance(names ...string) {
+	l.governanceHistory.prime(names...)
+}
+
+func (l *loaders) poolValidatorsPage(name string, validatorName string, sort string, desc bool, epoch uint64, limit uint64, offset uint64) (*poolValidators, error) {
+	key := fmt.Sprintf("%s|%s|%t|%d|%d|%d", validatorName, sort, desc, epoch, limit, offset)
+	v, err := l.poolValidators.load(key, name, func(names []string) (map[string]interface{}, error) {
+		pages, totals, err := l.svc.GetPoolsValidators(names, validatorName, sort, desc, epoch, smodels.ValidatorFilter{}, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]interface{}, len(names))
+		for _, n := range names {
+			values[n] = &poolValidators{data: pages[n], total: totals[n]}
+		}
+		return values, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*poolValidators), nil
+}
+
+func (l *loaders) poolStatistic(name string, aggregate string) ([]*smodels.Pool, error) {
+	v, err := l.poolHistory.load(aggregate, name, func(names []string) (map[string]interface{}, error) {
+		stats, err := l.svc.GetPoolsStatistic(names, aggregate)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]interface{}, len(names))
+		for _, n := range names {
+			values[n] = stats[n]
+		}
+		return values, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*smodels.Pool), nil
+}
+
+func (l *loaders) governanceSupply(name string, aggregate string) ([]*smodels.GovernanceSupply, error) {
+	v, err := l.governanceHistory.load(aggregate, name, func(names []string) (map[string]interface{}, error) {
+		history, err := l.svc.GetGovernancesHistory(names, aggregate)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]interface{}, len(names))
+		for _, n := range names {
+			values[n] = history[n]
+		}
+		return values, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*smodels.GovernanceSupply), nil
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func (l *loaders) coin(name string) (*smodels.Coin, error) {
+	l.coinsOnce.Do(func() {
+		coins, _, err := l.svc.GetCoins("", 0, 0)
+		l.coins, l.coinsErr = make(map[string]*smodels.Coin, len(coins)), err
+		for _, c := range coins {
+			l.coins[c.Name] = c
+		}
+	})
+	return l.coins[name], l.coinsErr
+}
+
+func (l *loaders) coinDeFi(name string) ([]*smodels.DeFi, error) {
+	l.defiOnce.Do(func() {
+		coins, _, err := l.svc.GetPoolCoins("", "", false, 0, 0)
+		l.defi, l.defiErr = make(map[string][]*smodels.DeFi, len(coins)), err
+		for _, c := range coins {
+			l.defi[c.Name] = c.DeFi
+		}
+	})
+	return l.defi[name], l.defiErr
+}
+
+func (l *loaders) pool(name string) (*smodels.PoolDetails, error) {
+	l.poolsOnce.Do(func() {
+		pools, _, err := l.svc.GetPools("", "", false, defaultEpoch, 0, 0)
+		l.pools, l.poolsErr = make(map[string]*smodels.PoolDetails, len(pools)), err
+		for _, p := range pools {
+			l.pools[p.Name] = p
+		}
+	})
+	return l.pools[name], l.poolsErr
+}
+
+// primeValidatorPools queues validators whose pools are loaded together with the first one asked for.
+func (l *loaders) primeValidatorPools(epoch uint64, votes ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pendingVotes[epoch] = append(l.pendingVotes[epoch], votes...)
+}
+
+func (l *loaders) validatorPool(epoch uint64, vote string) ([]*smodels.ValidatorPool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if pools, ok := l.validatorPools[epoch][vote]; ok {
+		return pools, nil
+	}
+
+	votes := l.pendingVotes[epoch]
+	delete(l.pendingVotes, epoch)
+	if !contains(votes, vote) {
+		votes = append(votes, vote)
+	}
+	pools, err := l.svc.GetValidatorsPools(votes, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if l.validatorPools[epoch] == nil {
+		l.validatorPools[epoch] = make(map[string][]*smodels.ValidatorPool, len(votes))
+	}
+	for _, v := range votes {
+		l.validatorPools[epoch][v] = pools[v]
+	}
+	return pools[vote], nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
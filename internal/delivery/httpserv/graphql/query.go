@@ -0,0 +1,240 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"go.uber.org/zap"
+	"strings"
+)
+
+const (
+	defaultEpoch = 10
+	maxLimit     = 100
+)
+
+var errInternal = errors.New("internal server error")
+
+type (
+	query struct {
+		svc services.Service
+		log *zap.Logger
+	}
+	pageArgs struct {
+		Limit  int32
+		Offset int32
+	}
+	pageInfo struct {
+		Limit  int32
+		Offset int32
+		Total  int32
+	}
+)
+
+// limit clamps the requested page size to 1..maxLimit.
+func (a pageArgs) limit() uint64 {
+	switch {
+	case a.Limit < 1:
+		return 1
+	case a.Limit > maxLimit:
+		return maxLimit
+	}
+	return uint64(a.Limit)
+}
+
+func (a pageArgs) offset() uint64 {
+	if a.Offset < 0 {
+		return 0
+	}
+	return uint64(a.Offset)
+}
+
+func newPageInfo(args pageArgs, total uint64) *pageInfo {
+	return &pageInfo{Limit: int32(args.limit()), Offset: int32(args.offset()), Total: int32(total)}
+}
+
+// internal logs err and returns a generic error so database details never reach the response.
+func (q *query) internal(method string, err error) error {
+	q.log.Error("GraphQL "+method, zap.Error(err))
+	return errInternal
+}
+
+// sortParam turns an enum value into the sort parameter of the REST API, e.g. POOL_STAKE into "pool stake".
+func sortParam(value string) string {
+	return strings.ToLower(strings.ReplaceAll(value, "_", " "))
+}
+
+func (q *query) Epoch() (*epoch, error) {
+	e, err := q.svc.GetEpoch()
+	if err != nil {
+		return nil, q.internal("Epoch", err)
+	}
+	return &epoch{e}, nil
+}
+
+func (q *query) Pool(args struct {
+	Name  string
+	Epoch int32
+}) (*pool, error) {
+	p, err := q.svc.GetPool(args.Name, uint64(args.Epoch))
+	if err != nil {
+		if errors.Is(err, postgres.ErrorRecordNotFounded) {
+			return nil, nil
+		}
+		return nil, q.internal("Pool", err)
+	}
+	return &pool{q: q, p: &p.Pool, epoch: uint64(args.Epoch)}, nil
+}
+
+func (q *query) Pools(ctx context.Context, args struct {
+	Name  string
+	Sort  string
+	Desc  bool
+	Epoch int32
+	pageArgs
+}) (*poolPage, error) {
+	l := loadersFrom(ctx)
+	if err := l.charge(int(args.limit())); err != nil {
+		return nil, err
+	}
+	pools, total, err := q.svc.GetPools(args.Name, sortParam(args.Sort), args.Desc, uint64(args.Epoch), args.limit(), args.offset())
+	if err != nil {
+		return nil, q.internal("Pools", err)
+	}
+	page := &poolPage{Items: make([]*pool, len(pools)), PageInfo: newPageInfo(args.pageArgs, total)}
+	names := make([]string, len(pools))
+	for i, p := range pools {
+		page.Items[i] = &pool{q: q, p: &p.Pool, epoch: uint64(args.Epoch)}
+		names[i] = p.Name
+	}
+	l.primePools(names...)
+	return page, nil
+}
+
+func (q *query) Validator(args struct {
+	Vote  string
+	Epoch int32
+}) (*validator, error) {
+	v, err := q.svc.GetValidator(args.Vote, uint64(args.Epoch), 1)
+	if err != nil {
+		if errors.Is(err, postgres.ErrorRecordNotFounded) {
+			return nil, nil
+		}
+		return nil, q.internal("Validator", err)
+	}
+	return &validator{q: q, v: &v.Validator, epoch: uint64(args.Epoch), pools: v.Pools}, nil
+}
+
+func (q *query) Validators(ctx context.Context, args struct {
+	Name   string
+	Sort   string
+	Desc   bool
+	Epoch  int32
+	Epochs *[]int32
+	pageArgs
+}) (*validatorPage, error) {
+	if err := loadersFrom(ctx).charge(int(args.limit())); err != nil {
+		return nil, err
+	}
+	var epochs []uint64
+	if args.Epochs != nil {
+		for _, e := range *args.Epochs {
+			epochs = append(epochs, uint64(e))
+		}
+	}
+	validators, total, err := q.svc.GetAllValidators(args.Name, sortParam(args.Sort), args.Desc, uint64(args.Epoch), epochs, smodels.ValidatorFilter{}, args.limit(), args.offset())
+	if err != nil {
+		return nil, q.internal("Validators", err)
+	}
+	page := &validatorPage{Items: make([]*validator, len(validators)), PageInfo: newPageInfo(args.pageArgs, total)}
+	votes := make([]string, len(validators))
+	for i, v := range validators {
+		page.Items[i] = &validator{q: q, v: v, epoch: uint64(args.Epoch)}
+		votes[i] = v.VotePK
+	}
+	loadersFrom(ctx).primeValidatorPools(uint64(args.Epoch), votes...)
+	return page, nil
+}
+
+func (q *query) Coins(ctx context.Context, args struct {
+	Name string
+	pageArgs
+}) (*coinPage, error) {
+	if err := loadersFrom(ctx).charge(int(args.limit())); err != nil {
+		return nil, err
+	}
+	coins, total, err := q.svc.GetCoins(args.Name, args.limit(), args.offset())
+	if err != nil {
+		return nil, q.internal("Coins", err)
+	}
+	return q.coinPage(coins, args.pageArgs, total), nil
+}
+
+func (q *query) PoolCoins(ctx context.Context, args struct {
+	Name string
+	Sort string
+	Desc bool
+	pageArgs
+}) (*coinPage, error) {
+	if err := loadersFrom(ctx).charge(int(args.limit())); err != nil {
+		return nil, err
+	}
+	coins, total, err := q.svc.GetPoolCoins(args.Name, sortParam(args.Sort), args.Desc, args.limit(), args.offset())
+	if err != nil {
+		return nil, q.internal("PoolCoins", err)
+	}
+	return q.coinPage(coins, args.pageArgs, total), nil
+}
+
+func (q *query) coinPage(coins []*smodels.Coin, args pageArgs, total uint64) *coinPage {
+	page := &coinPage{Items: make([]*coin, len(coins)), PageInfo: newPageInfo(args, total)}
+	for i, c := range coins {
+		page.Items[i] = &coin{q: q, c: c}
+	}
+	return page
+}
+
+func (q *query) LiquidityPools(ctx context.Context, args struct {
+	Name string
+	pageArgs
+}) (*liquidityPoolPage, error) {
+	if err := loadersFrom(ctx).charge(int(args.limit())); err != nil {
+		return nil, err
+	}
+	lps, total, err := q.svc.GetLiquidityPools(args.Name, args.limit(), args.offset())
+	if err != nil {
+		return nil, q.internal("LiquidityPools", err)
+	}
+	page := &liquidityPoolPage{Items: make([]*liquidityPool, len(lps)), PageInfo: newPageInfo(args.pageArgs, total)}
+	for i, lp := range lps {
+		page.Items[i] = &liquidityPool{lp}
+	}
+	return page, nil
+}
+
+func (q *query) Governance(ctx context.Context, args struct {
+	Name string
+	Sort string
+	Desc bool
+	pageArgs
+}) (*governancePage, error) {
+	l := loadersFrom(ctx)
+	if err := l.charge(int(args.limit())); err != nil {
+		return nil, err
+	}
+	// governance sort parameters keep their underscores, e.g. market_cap
+	gs, total, err := q.svc.GetGovernance(args.Name, strings.ToLower(args.Sort), args.Desc, args.limit(), args.offset())
+	if err != nil {
+		return nil, q.internal("Governance", err)
+	}
+	page := &governancePage{Items: make([]*governance, len(gs)), PageInfo: newPageInfo(args.pageArgs, total)}
+	names := make([]string, len(gs))
+	for i, g := range gs {
+		page.Items[i] = &governance{q: q, g: g}
+		names[i] = g.Name
+	}
+	l.primeGovernance(names...)
+	return page, nil
+}
@@ -0,0 +1,337 @@
+package graphql
+
+import (
+	"context"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+type (
+	epoch struct {
+		e *smodels.EpochInfo
+	}
+	pool struct {
+		q     *query
+		p     *smodels.Pool
+		epoch uint64
+	}
+	poolSnapshot struct {
+		p *smodels.Pool
+	}
+	validator struct {
+		q     *query
+		v     *smodels.Validator
+		epoch uint64
+		// pools are set when the validator was read with its pools
+		pools []*smodels.ValidatorPool
+	}
+	poolValidator struct {
+		Validator       *validator
+		PoolActiveStake float64
+	}
+	validatorPool struct {
+		q  *query
+		vp *smodels.ValidatorPool
+	}
+	coin struct {
+		q *query
+		c *smodels.Coin
+	}
+	defiPair struct {
+		q *query
+		d *smodels.DeFi
+	}
+	liquidityPool struct {
+		lp *smodels.LiquidityPool
+	}
+	governance struct {
+		q *query
+		g *smodels.Governance
+	}
+	governanceSupply struct {
+		s *smodels.GovernanceSupply
+	}
+
+	poolPage struct {
+		Items    []*pool
+		PageInfo *pageInfo
+	}
+	validatorPage struct {
+		Items    []*validator
+		PageInfo *pageInfo
+	}
+	poolValidatorPage struct {
+		Items    []*poolValidator
+		PageInfo *pageInfo
+	}
+	coinPage struct {
+		Items    []*coin
+		PageInfo *pageInfo
+	}
+	liquidityPoolPage struct {
+		Items    []*liquidityPool
+		PageInfo *pageInfo
+	}
+	governancePage struct {
+		Items    []*governance
+		PageInfo *pageInfo
+	}
+	aggregationArgs struct {
+		Aggregation string
+	}
+)
+
+func float(d decimal.Decimal) float64 {
+	f, _ := d.Float64()
+	return f
+}
+
+// aggregate turns an Aggregation value into the aggregation of the REST API, e.g. HALF_YEAR into "half-year".
+func aggregate(value string) string {
+	return strings.ToLower(strings.ReplaceAll(value, "_", "-"))
+}
+
+func timePtr(t *graphql.Time) *graphql.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+func (e *epoch) Epoch() int32           { return int32(e.e.Epoch) }
+func (e *epoch) SlotsInEpoch() int32    { return int32(e.e.SlotsInEpoch) }
+func (e *epoch) SPS() float64           { return e.e.SPS }
+func (e *epoch) EndEpoch() graphql.Time { return graphql.Time{Time: e.e.EndEpoch} }
+func (e *epoch) Progress() int32        { return int32(e.e.Progress) }
+
+func (p *pool) Name() string              { return p.p.Name }
+func (p *pool) Address() string           { return p.p.Address }
+func (p *pool) Image() string             { return p.p.Image }
+func (p *pool) Currency() string          { return p.p.Currency }
+func (p *pool) ActiveStake() float64      { return float(p.p.ActiveStake.Decimal) }
+func (p *pool) TokensSupply() float64     { return float(p.p.TokensSupply.Decimal) }
+func (p *pool) TotalSol() float64         { return float(p.p.TotalLamports.Decimal) }
+func (p *pool) APY() float64              { return float(p.p.APY) }
+func (p *pool) ValidatorCount() int32     { return int32(p.p.ValidatorCount) }
+func (p *pool) AVGSkippedSlots() float64  { return float(p.p.AVGSkippedSlots) }
+func (p *pool) AVGScore() int32           { return int32(p.p.AVGScore) }
+func (p *pool) StakingAccounts() int32    { return int32(p.p.StakingAccounts) }
+func (p *pool) Delinquent() int32         { return int32(p.p.Delinquent) }
+func (p *pool) UnstakeLiquidity() float64 { return float(p.p.UnstakeLiquidity.Decimal) }
+func (p *pool) DepositFee() float64       { return float(p.p.DepossitFee) }
+func (p *pool) WithdrawalFee() float64    { return float(p.p.WithdrawalFee) }
+func (p *pool) RewardsFee() float64       { return float(p.p.RewardsFee) }
+
+func (p *pool) Coin(ctx context.Context) (*coin, error) {
+	c, err := loadersFrom(ctx).coin(p.p.Currency)
+	if err != nil {
+		return nil, p.q.internal("Pool.Coin", err)
+	}
+	if c == nil {
+		return nil, nil
+	}
+	return &coin{q: p.q, c: c}, nil
+}
+
+func (p *pool) Validators(ctx context.Context, args struct {
+	Name  string
+	Sort  string
+	Desc  bool
+	Epoch int32
+	pageArgs
+}) (*poolValidatorPage, error) {
+	l := loadersFrom(ctx)
+	if err := l.charge(int(args.limit())); err != nil {
+		return nil, err
+	}
+	pv, err := l.poolValidatorsPage(p.p.Name, args.Name, sortParam(args.Sort), args.Desc, uint64(args.Epoch), args.limit(), args.offset())
+	if err != nil {
+		return nil, p.q.internal("Pool.Validators", err)
+	}
+	data := pv.data
+	page := &poolValidatorPage{Items: make([]*poolValidator, len(data)), PageInfo: newPageInfo(args.pageArgs, pv.total)}
+	votes := make([]string, len(data))
+	for i, d := range data {
+		page.Items[i] = &poolValidator{
+			Validator: &validator{q: p.q, epoch: uint64(args.Epoch), v: &smodels.Validator{
+				Image:            d.Image,
+				Name:             d.Name,
+				Delinquent:       d.Delinquent,
+				StakingAccounts:  d.StakingAccounts,
+				NodePK:           d.NodePK,
+				APY:              d.APY,
+				VotePK:           d.VotePK,
+				TotalActiveStake: d.TotalActiveStake,
+				Fee:              d.Fee,
+				Score:            d.Score,
+				SkippedSlots:     d.SkippedSlots,
+				DataCenter:       d.DataCenter,
+				Epoch:            d.Epoch,
+			}},
+			PoolActiveStake: float(d.PoolActiveStake.Decimal),
+		}
+		votes[i] = d.VotePK
+	}
+	l.primeValidatorPools(uint64(args.Epoch), votes...)
+	return page, nil
+}
+
+func (p *pool) History(ctx context.Context, args aggregationArgs) ([]*poolSnapshot, error) {
+	l := loadersFrom(ctx)
+	if err := l.charge(historyCost); err != nil {
+		return nil, err
+	}
+	history, err := l.poolStatistic(p.p.Name, aggregate(args.Aggregation))
+	if err != nil {
+		return nil, p.q.internal("Pool.History", err)
+	}
+	snapshots := make([]*poolSnapshot, len(history))
+	for i, h := range history {
+		snapshots[i] = &poolSnapshot{h}
+	}
+	return snapshots, nil
+}
+
+func (s *poolSnapshot) ActiveStake() float64      { return float(s.p.ActiveStake.Decimal) }
+func (s *poolSnapshot) TokensSupply() float64     { return float(s.p.TokensSupply.Decimal) }
+func (s *poolSnapshot) TotalSol() float64         { return float(s.p.TotalLamports.Decimal) }
+func (s *poolSnapshot) APY() float64              { return float(s.p.APY) }
+func (s *poolSnapshot) UnstakeLiquidity() float64 { return float(s.p.UnstakeLiquidity.Decimal) }
+func (s *poolSnapshot) Delinquent() int32         { return int32(s.p.Delinquent) }
+func (s *poolSnapshot) SOLUSD() float64           { return s.p.SOLUSD.InexactFloat64() }
+func (s *poolSnapshot) TokenUSD() float64         { return s.p.TokenUSD.InexactFloat64() }
+func (s *poolSnapshot) CreatedAt() graphql.Time   { return graphql.Time{Time: s.p.CreatedAt} }
+
+func (v *validator) VotePK() string            { return v.v.VotePK }
+func (v *validator) NodePK() string            { return v.v.NodePK }
+func (v *validator) Name() string              { return v.v.Name }
+func (v *validator) Image() string             { return v.v.Image }
+func (v *validator) Delinquent() bool          { return v.v.Delinquent }
+func (v *validator) APY() float64              { return float(v.v.APY) }
+func (v *validator) TotalActiveStake() float64 { return float(v.v.TotalActiveStake.Decimal) }
+func (v *validator) StakingAccounts() int32    { return int32(v.v.StakingAccounts) }
+func (v *validator) Fee() float64              { return float(v.v.Fee) }
+func (v *validator) Score() int32              { return int32(v.v.Score) }
+func (v *validator) SkippedSlots() float64     { return float(v.v.SkippedSlots) }
+func (v *validator) DataCenter() string        { return v.v.DataCenter }
+func (v *validator) Epoch() int32              { return int32(v.v.Epoch) }
+
+func (v *validator) Pools(ctx context.Context) ([]*validatorPool, error) {
+	pools := v.pools
+	if pools == nil {
+		var err error
+		if pools, err = loadersFrom(ctx).validatorPool(v.epoch, v.v.VotePK); err != nil {
+			return nil, v.q.internal("Validator.Pools", err)
+		}
+	}
+	result := make([]*validatorPool, len(pools))
+	for i, p := range pools {
+		result[i] = &validatorPool{q: v.q, vp: p}
+	}
+	return result, nil
+}
+
+func (vp *validatorPool) Pool(ctx context.Context) (*pool, error) {
+	p, err := loadersFrom(ctx).pool(vp.vp.Name)
+	if err != nil {
+		return nil, vp.q.internal("ValidatorPool.Pool", err)
+	}
+	if p == nil {
+		return nil, nil
+	}
+	return &pool{q: vp.q, p: &p.Pool, epoch: defaultEpoch}, nil
+}
+
+func (vp *validatorPool) ActiveStake() float64 { return float(vp.vp.ActiveStake.Decimal) }
+func (vp *validatorPool) PoolShare() float64   { return float(vp.vp.PoolShare) }
+
+func (c *coin) Name() string         { return c.c.Name }
+func (c *coin) Address() string      { return c.c.Address }
+func (c *coin) USD() float64         { return c.c.USD }
+func (c *coin) PriceSources() string { return c.c.PriceSources }
+func (c *coin) PriceStale() bool     { return c.c.PriceStale }
+func (c *coin) ThumbImage() string   { return c.c.ThumbImage }
+func (c *coin) SmallImage() string   { return c.c.SmallImage }
+func (c *coin) LargeImage() string   { return c.c.LargeImage }
+
+func (c *coin) PriceUpdatedAt() *graphql.Time {
+	if c.c.PriceUpdatedAt == nil {
+		return nil
+	}
+	return timePtr(&graphql.Time{Time: *c.c.PriceUpdatedAt})
+}
+
+func (c *coin) DeFi(ctx context.Context) ([]*defiPair, error) {
+	defi := c.c.DeFi
+	if defi == nil {
+		var err error
+		if defi, err = loadersFrom(ctx).coinDeFi(c.c.Name); err != nil {
+			return nil, c.q.internal("Coin.DeFi", err)
+		}
+	}
+	pairs := make([]*defiPair, len(defi))
+	for i, d := range defi {
+		pairs[i] = &defiPair{q: c.q, d: d}
+	}
+	return pairs, nil
+}
+
+func (d *defiPair) BuyCoin() *coin                { return &coin{q: d.q, c: d.d.BuyCoin} }
+func (d *defiPair) LiquidityPool() *liquidityPool { return &liquidityPool{d.d.LiquidityPool} }
+func (d *defiPair) Liquidity() float64            { return d.d.Liquidity }
+func (d *defiPair) Volume24H() float64            { return d.d.Volume24H }
+func (d *defiPair) APY() float64                  { return float(d.d.APY) }
+func (d *defiPair) FeeAPR() float64               { return float(d.d.FeeAPR) }
+func (d *defiPair) RewardAPR() float64            { return float(d.d.RewardAPR) }
+
+func (lp *liquidityPool) Name() string   { return lp.lp.Name }
+func (lp *liquidityPool) About() string  { return lp.lp.About }
+func (lp *liquidityPool) Image() string  { return lp.lp.Image }
+func (lp *liquidityPool) URL() string    { return lp.lp.URL }
+func (lp *liquidityPool) Status() string { return lp.lp.Status }
+
+func (lp *liquidityPool) SyncedAt() *graphql.Time {
+	if lp.lp.SyncedAt == nil {
+		return nil
+	}
+	return timePtr(&graphql.Time{Time: *lp.lp.SyncedAt})
+}
+
+func (g *governance) Name() string                { return g.g.Name }
+func (g *governance) Symbol() string              { return g.g.Symbol }
+func (g *governance) Image() string               { return g.g.Image }
+func (g *governance) GeckoKey() string            { return g.g.GeckoKey }
+func (g *governance) Blockchain() string          { return g.g.Blockchain }
+func (g *governance) ContractAddress() string     { return g.g.ContractAddress }
+func (g *governance) MaximumTokenSupply() float64 { return g.g.MaximumTokenSupply }
+func (g *governance) CirculatingSupply() float64  { return g.g.CirculatingSupply }
+func (g *governance) OnChainSupply() float64      { return g.g.OnChainSupply }
+func (g *governance) InflationRate() float64      { return g.g.InflationRate }
+func (g *governance) USD() float64                { return g.g.USD }
+func (g *governance) MarketCap() float64          { return g.g.MarketCap }
+func (g *governance) FDV() float64                { return g.g.FDV }
+
+func (g *governance) History(ctx context.Context, args aggregationArgs) ([]*governanceSupply, error) {
+	l := loadersFrom(ctx)
+	if err := l.charge(historyCost); err != nil {
+		return nil, err
+	}
+	history, err := l.governanceSupply(g.g.Name, aggregate(args.Aggregation))
+	if err != nil {
+		return nil, g.q.internal("Governance.History", err)
+	}
+	supplies := make([]*governanceSupply, len(history))
+	for i, s := range history {
+		supplies[i] = &governanceSupply{s}
+	}
+	return supplies, nil
+}
+
+func (s *governanceSupply) MaximumTokenSupply() float64 { return s.s.MaximumTokenSupply }
+func (s *governanceSupply) CirculatingSupply() float64  { return s.s.CirculatingSupply }
+func (s *governanceSupply) USD() float64                { return s.s.USD }
+func (s *governanceSupply) MarketCap() float64          { return s.s.MarketCap }
+func (s *governanceSupply) FDV() float64                { return s.s.FDV }
+func (s *governanceSupply) CreatedAt() graphql.Time     { return graphql.Time{Time: s.s.CreatedAt} }
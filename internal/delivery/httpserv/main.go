@@ -4,14 +4,20 @@ import (
 	"fmt"
 	"github.com/everstake/solana-pools/config"
 	"github.com/everstake/solana-pools/docs"
+	docsv2 "github.com/everstake/solana-pools/docs/v2"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/admin"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/graphql"
 	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
 	v1 "github.com/everstake/solana-pools/internal/delivery/httpserv/v1"
+	v2 "github.com/everstake/solana-pools/internal/delivery/httpserv/v2"
 	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/pkg/ratelimit"
 	"github.com/gin-contrib/cors"
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"github.com/swaggo/swag"
 	"go.uber.org/zap"
 	"net/http"
 	"strings"
@@ -20,19 +26,29 @@ import (
 
 type (
 	API struct {
-		cfg config.Env
-		svc services.Service
-		log *zap.Logger
-		v1  *v1.Handler
+		cfg     config.Env
+		svc     services.Service
+		log     *zap.Logger
+		v1      *v1.Handler
+		v2      *v2.Handler
+		admin   *admin.Handler
+		graphql *graphql.Handler
 	}
 )
 
 func NewAPI(cfg config.Env, svc services.Service, log *zap.Logger) (api *API, err error) {
+	gql, err := graphql.New(svc, log)
+	if err != nil {
+		return nil, fmt.Errorf("graphql.New: %w", err)
+	}
 	return &API{
-		cfg: cfg,
-		svc: svc,
-		log: log,
-		v1:  v1.New(svc, log),
+		cfg:     cfg,
+		svc:     svc,
+		log:     log,
+		v1:      v1.New(svc, log),
+		v2:      v2.New(svc, log),
+		admin:   admin.New(svc, log),
+		graphql: gql,
 	}, nil
 }
 
@@ -40,6 +56,7 @@ func (api *API) Run() error {
 	gin.SetMode(api.cfg.GinMode)
 
 	router := gin.New()
+	router.TrustedProxies = api.cfg.TrustedProxies
 	router.Use(ginzap.Ginzap(
 		api.log, time.RFC3339, true),
 		gin.Recovery(),
@@ -52,10 +69,19 @@ func (api *API) Run() error {
 	docs.SwaggerInfo.Version = "1.0"
 	docs.SwaggerInfo.Host = api.cfg.HttpSwaggerAddress
 	docs.SwaggerInfo.Schemes = []string{"http", "https", "ws"}
+	docsv2.SwaggerInfo.BasePath = "/v2"
+	docsv2.SwaggerInfo.Title = "Stake-Solana API v2"
+	docsv2.SwaggerInfo.Description = "Responses are envelopes with the data and, for lists, the pagination; errors are RFC 7807 problems with a stable code. " +
+		"Amounts are exact in lamports, as strings, next to their SOL value; decimals are strings."
+	docsv2.SwaggerInfo.Version = "2.0"
+	docsv2.SwaggerInfo.Host = api.cfg.HttpSwaggerAddress
+	docsv2.SwaggerInfo.Schemes = []string{"http", "https"}
 	router.GET("/", func(ctx *gin.Context) {
 		links := []string{
 			fmt.Sprintf(`<a href="http://%s">%s</a> - swagger`,
 				api.cfg.HttpSwaggerAddress+"/swagger/index.html", api.cfg.HttpSwaggerAddress+"/swagger/v1/index.html"),
+			fmt.Sprintf(`<a href="http://%s">%s</a> - swagger v2`,
+				api.cfg.HttpSwaggerAddress+"/swagger-v2/index.html", api.cfg.HttpSwaggerAddress+"/swagger-v2/index.html"),
 		}
 
 		content := strings.Join(links, "\n")
@@ -64,19 +90,83 @@ func (api *API) Run() error {
 		ctx.String(http.StatusCreated, `<html>%s</html>`, content)
 	})
 
-	v1g := router.Group("/v1")
-	v1g.GET("/epoch", tools.Must(api.v1.GetEpoch))
-	v1g.GET("/pools", tools.Must(api.v1.GetPools))
-	v1g.GET("/coins", tools.Must(api.v1.GetCoins))
-	v1g.GET("/pool-coins", tools.Must(api.v1.GetPoolsCoins))
-	v1g.GET("/governance", tools.Must(api.v1.GetGovernance))
-	v1g.GET("/validators", tools.Must(api.v1.GetAllValidators))
-	v1g.GET("/pool-validators/:pname", tools.Must(api.v1.GetPoolValidators))
-	v1g.GET("/pool/:name", tools.WSMust(api.v1.GetPool, time.Second*30))
-	v1g.GET("/pool-statistic", tools.Must(api.v1.GetPoolsStatistic))
-	v1g.GET("/pools-statistic", tools.WSMust(api.v1.GetTotalPoolsStatistic, time.Second*30))
-	v1g.GET("/liquidity-pools", tools.Must(api.v1.GetLiquidityPools))
+	tiers, err := ratelimit.ParseTiers(api.cfg.RateLimitTiers)
+	if err != nil {
+		return fmt.Errorf("ratelimit.ParseTiers: %w", err)
+	}
+
+	v1g := router.Group("/v1", api.rateLimit(tiers, tools.Abort))
+	v1g.GET("/epoch", api.cache(30*time.Second, epochTypes...), tools.Must(api.v1.GetEpoch))
+	v1g.GET("/pools", api.cache(time.Minute, poolTypes...), tools.Must(api.v1.GetPools))
+	v1g.GET("/coins", api.cache(time.Minute, coinTypes...), tools.Must(api.v1.GetCoins))
+	v1g.GET("/coins/:name/history", api.cache(time.Minute), tools.Must(api.v1.GetCoinHistory))
+	v1g.GET("/pool-coins", api.cache(time.Minute, poolCoinTypes...), tools.Must(api.v1.GetPoolsCoins))
+	v1g.GET("/governance", api.cache(time.Minute, governanceTypes...), tools.Must(api.v1.GetGovernance))
+	v1g.GET("/governance/:name/history", api.cache(time.Minute, governanceTypes...), tools.Must(api.v1.GetGovernanceHistory))
+	v1g.GET("/validators", api.cache(time.Minute, validatorTypes...), tools.Must(api.v1.GetAllValidators))
+	v1g.GET("/pool-validators/:pname", api.cache(time.Minute, poolTypes...), tools.Must(api.v1.GetPoolValidators))
+	v1g.GET("/validator/:vote", api.cache(time.Minute, validatorTypes...), tools.Must(api.v1.GetValidator))
+	v1g.GET("/pool/:name", api.cache(time.Minute, poolPegTypes...), tools.Must(api.v1.GetPool))
+	v1g.GET("/pool-statistic", api.cache(time.Minute, poolPriceTypes...), tools.Must(api.v1.GetPoolsStatistic))
+	v1g.GET("/pool-peg", api.cache(time.Minute, poolPegTypes...), tools.Must(api.v1.GetPoolPeg))
+	v1g.GET("/pools-statistic", api.cache(time.Minute, poolStatisticTypes...), tools.Must(api.v1.GetTotalPoolsStatistic))
+	v1g.GET("/liquidity-pools", api.cache(time.Minute, liquidityPoolTypes...), tools.Must(api.v1.GetLiquidityPools))
+	v1g.GET("/ws", api.v1.WS)
+	v1g.GET("/events", api.v1.Events)
+	v1g.GET("/search", api.cache(time.Minute), tools.Must(api.v1.Search))
+	go api.v1.ServeEvents()
+
+	v2g := router.Group("/v2", api.rateLimit(tiers, api.v2.Abort))
+	v2g.GET("/epoch", api.cache(30*time.Second, epochTypes...), api.v2.Must(api.v2.GetEpoch))
+	v2g.GET("/pools", api.cache(time.Minute, poolTypes...), api.v2.Must(api.v2.GetPools))
+	v2g.GET("/pools/:name", api.cache(time.Minute, poolPegTypes...), api.v2.Must(api.v2.GetPool))
+	v2g.GET("/pools/:name/validators", api.cache(time.Minute, poolTypes...), api.v2.Must(api.v2.GetPoolValidators))
+	v2g.GET("/pools/:name/statistic", api.cache(time.Minute, poolPriceTypes...), api.v2.Must(api.v2.GetPoolStatistic))
+	v2g.GET("/validators", api.cache(time.Minute, validatorTypes...), api.v2.Must(api.v2.GetValidators))
+	v2g.GET("/validators/:vote", api.cache(time.Minute, validatorTypes...), api.v2.Must(api.v2.GetValidator))
+
+	gql := router.Group("/graphql", api.rateLimit(tiers, tools.Abort))
+	gql.GET("", api.graphql.Serve)
+	gql.POST("", api.graphql.Serve)
+
+	ag := router.Group("/admin/v1", tools.BearerAuth(api.cfg.AdminTokens))
+	ag.GET("/pools", tools.Must(api.admin.GetPools))
+	ag.POST("/pools", tools.Must(api.admin.CreatePool))
+	ag.PUT("/pools/:id", tools.Must(api.admin.UpdatePool))
+	ag.DELETE("/pools/:id", tools.Must(api.admin.DeletePool))
+	ag.GET("/coins", tools.Must(api.admin.GetCoins))
+	ag.POST("/coins", tools.Must(api.admin.CreateCoin))
+	ag.PUT("/coins/:id", tools.Must(api.admin.UpdateCoin))
+	ag.DELETE("/coins/:id", tools.Must(api.admin.DeleteCoin))
+	ag.GET("/liquidity-pools", tools.Must(api.admin.GetLiquidityPools))
+	ag.POST("/liquidity-pools", tools.Must(api.admin.CreateLiquidityPool))
+	ag.PUT("/liquidity-pools/:id", tools.Must(api.admin.UpdateLiquidityPool))
+	ag.DELETE("/liquidity-pools/:id", tools.Must(api.admin.DeleteLiquidityPool))
+	ag.GET("/governance", tools.Must(api.admin.GetGovernance))
+	ag.POST("/governance", tools.Must(api.admin.CreateGovernance))
+	ag.PUT("/governance/:id", tools.Must(api.admin.UpdateGovernance))
+	ag.DELETE("/governance/:id", tools.Must(api.admin.DeleteGovernance))
+	ag.GET("/api-keys", tools.Must(api.admin.GetAPIKeys))
+	ag.POST("/api-keys", tools.Must(api.admin.CreateAPIKey))
+	ag.DELETE("/api-keys/:id", tools.Must(api.admin.DeactivateAPIKey))
+	ag.GET("/audit-log", tools.Must(api.admin.GetAuditLogs))
+	ag.GET("/export/:dataset", api.admin.Export)
+
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
+	swaggerV2 := ginSwagger.WrapHandler(swaggerfiles.Handler, ginSwagger.URL("doc.json"))
+	router.GET("/swagger-v2/*any", func(ctx *gin.Context) {
+		// the UI handler reads the default document, so the v2 one is served here
+		if ctx.Param("any") != "/doc.json" {
+			swaggerV2(ctx)
+			return
+		}
+		doc, err := swag.ReadDoc("v2")
+		if err != nil {
+			ctx.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		ctx.Data(http.StatusOK, "application/json; charset=utf-8", []byte(doc))
+	})
 	api.log.Info("Starting API server", zap.Uint64("port", api.cfg.HttpPort))
 	return router.Run(fmt.Sprintf(":%d", api.cfg.HttpPort))
 }
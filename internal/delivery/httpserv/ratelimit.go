@@ -0,0 +1,64 @@
+package httpserv
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/pkg/ratelimit"
+	"github.com/gin-gonic/gin"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// rateLimit authenticates the optional API key from the X-API-Key header and limits requests per key,
+// or per client IP without a key, by the tier limits; abort writes the errors.
+// A key lookup first takes a token from the client IP's anonymous bucket, so invalid keys cannot be tried faster than
+// anonymous requests; a failed lookup takes a second one and a valid key gets its token back.
+func (api *API) rateLimit(tiers map[string]ratelimit.Limit, abort func(*gin.Context, error)) gin.HandlerFunc {
+	limiter := ratelimit.New()
+	anonymous := tiers[ratelimit.AnonymousTier]
+	tooMany := func(ctx *gin.Context, tier string, retry time.Duration) {
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(retry.Seconds()))))
+		abort(ctx, tools.NewStatus(http.StatusTooManyRequests, fmt.Errorf("rate limit of %s tier exceeded, retry in %s", tier, retry.Round(time.Millisecond))))
+	}
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader("X-API-Key")
+		ip := "ip:" + ctx.ClientIP()
+
+		bucket, tier := ip, ratelimit.AnonymousTier
+		if key != "" {
+			if ok, retry := limiter.Allow(ip, anonymous, time.Now()); !ok {
+				ctx.Header("X-RateLimit-Limit", strconv.FormatFloat(anonymous.Rate, 'f', -1, 64))
+				tooMany(ctx, ratelimit.AnonymousTier, retry)
+				return
+			}
+			k, err := api.svc.GetAPIKey(key)
+			if err != nil {
+				limiter.Allow(ip, anonymous, time.Now())
+				if errors.Is(err, services.ErrInvalidAPIKey) {
+					abort(ctx, tools.NewStatus(http.StatusUnauthorized, err))
+					return
+				}
+				abort(ctx, err)
+				return
+			}
+			limiter.Refund(ip, anonymous)
+			bucket, tier = "key:"+k.ID.String(), k.Tier
+			api.svc.RecordAPIKeyUsage(k.ID)
+		}
+
+		limit, ok := tiers[tier]
+		if !ok {
+			limit = anonymous
+		}
+		ctx.Header("X-RateLimit-Limit", strconv.FormatFloat(limit.Rate, 'f', -1, 64))
+		if ok, retry := limiter.Allow(bucket, limit, time.Now()); !ok {
+			tooMany(ctx, tier, retry)
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"crypto/subtle"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+// ActorKey is the context key BearerAuth stores the authenticated admin name under.
+const ActorKey = "actor"
+
+// BearerAuth accepts requests with an "Authorization: Bearer <token>" header whose token matches one of tokens, keyed by admin name.
+func BearerAuth(tokens map[string]string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if token == header || token == "" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error": "unauthorized",
+			})
+			return
+		}
+		for name, t := range tokens {
+			if t != "" && subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+				ctx.Set(ActorKey, name)
+				ctx.Next()
+				return
+			}
+		}
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"error": "unauthorized",
+		})
+	}
+}
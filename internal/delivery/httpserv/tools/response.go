@@ -13,6 +13,8 @@ type MetaData struct {
 	Offset      uint64 `json:"offset"`
 	Limit       uint64 `json:"limit"`
 	TotalAmount uint64 `json:"total_amount"`
+	Next        string `json:"next,omitempty"`
+	Prev        string `json:"prev,omitempty"`
 }
 
 type ResponseError struct {
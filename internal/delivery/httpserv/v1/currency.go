@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"net/http"
+	"time"
+)
+
+// currencyRate returns the rate converting USD values into currency at the given time.
+func (h *Handler) currencyRate(currency string, at time.Time) (float64, error) {
+	rate, err := h.svc.GetCurrencyRate(currency, at)
+	if err != nil {
+		return 0, currencyStatus(currency, err)
+	}
+	return rate, nil
+}
+
+// currencyRates returns the rates converting USD values into currency between from and to.
+func (h *Handler) currencyRates(currency string, from time.Time, to time.Time) (smodels.CurrencyRates, error) {
+	rates, err := h.svc.GetCurrencyRates(currency, from, to)
+	if err != nil {
+		return rates, currencyStatus(currency, err)
+	}
+	return rates, nil
+}
+
+func currencyStatus(currency string, err error) error {
+	switch {
+	case errors.Is(err, services.ErrUnsupportedCurrency):
+		return tools.NewStatus(http.StatusBadRequest, fmt.Errorf("currency %s is not available", currency))
+	case errors.Is(err, services.ErrNoCurrencyRates):
+		return tools.NewStatus(http.StatusServiceUnavailable, fmt.Errorf("currency %s rates are not synced yet", currency))
+	}
+	return err
+}
+
+// The USD fields keep USD values; convert fills the currency-neutral fields next to them.
+
+func (c *coin) convert(currency string, rate float64) *coin {
+	c.Currency = currency
+	c.Price = c.USD * rate
+	for _, defi := range c.DeFi {
+		for _, d := range defi {
+			d.convert(currency, rate)
+		}
+	}
+	return c
+}
+
+func (f *deFi) convert(currency string, rate float64) *deFi {
+	f.Currency = currency
+	f.Liquidity *= rate
+	f.Volume24H *= rate
+	if f.BuyCoin != nil {
+		f.BuyCoin.convert(currency, rate)
+	}
+	for _, t := range f.Trends {
+		t.AVGLiquidity *= rate
+		t.LiquidityChange *= rate
+	}
+	return f
+}
+
+func (g *governance) convert(currency string, rate float64) *governance {
+	g.Currency = currency
+	g.Price = g.USD * rate
+	g.MarketCap *= rate
+	g.FDV *= rate
+	return g
+}
+
+func (gs *governanceSupply) convert(currency string, rate float64) *governanceSupply {
+	gs.Currency = currency
+	gs.Price = gs.USD * rate
+	gs.MarketCap *= rate
+	gs.FDV *= rate
+	return gs
+}
+
+func (ps *poolStatistic) convert(currency string, rate float64) *poolStatistic {
+	ps.Currency = currency
+	ps.SOLPrice = ps.SOLUSD * rate
+	ps.TokenPrice = ps.TokenUSD * rate
+	ps.TotalSolValue = ps.TotalSolUSD * rate
+	ps.TokensSupplyValue = ps.TokensSupplyUSD * rate
+	return ps
+}
+
+func (p *price) convert(currency string, rate float64) *price {
+	p.Currency = currency
+	p.Price = p.USD * rate
+	return p
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/everstake/solana-pools/internal/services/smodels"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"time"
 )
 
 // GetCoins godoc
@@ -17,21 +18,29 @@ import (
 // @Param offset query number true "offset for aggregation" default(0)
 // @Param limit query number true "limit for aggregation" default(10)
 // @Param name query string false "coin name"
+// @Param currency query string false "Currency of the converted price and value fields" default(usd)
 // @Success 200 {object} tools.ResponseArrayData{data=[]coin} "Ok"
 // @Failure 400,404 {object} tools.ResponseError "bad request"
 // @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure 503 {object} tools.ResponseError "currency rates are not synced yet"
 // @Failure default {object} tools.ResponseError "default response"
 // @Router /coins [get]
 func (h *Handler) GetCoins(ctx *gin.Context) (interface{}, error) {
 	q := struct {
-		Name   string `form:"name"`
-		Limit  uint64 `form:"limit,default=0"`
-		Offset uint64 `form:"offset,default=10"`
+		Name     string `form:"name"`
+		Limit    uint64 `form:"limit,default=0"`
+		Offset   uint64 `form:"offset,default=10"`
+		Currency string `form:"currency,default=usd"`
 	}{}
 	if err := ctx.ShouldBind(&q); err != nil {
 		return nil, tools.NewStatus(http.StatusBadRequest, err)
 	}
 
+	rate, err := h.currencyRate(q.Currency, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	scoins, count, err := h.svc.GetCoins(q.Name, q.Limit, q.Offset)
 	if err != nil {
 		return nil, tools.NewStatus(http.StatusInternalServerError, err)
@@ -39,7 +48,7 @@ func (h *Handler) GetCoins(ctx *gin.Context) (interface{}, error) {
 
 	coins := make([]*coin, len(scoins))
 	for i, c := range scoins {
-		coins[i] = (&coin{}).Set(c)
+		coins[i] = (&coin{}).Set(c).convert(q.Currency, rate)
 	}
 
 	return tools.ResponseArrayData{
@@ -55,7 +64,7 @@ func (h *Handler) GetCoins(ctx *gin.Context) (interface{}, error) {
 // GetPoolsCoins godoc
 // @Summary RestAPI
 // @Schemes
-// @Description The information about pool tokens with the specified search parameters.
+// @Description The information about pool tokens with the specified search parameters. DeFi pairs break their APY down into trading fee and farm reward APR and include 7 and 30 day trends.
 // @Tags coin
 // @Accept json
 // @Produce json
@@ -64,23 +73,31 @@ func (h *Handler) GetCoins(ctx *gin.Context) (interface{}, error) {
 // @Param desc query bool false "desc" default(true)
 // @Param offset query number true "offset for aggregation" default(0)
 // @Param limit query number true "limit for aggregation" default(10)
+// @Param currency query string false "Currency of the converted price and value fields" default(usd)
 // @Success 200 {object} tools.ResponseArrayData{data=[]coin} "Ok"
 // @Failure 400,404 {object} tools.ResponseError "bad request"
 // @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure 503 {object} tools.ResponseError "currency rates are not synced yet"
 // @Failure default {object} tools.ResponseError "default response"
 // @Router /pool-coins [get]
 func (h *Handler) GetPoolsCoins(ctx *gin.Context) (interface{}, error) {
 	q := struct {
-		Name   string `form:"name"`
-		Sort   string `form:"sort,default=price"`
-		Desc   bool   `form:"desc,default=true"`
-		Offset uint64 `form:"offset,default=0"`
-		Limit  uint64 `form:"limit,default=10"`
+		Name     string `form:"name"`
+		Sort     string `form:"sort,default=price"`
+		Desc     bool   `form:"desc,default=true"`
+		Offset   uint64 `form:"offset,default=0"`
+		Limit    uint64 `form:"limit,default=10"`
+		Currency string `form:"currency,default=usd"`
 	}{}
 	if err := ctx.ShouldBind(&q); err != nil {
 		return nil, tools.NewStatus(http.StatusBadRequest, err)
 	}
 
+	rate, err := h.currencyRate(q.Currency, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	scoins, count, err := h.svc.GetPoolCoins(q.Name, q.Sort, q.Desc, q.Limit, q.Offset)
 	if err != nil {
 		return nil, tools.NewStatus(http.StatusInternalServerError, err)
@@ -88,7 +105,7 @@ func (h *Handler) GetPoolsCoins(ctx *gin.Context) (interface{}, error) {
 
 	coins := make([]*coin, len(scoins))
 	for i, c := range scoins {
-		coins[i] = (&coin{}).Set(c)
+		coins[i] = (&coin{}).Set(c).convert(q.Currency, rate)
 	}
 
 	return tools.ResponseArrayData{
@@ -106,6 +123,10 @@ type liquidityPool struct {
 	About string `json:"about"`
 	Image string `json:"image"`
 	URL   string `json:"url"`
+
+	Status   string     `json:"status,omitempty"`
+	Error    string     `json:"error,omitempty"`
+	SyncedAt *time.Time `json:"synced_at,omitempty"`
 }
 
 func (lp *liquidityPool) Set(pool *smodels.LiquidityPool) *liquidityPool {
@@ -113,6 +134,9 @@ func (lp *liquidityPool) Set(pool *smodels.LiquidityPool) *liquidityPool {
 	lp.About = pool.About
 	lp.URL = pool.URL
 	lp.Image = pool.Image
+	lp.Status = pool.Status
+	lp.Error = pool.Error
+	lp.SyncedAt = pool.SyncedAt
 	return lp
 }
 
@@ -120,29 +144,68 @@ type deFi struct {
 	BuyCoin       *coin          `json:"buy_coin"`
 	LiquidityPool *liquidityPool `json:"liquidity_pool"`
 	Liquidity     float64        `json:"liquidity"`
+	Volume24H     float64        `json:"volume_24h"`
 	APY           float64        `json:"apy"`
+	FeeAPR        float64        `json:"fee_apr"`
+	RewardAPR     float64        `json:"reward_apr"`
+	Currency      string         `json:"currency"`
+	Trends        []*deFiTrend   `json:"trends,omitempty"`
+}
+
+type deFiTrend struct {
+	Days            uint64  `json:"days"`
+	AVGAPY          float64 `json:"avg_apy"`
+	AVGLiquidity    float64 `json:"avg_liquidity"`
+	APYChange       float64 `json:"apy_change"`
+	LiquidityChange float64 `json:"liquidity_change"`
 }
 
 func (f *deFi) Set(defi *smodels.DeFi, buyCoin *coin, liquidityPool *liquidityPool) *deFi {
 	f.APY, _ = defi.APY.Float64()
+	f.FeeAPR, _ = defi.FeeAPR.Float64()
+	f.RewardAPR, _ = defi.RewardAPR.Float64()
 	f.LiquidityPool = liquidityPool
 	f.BuyCoin = buyCoin
 	f.Liquidity = defi.Liquidity
+	f.Volume24H = defi.Volume24H
+	if defi.Trends != nil {
+		f.Trends = make([]*deFiTrend, len(defi.Trends))
+		for i, t := range defi.Trends {
+			f.Trends[i] = (&deFiTrend{}).Set(t)
+		}
+	}
 	return f
 }
 
+func (t *deFiTrend) Set(trend *smodels.DeFiTrend) *deFiTrend {
+	t.Days = trend.Days
+	t.AVGAPY, _ = trend.AVGAPY.Float64()
+	t.AVGLiquidity = trend.AVGLiquidity
+	t.APYChange, _ = trend.APYChange.Float64()
+	t.LiquidityChange = trend.LiquidityChange
+	return t
+}
+
 type coin struct {
-	Name       string             `json:"name"`
-	Address    string             `json:"address"`
-	USD        float64            `json:"usd"`
-	ThumbImage string             `json:"thumb_image"`
-	SmallImage string             `json:"small_image"`
-	LargeImage string             `json:"large_image"`
-	DeFi       map[string][]*deFi `json:"de_fi,omitempty"`
+	Name           string             `json:"name"`
+	Address        string             `json:"address"`
+	USD            float64            `json:"usd"`
+	Price          float64            `json:"price"`
+	Currency       string             `json:"currency"`
+	PriceUpdatedAt *time.Time         `json:"price_updated_at,omitempty"`
+	PriceSources   string             `json:"price_sources,omitempty"`
+	PriceStale     bool               `json:"price_stale"`
+	ThumbImage     string             `json:"thumb_image"`
+	SmallImage     string             `json:"small_image"`
+	LargeImage     string             `json:"large_image"`
+	DeFi           map[string][]*deFi `json:"de_fi,omitempty"`
 }
 
 func (c *coin) Set(coinM *smodels.Coin) *coin {
 	c.USD = coinM.USD
+	c.PriceUpdatedAt = coinM.PriceUpdatedAt
+	c.PriceSources = coinM.PriceSources
+	c.PriceStale = coinM.PriceStale
 	c.ThumbImage = coinM.ThumbImage
 	c.SmallImage = coinM.SmallImage
 	c.LargeImage = coinM.LargeImage
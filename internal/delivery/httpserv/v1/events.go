@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	sseBuffer    = 256
+	sseHeartbeat = 15 * time.Second
+	// sseRetry is the reconnection delay, in milliseconds, clients are told to use.
+	sseRetry = 3000
+	// sseResync tells the client events were missed and it should re-read the data it follows.
+	sseResync = "resync"
+)
+
+type sseEvent struct {
+	Topic     string      `json:"topic" example:"pool:Eversol"`
+	Data      interface{} `json:"data,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Events godoc
+// @Summary Server-Sent Events
+// @Schemes
+// @Description Streams an event every time the update jobs commit new data. The event name is the topic type (pool, validator,
+// @Description liquidity-pool, network-stats, epoch, coins or delinquency) and the data names the topic; only delinquency events carry
+// @Description their payload, the others tell which REST resource to re-read. Reconnecting with the Last-Event-ID header, or the
+// @Description last_event_id query parameter, replays the recent events that were missed; a resync event is sent when they are no longer kept.
+// @Tags events
+// @Produce text/event-stream
+// @Param topics query string false "Comma separated topics to stream, <type>:* selects every topic of a type; all topics by default." example(pool:*,network-stats)
+// @Param last_event_id query integer false "ID of the last received event."
+// @Param Last-Event-ID header integer false "ID of the last received event, set by EventSource on reconnect."
+// @Success 200 {object} sseEvent "Event stream"
+// @Failure 400 {object} tools.ResponseError "bad request"
+// @Router /events [get]
+func (h *Handler) Events(ctx *gin.Context) {
+	var filters []string
+	if topics := ctx.Query("topics"); topics != "" {
+		for _, f := range strings.Split(topics, ",") {
+			f = strings.TrimSpace(f)
+			if !events.ValidFilter(f) {
+				tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("unknown topic %s", f)))
+				return
+			}
+			filters = append(filters, f)
+		}
+	}
+
+	lastEventID := ctx.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = ctx.Query("last_event_id")
+	}
+	var last uint64
+	if lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			tools.Abort(ctx, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("invalid last event id %s", lastEventID)))
+			return
+		}
+		last = id
+	}
+
+	ch, unsubscribe := h.svc.Subscribe(sseBuffer, filters...)
+	defer unsubscribe()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	fmt.Fprintf(ctx.Writer, "retry: %d\n\n", sseRetry)
+
+	emit := func(e events.Event) {
+		if e.ID <= last {
+			return
+		}
+		last = e.ID
+		if events.Match(filters, e.Topic) {
+			writeSSE(ctx.Writer, e)
+		}
+	}
+	// replay sends the kept events after last, or a resync event and all kept events when some were dropped.
+	replay := func() {
+		missed, complete := h.svc.EventsSince(last)
+		if !complete {
+			fmt.Fprintf(ctx.Writer, "event: %s\ndata: {}\n\n", sseResync)
+			last = 0
+		}
+		for _, e := range missed {
+			emit(e)
+		}
+	}
+	if last != 0 {
+		replay()
+	}
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case e, ok := <-ch:
+			if !ok {
+				return
+			}
+			if e.Dropped {
+				if last != 0 {
+					replay()
+				} else {
+					fmt.Fprintf(ctx.Writer, "event: %s\ndata: {}\n\n", sseResync)
+				}
+			}
+			emit(e)
+		case <-heartbeat.C:
+			io.WriteString(ctx.Writer, ": ping\n\n")
+		}
+		ctx.Writer.Flush()
+	}
+}
+
+func writeSSE(w io.Writer, e events.Event) {
+	data, err := json.Marshal(&sseEvent{Topic: e.Topic, Data: wsPayload(e.Data), CreatedAt: e.CreatedAt})
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, events.Type(e.Topic), data)
+}
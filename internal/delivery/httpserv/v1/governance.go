@@ -1,10 +1,14 @@
 package v1
 
 import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
 	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
 	"github.com/everstake/solana-pools/internal/services/smodels"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"time"
 )
 
 // GetGovernance godoc
@@ -15,27 +19,35 @@ import (
 // @Accept json
 // @Produce json
 // @Param name query string false "governance name"
-// @Param sort query string false "sort param" Enums(price, name) default(price)
+// @Param sort query string false "sort param" Enums(price, name, market_cap, fdv, circulating_supply, inflation, holders) default(price)
 // @Param desc query bool false "desc" default(true)
 // @Param offset query number true "offset for aggregation" default(0)
 // @Param limit query number true "limit for aggregation" default(10)
+// @Param currency query string false "Currency of the converted price and value fields" default(usd)
 // @Success 200 {object} tools.ResponseArrayData{data=[]governance} "Ok"
 // @Failure 400,404 {object} tools.ResponseError "bad request"
 // @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure 503 {object} tools.ResponseError "currency rates are not synced yet"
 // @Failure default {object} tools.ResponseError "default response"
 // @Router /governance [get]
 func (h *Handler) GetGovernance(ctx *gin.Context) (interface{}, error) {
 	q := struct {
-		Name   string `form:"name"`
-		Sort   string `form:"sort,default=price"`
-		Desc   bool   `form:"desc,default=true"`
-		Offset uint64 `form:"offset,default=0"`
-		Limit  uint64 `form:"limit,default=10"`
+		Name     string `form:"name"`
+		Sort     string `form:"sort,default=price"`
+		Desc     bool   `form:"desc,default=true"`
+		Offset   uint64 `form:"offset,default=0"`
+		Limit    uint64 `form:"limit,default=10"`
+		Currency string `form:"currency,default=usd"`
 	}{}
 	if err := ctx.ShouldBind(&q); err != nil {
 		return nil, tools.NewStatus(http.StatusBadRequest, err)
 	}
 
+	rate, err := h.currencyRate(q.Currency, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	gc, amount, err := h.svc.GetGovernance(q.Name, q.Sort, q.Desc, q.Limit, q.Offset)
 	if err != nil {
 		return nil, err
@@ -43,7 +55,7 @@ func (h *Handler) GetGovernance(ctx *gin.Context) (interface{}, error) {
 
 	g := make([]*governance, len(gc))
 	for i, s := range gc {
-		g[i] = (&governance{}).Set(s)
+		g[i] = (&governance{}).Set(s).convert(q.Currency, rate)
 	}
 
 	return tools.ResponseArrayData{
@@ -57,16 +69,29 @@ func (h *Handler) GetGovernance(ctx *gin.Context) (interface{}, error) {
 }
 
 type governance struct {
-	Name               string  `json:"name"`
-	Symbol             string  `json:"symbol"`
-	VoteURL            string  `json:"vote_url"`
-	WebSiteURL         string  `json:"web_site_url"`
-	Image              string  `json:"image"`
-	Blockchain         string  `json:"blockchain"`
-	ContractAddress    string  `json:"contract_address"`
-	MaximumTokenSupply float64 `json:"maximum_token_supply"`
-	CirculatingSupply  float64 `json:"circulating_supply"`
-	USD                float64 `json:"usd"`
+	Name               string     `json:"name"`
+	Symbol             string     `json:"symbol"`
+	VoteURL            string     `json:"vote_url"`
+	WebSiteURL         string     `json:"web_site_url"`
+	Image              string     `json:"image"`
+	Blockchain         string     `json:"blockchain"`
+	ContractAddress    string     `json:"contract_address"`
+	MaximumTokenSupply float64    `json:"maximum_token_supply"`
+	TotalSupply        float64    `json:"total_supply"`
+	CirculatingSupply  float64    `json:"circulating_supply"`
+	OnChainSupply      float64    `json:"on_chain_supply"`
+	SupplyMismatch     bool       `json:"supply_mismatch"`
+	InflationRate      float64    `json:"inflation_rate"`
+	Holders            uint64     `json:"holders"`
+	USD                float64    `json:"usd"`
+	Price              float64    `json:"price"`
+	MarketCap          float64    `json:"market_cap"`
+	FDV                float64    `json:"fdv"`
+	FDVBasis           string     `json:"fdv_basis" enums:"max_supply,total_supply"`
+	Currency           string     `json:"currency"`
+	PriceUpdatedAt     *time.Time `json:"price_updated_at,omitempty"`
+	PriceSources       string     `json:"price_sources,omitempty"`
+	PriceStale         bool       `json:"price_stale"`
 }
 
 func (g *governance) Set(governance *smodels.Governance) *governance {
@@ -78,7 +103,97 @@ func (g *governance) Set(governance *smodels.Governance) *governance {
 	g.Blockchain = governance.Blockchain
 	g.ContractAddress = governance.ContractAddress
 	g.MaximumTokenSupply = governance.MaximumTokenSupply
+	g.TotalSupply = governance.TotalSupply
 	g.CirculatingSupply = governance.CirculatingSupply
+	g.OnChainSupply = governance.OnChainSupply
+	g.SupplyMismatch = governance.SupplyMismatch
+	g.InflationRate = governance.InflationRate
+	g.Holders = governance.Holders
 	g.USD = governance.USD
+	g.MarketCap = governance.MarketCap
+	g.FDV = governance.FDV
+	g.FDVBasis = governance.FDVBasis
+	g.PriceUpdatedAt = governance.PriceUpdatedAt
+	g.PriceSources = governance.PriceSources
+	g.PriceStale = governance.PriceStale
 	return g
 }
+
+// GetGovernanceHistory godoc
+// @Summary RestAPI
+// @Schemes
+// @Description The supply, holders, price, market cap and FDV history of a governance token for the specified aggregation.
+// @Tags governance
+// @Accept json
+// @Produce json
+// @Param name path string true "Name of the governance token with strict observance of the case."
+// @Param aggregation query string true "Type of data aggregation for a time period" Enums(week, month, quarter, half-year, year)
+// @Param currency query string false "Currency of the converted price and value fields" default(usd)
+// @Success 200 {object} tools.ResponseData{data=[]governanceSupply} "Ok"
+// @Failure 400,404 {object} tools.ResponseError "bad request"
+// @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure 503 {object} tools.ResponseError "currency rates are not synced yet"
+// @Failure default {object} tools.ResponseError "default response"
+// @Router /governance/{name}/history [get]
+func (h *Handler) GetGovernanceHistory(ctx *gin.Context) (interface{}, error) {
+	name := ctx.Param("name")
+
+	request := struct {
+		Aggregation string `form:"aggregation" binding:"required"`
+		Currency    string `form:"currency,default=usd"`
+	}{}
+	if err := ctx.ShouldBind(&request); err != nil {
+		return nil, tools.NewStatus(http.StatusNotAcceptable, fmt.Errorf("bad request %w", err))
+	}
+
+	arr, err := h.svc.GetGovernanceHistory(name, request.Aggregation)
+	if err != nil {
+		if errors.Is(err, postgres.ErrorRecordNotFounded) {
+			return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("%s governance not found", name))
+		}
+		return nil, err
+	}
+
+	data := make([]*governanceSupply, len(arr))
+	if len(arr) == 0 {
+		return tools.ResponseData{Data: data}, nil
+	}
+	rates, err := h.currencyRates(request.Currency, arr[0].CreatedAt, arr[len(arr)-1].CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range arr {
+		data[i] = (&governanceSupply{}).Set(v).convert(rates.Currency, rates.At(v.CreatedAt))
+	}
+
+	return tools.ResponseData{Data: data}, nil
+}
+
+type governanceSupply struct {
+	MaximumTokenSupply float64   `json:"maximum_token_supply"`
+	TotalSupply        float64   `json:"total_supply"`
+	CirculatingSupply  float64   `json:"circulating_supply"`
+	OnChainSupply      float64   `json:"on_chain_supply"`
+	Holders            uint64    `json:"holders"`
+	USD                float64   `json:"usd"`
+	Price              float64   `json:"price"`
+	MarketCap          float64   `json:"market_cap"`
+	FDV                float64   `json:"fdv"`
+	FDVBasis           string    `json:"fdv_basis" enums:"max_supply,total_supply"`
+	Currency           string    `json:"currency"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+func (gs *governanceSupply) Set(supply *smodels.GovernanceSupply) *governanceSupply {
+	gs.MaximumTokenSupply = supply.MaximumTokenSupply
+	gs.TotalSupply = supply.TotalSupply
+	gs.CirculatingSupply = supply.CirculatingSupply
+	gs.OnChainSupply = supply.OnChainSupply
+	gs.Holders = supply.Holders
+	gs.USD = supply.USD
+	gs.MarketCap = supply.MarketCap
+	gs.FDV = supply.FDV
+	gs.FDVBasis = supply.FDVBasis
+	gs.CreatedAt = supply.CreatedAt
+	return gs
+}
@@ -19,16 +19,23 @@ import (
 // @BasePath /v1
 // @query.collection.format multi
 
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description Optional; requests without a key are rate limited per client IP.
+
 // @x-extension-openapi {"example": "value on a json format"}
 
 type Handler struct {
 	svc services.Service
 	log *zap.Logger
+	hub *wsHub
 }
 
 func New(svc services.Service, log *zap.Logger) *Handler {
 	return &Handler{
 		svc: svc,
 		log: log,
+		hub: newWSHub(),
 	}
 }
@@ -15,9 +15,9 @@ import (
 )
 
 // GetPool godoc
-// @Summary WebSocket
+// @Summary RestAPI
 // @Schemes
-// @Description Creates a WS request to get server data for the pool with the pool name specified in the request.
+// @Description The pool with the pool name specified in the request; subscribe to pool:{name} on /ws for updates.
 // @Tags pool
 // @Param name path string true "Name of the pool with strict observance of the case." default(Eversol)
 // @Param epoch query number false "Epoch aggregation." Enums(1, 10) default(10)
@@ -28,9 +28,7 @@ import (
 // @Failure 500 {object} tools.ResponseError "internal server error"
 // @Failure default {object} tools.ResponseError "default response"
 // @Router /pool/{name} [get]
-func (h *Handler) GetPool(ctx *gin.Context, message []byte) (interface{}, error) {
-	name := ctx.Param("name")
-
+func (h *Handler) GetPool(ctx *gin.Context) (interface{}, error) {
 	q := struct {
 		Epoch uint64 `form:"epoch,default=10"`
 	}{}
@@ -38,7 +36,16 @@ func (h *Handler) GetPool(ctx *gin.Context, message []byte) (interface{}, error)
 		return nil, tools.NewStatus(http.StatusBadRequest, err)
 	}
 
-	resp, err := h.svc.GetPool(name, q.Epoch)
+	p, err := h.pool(ctx.Param("name"), q.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return tools.ResponseData{Data: p}, nil
+}
+
+func (h *Handler) pool(name string, epoch uint64) (*pool, error) {
+	resp, err := h.svc.GetPool(name, epoch)
 	if err != nil {
 		h.log.Error("API GetPoolData", zap.Error(err))
 		if errors.Is(err, postgres.ErrorRecordNotFounded) {
@@ -103,26 +110,43 @@ func (h *Handler) GetPools(ctx *gin.Context) (interface{}, error) {
 }
 
 // GetTotalPoolsStatistic godoc
-// @Summary WebSocket
+// @Summary RestAPI
 // @Schemes
-// @Description Creates a WS request to get current statistics.
+// @Description The current statistics of all pools; subscribe to network-stats on /ws for updates.
 // @Tags pool
 // @Param epoch query number false "Epoch aggregation." Enums(1, 10) default(10)
+// @Param currency query string false "Currency of the converted price and value fields" default(usd)
 // @Accept json
 // @Produce json
 // @Success 200 {object} tools.ResponseData{data=TotalPoolsStatistic} "Ok"
 // @Failure 400,404 {object} tools.ResponseError "bad request"
 // @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure 503 {object} tools.ResponseError "currency rates are not synced yet"
 // @Failure default {object} tools.ResponseError "default response"
 // @Router /pools-statistic [get]
-func (h *Handler) GetTotalPoolsStatistic(ctx *gin.Context, message []byte) (interface{}, error) {
+func (h *Handler) GetTotalPoolsStatistic(ctx *gin.Context) (interface{}, error) {
 	q := struct {
-		Epoch uint64 `form:"epoch,default=10"`
+		Epoch    uint64 `form:"epoch,default=10"`
+		Currency string `form:"currency,default=usd"`
 	}{}
 	if err := ctx.ShouldBind(&q); err != nil {
 		return nil, tools.NewStatus(http.StatusBadRequest, err)
 	}
 
+	st, err := h.totalPoolsStatistic(q.Epoch, q.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return tools.ResponseData{Data: st}, nil
+}
+
+func (h *Handler) totalPoolsStatistic(epoch uint64, currency string) (*TotalPoolsStatistic, error) {
+	rate, err := h.currencyRate(currency, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	apy, err := h.svc.GetAPY()
 	if err != nil {
 		if errors.Is(err, cache.KeyWasNotFound) {
@@ -139,7 +163,7 @@ func (h *Handler) GetTotalPoolsStatistic(ctx *gin.Context, message []byte) (inte
 		return nil, err
 	}
 
-	sc, err := h.svc.GetPoolsCurrentStatistic(q.Epoch)
+	sc, err := h.svc.GetPoolsCurrentStatistic(epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +173,7 @@ func (h *Handler) GetTotalPoolsStatistic(ctx *gin.Context, message []byte) (inte
 	tu, _ := sc.UnstakeLiquidity.Float64()
 	ss, _ := sc.AVGSkippedSlots.Float64()
 	paa, _ := sc.MAXPoolsApy.Float64()
+	pra, _ := sc.MAXRealizedAPY.Float64()
 
 	APY, _ := apy.Float64()
 
@@ -159,7 +184,7 @@ func (h *Handler) GetTotalPoolsStatistic(ctx *gin.Context, message []byte) (inte
 
 	USD, _ := usd.Float64()
 
-	return tools.ResponseData{Data: &TotalPoolsStatistic{
+	return &TotalPoolsStatistic{
 		TotalActiveStake:      float64(h.svc.GetActiveStake()) * math.Pow(10, -9),
 		TotalActiveStakePool:  ta,
 		TotalSupply:           ts,
@@ -168,32 +193,38 @@ func (h *Handler) GetTotalPoolsStatistic(ctx *gin.Context, message []byte) (inte
 		NetworkAPY:            APY,
 		Pools:                 sc.Pools,
 		PoolsMaxAPY:           paa,
+		PoolsMaxRealizedAPY:   pra,
 		MinPerformanceScore:   sc.MINScore,
 		AvgPerformanceScore:   sc.AVGScore,
 		MaxPerformanceScore:   sc.MAXScore,
 		SkippedSlot:           ss,
 		USD:                   USD,
-	}}, nil
+		SOLPrice:              USD * rate,
+		Currency:              currency,
+	}, nil
 }
 
 // GetPoolsStatistic godoc
 // @Summary RestAPI
 // @Schemes
-// @Description The pool statistic for the specified aggregation.
+// @Description The pool statistic for the specified aggregation, with USD values at the time of every point.
 // @Tags pool
 // @Accept json
 // @Produce json
 // @Param name query string true "Name of the pool with strict observance of the case." default(Eversol)
 // @Param aggregation query string true "Type of data aggregation for a time period" Enums(week, month, quarter, half-year, year)
+// @Param currency query string false "Currency of the converted price and value fields" default(usd)
 // @Success 200 {object} tools.ResponseData{data=[]poolStatistic} "Ok"
 // @Failure 400,404 {object} tools.ResponseError "bad request"
 // @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure 503 {object} tools.ResponseError "currency rates are not synced yet"
 // @Failure default {object} tools.ResponseError "default response"
 // @Router /pool-statistic [get]
 func (h *Handler) GetPoolsStatistic(ctx *gin.Context) (interface{}, error) {
 	request := struct {
 		Name        string `form:"name" binding:"required"`
 		Aggregation string `form:"aggregation" binding:"required"`
+		Currency    string `form:"currency,default=usd"`
 	}{}
 
 	if err := ctx.ShouldBind(&request); err != nil {
@@ -209,8 +240,55 @@ func (h *Handler) GetPoolsStatistic(ctx *gin.Context) (interface{}, error) {
 	}
 
 	data := make([]*poolStatistic, len(arr))
+	if len(arr) == 0 {
+		return tools.ResponseData{Data: data}, nil
+	}
+	rates, err := h.currencyRates(request.Currency, arr[0].CreatedAt, arr[len(arr)-1].CreatedAt)
+	if err != nil {
+		return nil, err
+	}
 	for i, v := range arr {
-		data[i] = (&poolStatistic{}).Set(v)
+		data[i] = (&poolStatistic{}).Set(v).convert(rates.Currency, rates.At(v.CreatedAt))
+	}
+
+	return tools.ResponseData{Data: data}, err
+}
+
+// GetPoolPeg godoc
+// @Summary RestAPI
+// @Schemes
+// @Description The pool token price on DEX markets against its on-chain value for the specified aggregation.
+// @Tags pool
+// @Accept json
+// @Produce json
+// @Param name query string true "Name of the pool with strict observance of the case." default(Eversol)
+// @Param aggregation query string true "Type of data aggregation for a time period" Enums(week, month, quarter, half-year, year)
+// @Success 200 {object} tools.ResponseData{data=[]peg} "Ok"
+// @Failure 400,404 {object} tools.ResponseError "bad request"
+// @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure default {object} tools.ResponseError "default response"
+// @Router /pool-peg [get]
+func (h *Handler) GetPoolPeg(ctx *gin.Context) (interface{}, error) {
+	request := struct {
+		Name        string `form:"name" binding:"required"`
+		Aggregation string `form:"aggregation" binding:"required"`
+	}{}
+
+	if err := ctx.ShouldBind(&request); err != nil {
+		return nil, tools.NewStatus(http.StatusNotAcceptable, fmt.Errorf("bad request %w", err))
+	}
+
+	arr, err := h.svc.GetPoolPegHistory(request.Name, request.Aggregation)
+	if err != nil {
+		if errors.Is(err, postgres.ErrorRecordNotFounded) {
+			return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("%s pool not found", request.Name))
+		}
+		return nil, err
+	}
+
+	data := make([]*peg, len(arr))
+	for i, v := range arr {
+		data[i] = (&peg{}).Set(v)
 	}
 
 	return tools.ResponseData{Data: data}, err
@@ -229,14 +307,25 @@ type (
 		Validators uint64 `json:"validators"`
 	}
 	poolStatistic struct {
-		TotalSol           float64   `json:"total_sol"`
-		TokensSupply       float64   `json:"tokens_supply"`
-		ActiveStake        float64   `json:"active_stake"`
-		APY                float64   `json:"apy"`
-		Delinquent         uint64    `json:"delinquent"`
-		UnstackedLiquidity float64   `json:"unstacked_liquidity"`
-		NumberOfValidators int64     `json:"number_of_validators"`
-		CreatedAt          time.Time `json:"created_at"`
+		TotalSol           float64 `json:"total_sol"`
+		TokensSupply       float64 `json:"tokens_supply"`
+		ActiveStake        float64 `json:"active_stake"`
+		APY                float64 `json:"apy"`
+		Delinquent         uint64  `json:"delinquent"`
+		UnstackedLiquidity float64 `json:"unstacked_liquidity"`
+		NumberOfValidators int64   `json:"number_of_validators"`
+		// USD values use the prices saved last before CreatedAt and are zero when there is none.
+		SOLUSD          float64 `json:"sol_usd"`
+		TokenUSD        float64 `json:"token_usd"`
+		TotalSolUSD     float64 `json:"total_sol_usd"`
+		TokensSupplyUSD float64 `json:"tokens_supply_usd"`
+		// The same values in Currency, at the rate of CreatedAt.
+		SOLPrice          float64   `json:"sol_price"`
+		TokenPrice        float64   `json:"token_price"`
+		TotalSolValue     float64   `json:"total_sol_value"`
+		TokensSupplyValue float64   `json:"tokens_supply_value"`
+		Currency          string    `json:"currency"`
+		CreatedAt         time.Time `json:"created_at"`
 	}
 	TotalPoolsStatistic struct {
 		TotalActiveStakePool  float64 `json:"total_active_stake_pool"`
@@ -247,30 +336,60 @@ type (
 		NetworkAPY            float64 `json:"network_apy"`
 		Pools                 uint64  `json:"pools"`
 		PoolsMaxAPY           float64 `json:"pools_max_apy"`
+		PoolsMaxRealizedAPY   float64 `json:"pools_max_realized_apy"`
 		MinPerformanceScore   int64   `json:"min_performance_score"`
 		AvgPerformanceScore   int64   `json:"avg_performance_score"`
 		MaxPerformanceScore   int64   `json:"max_performance_score"`
 		SkippedSlot           float64 `json:"skipped_slot"`
 		USD                   float64 `json:"usd"`
+		SOLPrice              float64 `json:"sol_price"`
+		Currency              string  `json:"currency"`
 	}
 	pool struct {
-		Address          string  `json:"address"`
-		Name             string  `json:"name"`
-		Image            string  `json:"image"`
-		Currency         string  `json:"currency"`
-		ActiveStake      float64 `json:"active_stake"`
-		TokensSupply     float64 `json:"tokens_supply"`
-		TotalSol         float64 `json:"total_sol"`
-		APY              float64 `json:"apy"`
-		Validators       int64   `json:"validators"`
-		AVGSkippedSlots  float64 `json:"avg_skipped_slots"`
-		AVGScore         int64   `json:"avg_score"`
-		StakingAccounts  uint64  `json:"staking_accounts"`
-		Delinquent       uint64  `json:"delinquent"`
-		UnstakeLiquidity float64 `json:"unstake_liquidity"`
-		DepositFee       float64 `json:"deposit_fee"`
-		WithdrawalFee    float64 `json:"withdrawal_fee"`
-		RewardsFee       float64 `json:"rewards_fee"`
+		Address          string        `json:"address"`
+		Name             string        `json:"name"`
+		Image            string        `json:"image"`
+		Currency         string        `json:"currency"`
+		ActiveStake      float64       `json:"active_stake"`
+		TokensSupply     float64       `json:"tokens_supply"`
+		TotalSol         float64       `json:"total_sol"`
+		APY              float64       `json:"apy"`
+		Validators       int64         `json:"validators"`
+		AVGSkippedSlots  float64       `json:"avg_skipped_slots"`
+		AVGScore         int64         `json:"avg_score"`
+		StakingAccounts  uint64        `json:"staking_accounts"`
+		Delinquent       uint64        `json:"delinquent"`
+		UnstakeLiquidity float64       `json:"unstake_liquidity"`
+		DepositFee       float64       `json:"deposit_fee"`
+		WithdrawalFee    float64       `json:"withdrawal_fee"`
+		RewardsFee       float64       `json:"rewards_fee"`
+		StakeWeighted    stakeWeighted `json:"stake_weighted"`
+		Yield            yield         `json:"yield"`
+		Peg              *peg          `json:"peg,omitempty"`
+	}
+	peg struct {
+		Epoch     uint64    `json:"epoch"`
+		FairValue float64   `json:"fair_value"`
+		DEXPrice  float64   `json:"dex_price"`
+		Deviation float64   `json:"deviation"`
+		Liquidity float64   `json:"liquidity"`
+		Depegged  bool      `json:"depegged"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+	stakeWeighted struct {
+		APY          float64 `json:"apy"`
+		Score        float64 `json:"score"`
+		SkippedSlots float64 `json:"skipped_slots"`
+	}
+	yield struct {
+		ValidatorAPY float64        `json:"validator_apy"`
+		Realized     []*realizedAPY `json:"realized"`
+	}
+	realizedAPY struct {
+		Epochs  uint64  `json:"epochs,omitempty"`
+		Days    uint64  `json:"days,omitempty"`
+		APY     float64 `json:"apy"`
+		FeeDrag float64 `json:"fee_drag"`
 	}
 )
 
@@ -291,6 +410,10 @@ func (ps *poolStatistic) Set(data *smodels.Pool) *poolStatistic {
 	ps.ActiveStake, _ = data.ActiveStake.Float64()
 	ps.NumberOfValidators = data.ValidatorCount
 	ps.Delinquent = data.Delinquent
+	ps.SOLUSD = data.SOLUSD.InexactFloat64()
+	ps.TokenUSD = data.TokenUSD.InexactFloat64()
+	ps.TotalSolUSD = ps.TotalSol * ps.SOLUSD
+	ps.TokensSupplyUSD = ps.TokensSupply * ps.TokenUSD
 	ps.CreatedAt = data.CreatedAt
 	return ps
 }
@@ -319,6 +442,43 @@ func (pl *pool) Set(pool *smodels.Pool) *pool {
 	pl.WithdrawalFee, _ = pool.WithdrawalFee.Float64()
 	pl.RewardsFee, _ = pool.RewardsFee.Float64()
 	pl.Validators = pool.ValidatorCount
+	pl.StakeWeighted.Set(&pool.StakeWeighted)
+	pl.Yield.Set(&pool.Yield)
+	if pool.Peg != nil {
+		pl.Peg = (&peg{}).Set(pool.Peg)
+	}
 
 	return pl
 }
+
+func (sw *stakeWeighted) Set(weighted *smodels.StakeWeighted) *stakeWeighted {
+	sw.APY, _ = weighted.APY.Float64()
+	sw.Score, _ = weighted.Score.Float64()
+	sw.SkippedSlots, _ = weighted.SkippedSlots.Float64()
+	return sw
+}
+
+func (y *yield) Set(data *smodels.Yield) *yield {
+	y.ValidatorAPY, _ = data.ValidatorAPY.Float64()
+	y.Realized = make([]*realizedAPY, len(data.Realized))
+	for i, r := range data.Realized {
+		y.Realized[i] = &realizedAPY{
+			Epochs: r.Epochs,
+			Days:   r.Days,
+		}
+		y.Realized[i].APY, _ = r.APY.Float64()
+		y.Realized[i].FeeDrag, _ = r.FeeDrag.Float64()
+	}
+	return y
+}
+
+func (p *peg) Set(data *smodels.Peg) *peg {
+	p.Epoch = data.Epoch
+	p.FairValue, _ = data.FairValue.Float64()
+	p.DEXPrice, _ = data.DEXPrice.Float64()
+	p.Deviation, _ = data.Deviation.Float64()
+	p.Liquidity = data.Liquidity
+	p.Depegged = data.Depegged
+	p.CreatedAt = data.CreatedAt
+	return p
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type price struct {
+	USD       float64   `json:"usd"`
+	Price     float64   `json:"price"`
+	Currency  string    `json:"currency"`
+	Sources   string    `json:"sources"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (p *price) Set(data *smodels.Price) *price {
+	p.USD = data.USD
+	p.Sources = data.Sources
+	p.CreatedAt = data.CreatedAt
+	return p
+}
+
+// GetCoinHistory godoc
+// @Summary RestAPI
+// @Schemes
+// @Description The USD price history of a coin or governance token for the specified aggregation.
+// @Tags coin
+// @Accept json
+// @Produce json
+// @Param name path string true "Name of the coin or governance token with strict observance of the case." default(mSOL)
+// @Param aggregation query string true "Type of data aggregation for a time period" Enums(week, month, quarter, half-year, year)
+// @Param currency query string false "Currency of the price field" default(usd)
+// @Success 200 {object} tools.ResponseData{data=[]price} "Ok"
+// @Failure 400,404 {object} tools.ResponseError "bad request"
+// @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure 503 {object} tools.ResponseError "currency rates are not synced yet"
+// @Failure default {object} tools.ResponseError "default response"
+// @Router /coins/{name}/history [get]
+func (h *Handler) GetCoinHistory(ctx *gin.Context) (interface{}, error) {
+	name := ctx.Param("name")
+
+	request := struct {
+		Aggregation string `form:"aggregation" binding:"required"`
+		Currency    string `form:"currency,default=usd"`
+	}{}
+	if err := ctx.ShouldBind(&request); err != nil {
+		return nil, tools.NewStatus(http.StatusNotAcceptable, fmt.Errorf("bad request %w", err))
+	}
+
+	arr, err := h.svc.GetPriceHistory(name, request.Aggregation)
+	if err != nil {
+		if errors.Is(err, postgres.ErrorRecordNotFounded) {
+			return nil, tools.NewStatus(http.StatusNotFound, fmt.Errorf("%s coin not found", name))
+		}
+		return nil, err
+	}
+
+	data := make([]*price, len(arr))
+	if len(arr) == 0 {
+		return tools.ResponseData{Data: data}, nil
+	}
+	rates, err := h.currencyRates(request.Currency, arr[0].CreatedAt, arr[len(arr)-1].CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range arr {
+		data[i] = (&price{}).Set(v).convert(rates.Currency, rates.At(v.CreatedAt))
+	}
+
+	return tools.ResponseData{Data: data}, nil
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+// Search godoc
+// @Summary RestAPI
+// @Schemes
+// @Description Finds pools, validators, coins, governance tokens and liquidity pools by a part of the name, or by
+// @Description an address: a pool address, a validator vote key or node identity, a coin mint or gecko key, a
+// @Description governance token symbol or contract. Results are ranked by match quality, exact matches score 1.
+// @Tags search
+// @Param q query string true "The name or address to search for, at least 2 characters."
+// @Param types query []string false "Types of the results." Enums(pool, validator, coin, governance, liquidity_pool)
+// @Param limit query number false "limit for the results" minimum(1) maximum(100) default(20)
+// @Accept json
+// @Produce json
+// @Success 200 {object} tools.ResponseArrayData{data=[]searchResult} "Ok"
+// @Failure 400 {object} tools.ResponseError "bad request"
+// @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure default {object} tools.ResponseError "default response"
+// @Router /search [get]
+func (h *Handler) Search(ctx *gin.Context) (interface{}, error) {
+	q := struct {
+		Query string   `form:"q"`
+		Types []string `form:"types"`
+		Limit uint64   `form:"limit,default=20"`
+	}{}
+	if err := ctx.ShouldBind(&q); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, err)
+	}
+	if len([]rune(strings.TrimSpace(q.Query))) < 2 {
+		return nil, tools.NewStatus(http.StatusBadRequest, errors.New("q must have at least 2 characters"))
+	}
+	if q.Limit < 1 || q.Limit > 100 {
+		return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("limit %d is out of 1-100", q.Limit))
+	}
+
+	resp, err := h.svc.Search(q.Query, q.Types, q.Limit)
+	if err != nil {
+		if errors.Is(err, services.ErrUnknownSearchType) {
+			return nil, tools.NewStatus(http.StatusBadRequest, err)
+		}
+		return nil, tools.NewStatus(http.StatusInternalServerError, err)
+	}
+
+	arr := make([]*searchResult, len(resp))
+	for i, r := range resp {
+		arr[i] = (&searchResult{}).Set(r)
+	}
+	return tools.ResponseArrayData{
+		Data: arr,
+		MetaData: &tools.MetaData{
+			Limit:       q.Limit,
+			TotalAmount: uint64(len(arr)),
+		},
+	}, nil
+}
+
+type searchResult struct {
+	Type    string  `json:"type"`
+	Key     string  `json:"key"`
+	Name    string  `json:"name"`
+	Address string  `json:"address"`
+	Image   string  `json:"image"`
+	Score   float64 `json:"score"`
+}
+
+func (r *searchResult) Set(result *smodels.SearchResult) *searchResult {
+	r.Type = result.Type
+	r.Key = result.Key
+	r.Name = result.Name
+	r.Address = result.Address
+	r.Image = result.Image
+	r.Score = result.Score
+	return r
+}
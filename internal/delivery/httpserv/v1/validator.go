@@ -5,24 +5,40 @@ import (
 	"fmt"
 	"github.com/everstake/solana-pools/internal/dao/postgres"
 	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/services"
 	"github.com/everstake/solana-pools/internal/services/smodels"
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 	"net/http"
+	"time"
 )
 
 // GetPoolValidators godoc
 // @Summary RestAPI
 // @Schemes
-// @Description This list with pool's validators.
+// @Description This list with pool's validators. The filters combine. With paging=cursor, pages are read from a snapshot of the first page, follow the next and prev cursors of the meta data to browse it.
 // @Tags validatorData
 // @Param pname path string true "Name of the pool with strict observance of the case." default(Eversol)
 // @Param vname query string false "The name of the validatorData without strict observance of the case."
 // @Param epoch query number false "Epoch aggregation." Enums(1, 10) default(10)
 // @Param sort query string false "sort param" Enums(apy, pool stake, stake, fee, score, skipped slot, data center) default(apy)
 // @Param desc query bool false "desc" default(true)
-// @Param offset query number true "offset for aggregation" default(0)
-// @Param limit query number true "limit for aggregation" default(10)
+// @Param min_fee query number false "Minimum fee, a fraction."
+// @Param max_fee query number false "Maximum fee, a fraction."
+// @Param min_apy query number false "Minimum APY."
+// @Param max_apy query number false "Maximum APY."
+// @Param min_score query number false "Minimum score."
+// @Param max_skipped_slots query number false "Maximum skipped slots."
+// @Param delinquent query bool false "Only delinquent validators when true, none when false."
+// @Param data_centers query []string false "Data centers."
+// @Param min_stake query number false "Minimum active stake in SOL."
+// @Param max_stake query number false "Maximum active stake in SOL."
+// @Param cursor query string false "The next or prev cursor of the previous page."
+// @Param snapshot_epoch query number false "List the data up to the epoch, the latest by default."
+// @Param paging query string false "offset lists the current data, cursor pages through a snapshot; a cursor implies cursor paging" Enums(offset, cursor) default(offset)
+// @Param offset query number false "offset for aggregation, ignored with cursor paging" default(0)
+// @Param limit query number true "limit for aggregation, 0 lists all" default(10)
 // @Accept json
 // @Produce json
 // @Success 200 {object} tools.ResponseArrayData{data=[]validatorData} "Ok"
@@ -33,18 +49,45 @@ import (
 func (h *Handler) GetPoolValidators(ctx *gin.Context) (interface{}, error) {
 	name := ctx.Param("pname")
 	q := struct {
-		Name   string `form:"vname"`
-		Epoch  uint64 `form:"epoch,default=10"`
-		Sort   string `form:"sort,default=apy"`
-		Desc   bool   `form:"desc,default=true"`
-		Offset uint64 `form:"offset,default=0"`
-		Limit  uint64 `form:"limit,default=10"`
+		Name          string `form:"vname"`
+		Epoch         uint64 `form:"epoch,default=10"`
+		Sort          string `form:"sort,default=apy"`
+		Desc          bool   `form:"desc,default=true"`
+		Cursor        string `form:"cursor"`
+		SnapshotEpoch uint64 `form:"snapshot_epoch"`
+		Paging        string `form:"paging,default=offset" binding:"oneof=offset cursor"`
+		Offset        uint64 `form:"offset,default=0"`
+		Limit         uint64 `form:"limit,default=10"`
+		validatorFilter
 	}{}
 	if err := ctx.ShouldBind(&q); err != nil {
 		return nil, tools.NewStatus(http.StatusBadRequest, err)
 	}
+	if q.Cursor != "" || q.Paging == "cursor" {
+		resp, page, err := h.svc.GetPoolValidatorsPage(name, q.Name, q.Sort, q.Desc, q.Epoch, q.filter(), smodels.PageRequest{
+			Cursor:        q.Cursor,
+			SnapshotEpoch: q.SnapshotEpoch,
+			Limit:         q.Limit,
+		})
+		if err != nil {
+			if errors.Is(err, services.ErrInvalidCursor) {
+				return nil, tools.NewStatus(http.StatusBadRequest, err)
+			}
+			h.log.Error("API GetPoolValidatorsPage", zap.Error(err))
+			if errors.Is(err, postgres.ErrorRecordNotFounded) {
+				return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("%s pool not found", name))
+			}
+			return nil, tools.NewStatus(http.StatusInternalServerError, err)
+		}
+
+		arr := make([]*validatorData, len(resp))
+		for i, v := range resp {
+			arr[i] = (&validatorData{}).Set(v)
+		}
+		return tools.ResponseArrayData{Data: arr, MetaData: pageMetaData(q.Limit, page)}, nil
+	}
 
-	resp, amount, err := h.svc.GetPoolValidators(name, q.Name, q.Sort, q.Desc, q.Epoch, q.Limit, q.Offset)
+	resp, amount, err := h.svc.GetPoolValidators(name, q.Name, q.Sort, q.Desc, q.Epoch, q.filter(), q.Limit, q.Offset)
 	if err != nil {
 		h.log.Error("API GetPoolData", zap.Error(err))
 		if errors.Is(err, postgres.ErrorRecordNotFounded) {
@@ -71,15 +114,30 @@ func (h *Handler) GetPoolValidators(ctx *gin.Context) (interface{}, error) {
 // GetAllValidators godoc
 // @Summary RestAPI
 // @Schemes
-// @Description This list with all Solana's validators.
+// @Description This list with all Solana's validators. The filters combine. With paging=cursor, pages are read from a snapshot of the first page, follow the next and prev cursors of the meta data to browse it.
 // @Tags validatorData
 // @Param name query string false "The name of the validatorData without strict observance of the case."
 // @Param epoch query number false "Epoch aggregation." Enums(1, 10) default(10)
 // @Param epochs query []number false "Epochs for filter."
 // @Param sort query string false "sort param" Enums(apy, stake, fee, score, skipped slot, data center, staking accounts) default(apy)
 // @Param desc query bool false "desc" default(true)
-// @Param offset query number true "offset for aggregation" default(0)
-// @Param limit query number true "limit for aggregation" default(10)
+// @Param min_fee query number false "Minimum fee, a fraction."
+// @Param max_fee query number false "Maximum fee, a fraction."
+// @Param min_apy query number false "Minimum APY."
+// @Param max_apy query number false "Maximum APY."
+// @Param min_score query number false "Minimum score."
+// @Param max_skipped_slots query number false "Maximum skipped slots."
+// @Param delinquent query bool false "Only delinquent validators when true, none when false."
+// @Param data_centers query []string false "Data centers."
+// @Param min_stake query number false "Minimum active stake in SOL."
+// @Param max_stake query number false "Maximum active stake in SOL."
+// @Param delegated_by query []string false "Only validators some of the pools delegate to."
+// @Param undelegated query bool false "Only validators no pool delegates to."
+// @Param cursor query string false "The next or prev cursor of the previous page."
+// @Param snapshot_epoch query number false "List the data up to the epoch, the latest by default."
+// @Param paging query string false "offset lists the current data, cursor pages through a snapshot; a cursor implies cursor paging" Enums(offset, cursor) default(offset)
+// @Param offset query number false "offset for aggregation, ignored with cursor paging" default(0)
+// @Param limit query number true "limit for aggregation, 0 lists all" default(10)
 // @Accept json
 // @Produce json
 // @Success 200 {object} tools.ResponseArrayData{data=[]validator} "Ok"
@@ -89,19 +147,46 @@ func (h *Handler) GetPoolValidators(ctx *gin.Context) (interface{}, error) {
 // @Router /validators [get]
 func (h *Handler) GetAllValidators(ctx *gin.Context) (interface{}, error) {
 	q := struct {
-		Name   string   `form:"name"`
-		Epoch  uint64   `form:"epoch,default=10"`
-		Epochs []uint64 `form:"epochs"`
-		Sort   string   `form:"sort,default=apy"`
-		Desc   bool     `form:"desc,default=true"`
-		Offset uint64   `form:"offset,default=0"`
-		Limit  uint64   `form:"limit,default=10"`
+		Name          string   `form:"name"`
+		Epoch         uint64   `form:"epoch,default=10"`
+		Epochs        []uint64 `form:"epochs"`
+		Sort          string   `form:"sort,default=apy"`
+		Desc          bool     `form:"desc,default=true"`
+		Cursor        string   `form:"cursor"`
+		SnapshotEpoch uint64   `form:"snapshot_epoch"`
+		Paging        string   `form:"paging,default=offset" binding:"oneof=offset cursor"`
+		Offset        uint64   `form:"offset,default=0"`
+		Limit         uint64   `form:"limit,default=10"`
+		validatorFilter
+		DelegatedBy []string `form:"delegated_by"`
+		Undelegated bool     `form:"undelegated"`
 	}{}
 	if err := ctx.ShouldBind(&q); err != nil {
 		return nil, tools.NewStatus(http.StatusBadRequest, err)
 	}
+	filter := q.filter()
+	filter.DelegatedBy, filter.Undelegated = q.DelegatedBy, q.Undelegated
+	if q.Cursor != "" || q.Paging == "cursor" {
+		resp, page, err := h.svc.GetValidatorsPage(q.Name, q.Sort, q.Desc, q.Epoch, q.Epochs, filter, smodels.PageRequest{
+			Cursor:        q.Cursor,
+			SnapshotEpoch: q.SnapshotEpoch,
+			Limit:         q.Limit,
+		})
+		if err != nil {
+			if errors.Is(err, services.ErrInvalidCursor) {
+				return nil, tools.NewStatus(http.StatusBadRequest, err)
+			}
+			return nil, tools.NewStatus(http.StatusInternalServerError, err)
+		}
+
+		arr := make([]*validator, len(resp))
+		for i, v := range resp {
+			arr[i] = (&validator{}).Set(v)
+		}
+		return tools.ResponseArrayData{Data: arr, MetaData: pageMetaData(q.Limit, page)}, nil
+	}
 
-	resp, amount, err := h.svc.GetAllValidators(q.Name, q.Sort, q.Desc, q.Epoch, q.Epochs, q.Limit, q.Offset)
+	resp, amount, err := h.svc.GetAllValidators(q.Name, q.Sort, q.Desc, q.Epoch, q.Epochs, filter, q.Limit, q.Offset)
 	if err != nil {
 		return nil, tools.NewStatus(http.StatusInternalServerError, err)
 	}
@@ -121,6 +206,91 @@ func (h *Handler) GetAllValidators(ctx *gin.Context) (interface{}, error) {
 	}, nil
 }
 
+// GetValidator godoc
+// @Summary RestAPI
+// @Schemes
+// @Description The validator with its epoch history and the pools delegating to it.
+// @Tags validatorData
+// @Param vote path string true "Vote account of the validator."
+// @Param epoch query number false "Epoch aggregation." Enums(1, 10) default(10)
+// @Param limit query number false "limit for the epoch history" default(10)
+// @Accept json
+// @Produce json
+// @Success 200 {object} tools.ResponseData{data=validatorDetails} "Ok"
+// @Failure 400,404 {object} tools.ResponseError "bad request"
+// @Failure 500 {object} tools.ResponseError "internal server error"
+// @Failure default {object} tools.ResponseError "default response"
+// @Router /validator/{vote} [get]
+func (h *Handler) GetValidator(ctx *gin.Context) (interface{}, error) {
+	vote := ctx.Param("vote")
+	q := struct {
+		Epoch uint64 `form:"epoch,default=10"`
+		Limit uint64 `form:"limit,default=10"`
+	}{}
+	if err := ctx.ShouldBind(&q); err != nil {
+		return nil, tools.NewStatus(http.StatusBadRequest, err)
+	}
+
+	resp, err := h.svc.GetValidator(vote, q.Epoch, q.Limit)
+	if err != nil {
+		h.log.Error("API GetValidator", zap.Error(err))
+		if errors.Is(err, postgres.ErrorRecordNotFounded) {
+			return nil, tools.NewStatus(http.StatusNotFound, fmt.Errorf("%s validator not found", vote))
+		}
+		return nil, tools.NewStatus(http.StatusInternalServerError, err)
+	}
+
+	return tools.ResponseData{Data: (&validatorDetails{}).Set(resp)}, nil
+}
+
+// validatorFilter are the query parameters filtering validators by their data.
+type validatorFilter struct {
+	MinFee          *decimal.Decimal `form:"min_fee"`
+	MaxFee          *decimal.Decimal `form:"max_fee"`
+	MinAPY          *decimal.Decimal `form:"min_apy"`
+	MaxAPY          *decimal.Decimal `form:"max_apy"`
+	MinScore        *int64           `form:"min_score"`
+	MaxSkippedSlots *decimal.Decimal `form:"max_skipped_slots"`
+	Delinquent      *bool            `form:"delinquent"`
+	DataCenters     []string         `form:"data_centers"`
+	MinStake        *decimal.Decimal `form:"min_stake"`
+	MaxStake        *decimal.Decimal `form:"max_stake"`
+}
+
+func (f validatorFilter) filter() smodels.ValidatorFilter {
+	return smodels.ValidatorFilter{
+		MinFee:          f.MinFee,
+		MaxFee:          f.MaxFee,
+		MinAPY:          f.MinAPY,
+		MaxAPY:          f.MaxAPY,
+		MinScore:        f.MinScore,
+		MaxSkippedSlots: f.MaxSkippedSlots,
+		Delinquent:      f.Delinquent,
+		DataCenters:     f.DataCenters,
+		MinStake:        lamports(f.MinStake),
+		MaxStake:        lamports(f.MaxStake),
+	}
+}
+
+// lamports converts a stake in SOL.
+func lamports(stake *decimal.Decimal) *uint64 {
+	if stake == nil {
+		return nil
+	}
+	l := uint64(decimal.Max(stake.Shift(9), decimal.Zero).IntPart())
+	return &l
+}
+
+// pageMetaData describes a cursor page.
+func pageMetaData(limit uint64, page *smodels.Page) *tools.MetaData {
+	return &tools.MetaData{
+		Limit:       limit,
+		TotalAmount: page.Total,
+		Next:        page.Next,
+		Prev:        page.Prev,
+	}
+}
+
 type validator struct {
 	Name             string  `json:"name"`
 	Delinquent       bool    `json:"delinquent"`
@@ -185,3 +355,62 @@ func (v *validatorData) Set(validator *smodels.PoolValidatorData) *validatorData
 
 	return v
 }
+
+type validatorDetails struct {
+	validator
+	History []*validatorEpoch `json:"history"`
+	Pools   []*validatorPool  `json:"pools"`
+}
+
+func (vd *validatorDetails) Set(details *smodels.ValidatorDetails) *validatorDetails {
+	vd.validator.Set(&details.Validator)
+	vd.History = make([]*validatorEpoch, len(details.History))
+	for i, v := range details.History {
+		vd.History[i] = (&validatorEpoch{}).Set(v)
+	}
+	vd.Pools = make([]*validatorPool, len(details.Pools))
+	for i, v := range details.Pools {
+		vd.Pools[i] = (&validatorPool{}).Set(v)
+	}
+	return vd
+}
+
+type validatorEpoch struct {
+	Epoch           uint64    `json:"epoch"`
+	APY             float64   `json:"apy"`
+	StakingAccounts uint64    `json:"staking_accounts"`
+	ActiveStake     float64   `json:"active_stake"`
+	Fee             float64   `json:"fee"`
+	Score           int64     `json:"score"`
+	SkippedSlots    float64   `json:"skipped_slots"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (ve *validatorEpoch) Set(data *smodels.ValidatorEpoch) *validatorEpoch {
+	ve.Epoch = data.Epoch
+	ve.APY, _ = data.APY.Float64()
+	ve.StakingAccounts = data.StakingAccounts
+	ve.ActiveStake, _ = data.ActiveStake.Float64()
+	ve.Fee, _ = data.Fee.Float64()
+	ve.Score = data.Score
+	ve.SkippedSlots, _ = data.SkippedSlots.Float64()
+	ve.CreatedAt = data.CreatedAt
+	return ve
+}
+
+type validatorPool struct {
+	Name        string  `json:"name"`
+	Address     string  `json:"address"`
+	Image       string  `json:"image"`
+	ActiveStake float64 `json:"active_stake"`
+	PoolShare   float64 `json:"pool_share"`
+}
+
+func (vp *validatorPool) Set(pool *smodels.ValidatorPool) *validatorPool {
+	vp.Name = pool.Name
+	vp.Address = pool.Address
+	vp.Image = pool.Image
+	vp.ActiveStake, _ = pool.ActiveStake.Float64()
+	vp.PoolShare, _ = pool.PoolShare.Float64()
+	return vp
+}
@@ -0,0 +1,401 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// wsSendBuffer bounds the messages queued for a connection; connections that fall behind are closed.
+	wsSendBuffer = 64
+	// wsMaxTopics bounds the subscriptions of a connection.
+	wsMaxTopics = 50
+	wsReadLimit = 4096
+	wsPingEvery = 30 * time.Second
+	wsPongWait  = 60 * time.Second
+	wsWriteWait = 10 * time.Second
+	// wsEventBuffer has room for one event per validator of an update.
+	wsEventBuffer = 4096
+	wsEpoch       = 10
+	wsCurrency    = "usd"
+
+	wsActionSubscribe   = "subscribe"
+	wsActionUnsubscribe = "unsubscribe"
+	wsActionPing        = "ping"
+
+	wsTypeData         = "data"
+	wsTypeSubscribed   = "subscribed"
+	wsTypeUnsubscribed = "unsubscribed"
+	wsTypeError        = "error"
+	wsTypePong         = "pong"
+)
+
+type (
+	wsRequest struct {
+		Action string `json:"action" enums:"subscribe,unsubscribe,ping"`
+		Topic  string `json:"topic" example:"pool:Eversol"`
+	}
+	wsMessage struct {
+		Type  string      `json:"type" enums:"data,subscribed,unsubscribed,error,pong"`
+		Topic string      `json:"topic,omitempty"`
+		Data  interface{} `json:"data,omitempty"`
+		Error string      `json:"error,omitempty"`
+	}
+	delinquencyAlert struct {
+		VotePK     string    `json:"vote_pk"`
+		Name       string    `json:"name"`
+		Delinquent bool      `json:"delinquent"`
+		CreatedAt  time.Time `json:"created_at"`
+	}
+
+	wsConn struct {
+		send   chan []byte
+		topics map[string]bool
+		done   chan struct{}
+		once   sync.Once
+	}
+	// wsHub tracks the topics of every connection and pushes a topic only when its payload differs from the last push.
+	wsHub struct {
+		mu    sync.Mutex
+		conns map[*wsConn]struct{}
+		last  map[string][]byte
+	}
+)
+
+func newWSHub() *wsHub {
+	return &wsHub{
+		conns: make(map[*wsConn]struct{}),
+		last:  make(map[string][]byte),
+	}
+}
+
+func newWSConn() *wsConn {
+	return &wsConn{
+		send:   make(chan []byte, wsSendBuffer),
+		topics: make(map[string]bool),
+		done:   make(chan struct{}),
+	}
+}
+
+func (a *delinquencyAlert) Set(alert *smodels.DelinquencyAlert) *delinquencyAlert {
+	a.VotePK = alert.VotePK
+	a.Name = alert.Name
+	a.Delinquent = alert.Delinquent
+	a.CreatedAt = alert.CreatedAt
+	return a
+}
+
+// WS godoc
+// @Summary WebSocket
+// @Schemes
+// @Description Multiplexed WS connection. Send {"action":"subscribe","topic":"pool:Eversol"} to receive the current value
+// @Description of a topic and then every change of it; topics are pool:{name}, validator:{vote}, network-stats, epoch and delinquency.
+// @Description {"action":"unsubscribe"} stops a topic and {"action":"ping"} is answered with a pong. The server pings every 30 seconds
+// @Description and closes connections that do not answer or do not read their messages fast enough.
+// @Tags ws
+// @Success 101 {object} wsMessage "Switching protocols"
+// @Router /ws [get]
+func (h *Handler) WS(ctx *gin.Context) {
+	upGrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	ws, err := upGrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	defer ws.Close()
+
+	c := newWSConn()
+	h.hub.add(c)
+	defer h.hub.remove(c)
+
+	go h.readWS(ws, c)
+
+	ping := time.NewTicker(wsPingEvery)
+	defer ping.Stop()
+	for {
+		select {
+		case <-c.done:
+			ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(wsWriteWait))
+			return
+		case msg := <-c.send:
+			ws.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := ws.WriteMessage(websocket.TextMessage, msg); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (h *Handler) readWS(ws *websocket.Conn, c *wsConn) {
+	defer c.close()
+	ws.SetReadLimit(wsReadLimit)
+	ws.SetReadDeadline(time.Now().Add(wsPongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		_, p, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		ws.SetReadDeadline(time.Now().Add(wsPongWait))
+		h.handleWS(c, p)
+	}
+}
+
+// handleWS answers a request of the client.
+func (h *Handler) handleWS(c *wsConn, p []byte) {
+	var req wsRequest
+	if err := json.Unmarshal(p, &req); err != nil {
+		c.push(&wsMessage{Type: wsTypeError, Error: "invalid message"})
+		return
+	}
+
+	switch req.Action {
+	case wsActionPing:
+		c.push(&wsMessage{Type: wsTypePong})
+	case wsActionSubscribe:
+		if err := h.hub.subscribe(c, req.Topic); err != nil {
+			c.push(&wsMessage{Type: wsTypeError, Topic: req.Topic, Error: err.Error()})
+			return
+		}
+		c.push(&wsMessage{Type: wsTypeSubscribed, Topic: req.Topic})
+		if req.Topic != events.TopicDelinquency {
+			h.refresh(req.Topic, c)
+		}
+	case wsActionUnsubscribe:
+		h.hub.unsubscribe(c, req.Topic)
+		c.push(&wsMessage{Type: wsTypeUnsubscribed, Topic: req.Topic})
+	default:
+		c.push(&wsMessage{Type: wsTypeError, Topic: req.Topic, Error: fmt.Sprintf("unknown action %q", req.Action)})
+	}
+}
+
+// ServeEvents pushes the events published by the update jobs to the subscribed WS connections until the service
+// stops publishing.
+func (h *Handler) ServeEvents() {
+	ch, unsubscribe := h.svc.Subscribe(wsEventBuffer)
+	defer unsubscribe()
+	for e := range ch {
+		if e.Data != nil {
+			h.hub.broadcast(e.Topic, wsPayload(e.Data))
+			continue
+		}
+		if h.hub.subscribed(e.Topic) {
+			h.refresh(e.Topic, nil)
+		}
+	}
+}
+
+// refresh resolves the topic and pushes it to c, or to every subscriber when c is nil and the payload changed.
+func (h *Handler) refresh(topic string, c *wsConn) {
+	data, err := h.resolve(topic)
+	if err != nil {
+		if c != nil {
+			c.push(&wsMessage{Type: wsTypeError, Topic: topic, Error: wsError(err)})
+		}
+		h.log.Error("API WS", zap.String("topic", topic), zap.Error(err))
+		return
+	}
+	msg, err := json.Marshal(&wsMessage{Type: wsTypeData, Topic: topic, Data: data})
+	if err != nil {
+		h.log.Error("API WS", zap.String("topic", topic), zap.Error(err))
+		return
+	}
+	if c != nil {
+		h.hub.snapshot(c, topic, msg)
+		return
+	}
+	h.hub.publish(topic, msg)
+}
+
+func (h *Handler) resolve(topic string) (interface{}, error) {
+	if name, ok := events.PoolName(topic); ok {
+		return h.pool(name, wsEpoch)
+	}
+	if vote, ok := events.ValidatorVote(topic); ok {
+		resp, err := h.svc.GetValidator(vote, wsEpoch, wsEpoch)
+		if err != nil {
+			if errors.Is(err, postgres.ErrorRecordNotFounded) {
+				return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("%s validator not found", vote))
+			}
+			return nil, err
+		}
+		return (&validatorDetails{}).Set(resp), nil
+	}
+	switch topic {
+	case events.TopicNetworkStats:
+		return h.totalPoolsStatistic(wsEpoch, wsCurrency)
+	case events.TopicEpoch:
+		e, err := h.svc.GetEpoch()
+		if err != nil {
+			return nil, err
+		}
+		return (&epoch{}).Set(e), nil
+	}
+	return nil, tools.NewStatus(http.StatusBadRequest, fmt.Errorf("unknown topic %s", topic))
+}
+
+func wsPayload(data interface{}) interface{} {
+	if alert, ok := data.(*smodels.DelinquencyAlert); ok {
+		return (&delinquencyAlert{}).Set(alert)
+	}
+	return data
+}
+
+// wsError hides internal errors the same way tools.Must does.
+func wsError(err error) string {
+	var s *tools.Status
+	if errors.As(err, &s) && s.Code() < http.StatusInternalServerError {
+		return s.Error()
+	}
+	return "internal server error"
+}
+
+func (hub *wsHub) add(c *wsConn) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	hub.conns[c] = struct{}{}
+}
+
+func (hub *wsHub) remove(c *wsConn) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	delete(hub.conns, c)
+	for topic := range c.topics {
+		hub.forget(topic)
+	}
+}
+
+func (hub *wsHub) subscribe(c *wsConn, topic string) error {
+	if !events.Valid(topic) || topic == events.TopicCoins || events.Type(topic) == events.TypeLiquidityPool {
+		return fmt.Errorf("unknown topic %s", topic)
+	}
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if !c.topics[topic] && len(c.topics) >= wsMaxTopics {
+		return fmt.Errorf("too many topics, the limit is %d", wsMaxTopics)
+	}
+	c.topics[topic] = true
+	return nil
+}
+
+func (hub *wsHub) unsubscribe(c *wsConn, topic string) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	delete(c.topics, topic)
+	hub.forget(topic)
+}
+
+// forget drops the last payload of a topic nobody is subscribed to anymore; callers hold mu.
+func (hub *wsHub) forget(topic string) {
+	for c := range hub.conns {
+		if c.topics[topic] {
+			return
+		}
+	}
+	delete(hub.last, topic)
+}
+
+func (hub *wsHub) subscribed(topic string) bool {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	for c := range hub.conns {
+		if c.topics[topic] {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot sends the current payload of a topic to a new subscriber; a payload newer than the one the other
+// subscribers were sent goes to all of them.
+func (hub *wsHub) snapshot(c *wsConn, topic string, msg []byte) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if last, ok := hub.last[topic]; ok && !bytes.Equal(last, msg) {
+		hub.send(topic, msg)
+		return
+	}
+	hub.last[topic] = msg
+	c.pushRaw(msg)
+}
+
+// publish sends msg to the subscribers of topic unless it is the payload they were last sent.
+func (hub *wsHub) publish(topic string, msg []byte) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if bytes.Equal(hub.last[topic], msg) {
+		return
+	}
+	hub.send(topic, msg)
+}
+
+// send records msg as the last payload of topic and queues it for its subscribers; callers hold mu.
+func (hub *wsHub) send(topic string, msg []byte) {
+	hub.last[topic] = msg
+	for c := range hub.conns {
+		if c.topics[topic] {
+			c.pushRaw(msg)
+		}
+	}
+}
+
+func (hub *wsHub) broadcast(topic string, data interface{}) {
+	msg, err := json.Marshal(&wsMessage{Type: wsTypeData, Topic: topic, Data: data})
+	if err != nil {
+		return
+	}
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	for c := range hub.conns {
+		if c.topics[topic] {
+			c.pushRaw(msg)
+		}
+	}
+}
+
+func (c *wsConn) push(msg *wsMessage) {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	c.pushRaw(b)
+}
+
+// pushRaw queues msg or closes the connection when its send buffer is full.
+func (c *wsConn) pushRaw(msg []byte) {
+	select {
+	case <-c.done:
+	case c.send <- msg:
+	default:
+		c.close()
+	}
+}
+
+func (c *wsConn) close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
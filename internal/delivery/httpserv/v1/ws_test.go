@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"go.uber.org/zap"
+	"gotest.tools/assert"
+	"testing"
+)
+
+// received drains the messages queued for the connection.
+func received(c *wsConn) []string {
+	var msgs []string
+	for {
+		select {
+		case msg := <-c.send:
+			msgs = append(msgs, string(msg))
+		default:
+			return msgs
+		}
+	}
+}
+
+func closed(c *wsConn) bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func dataMessage(topic string, data string) string {
+	return fmt.Sprintf(`{"type":"data","topic":"%s","data":%s}`, topic, data)
+}
+
+func TestWSRequests(t *testing.T) {
+	epoch := uint64(300)
+	h := New(&services.ServiceMock{
+		GetEpochFunc: func() (*smodels.EpochInfo, error) {
+			return &smodels.EpochInfo{Epoch: epoch}, nil
+		},
+	}, zap.NewNop())
+	c := newWSConn()
+	h.hub.add(c)
+
+	tests := []struct {
+		name    string
+		request string
+		msgs    []string
+	}{
+		{
+			name:    "ping",
+			request: `{"action":"ping"}`,
+			msgs:    []string{`{"type":"pong"}`},
+		},
+		{
+			name:    "subscribe",
+			request: `{"action":"subscribe","topic":"epoch"}`,
+			msgs: []string{
+				`{"type":"subscribed","topic":"epoch"}`,
+				dataMessage(events.TopicEpoch, `{"epoch":300,"slots_in_epoch":0,"sps":0,"end_epoch":"0001-01-01T00:00:00Z","progress":0}`),
+			},
+		},
+		{
+			name:    "subscribe without snapshot",
+			request: `{"action":"subscribe","topic":"delinquency"}`,
+			msgs:    []string{`{"type":"subscribed","topic":"delinquency"}`},
+		},
+		{
+			name:    "unknown topic",
+			request: `{"action":"subscribe","topic":"coins"}`,
+			msgs:    []string{`{"type":"error","topic":"coins","error":"unknown topic coins"}`},
+		},
+		{
+			name:    "unsubscribe",
+			request: `{"action":"unsubscribe","topic":"epoch"}`,
+			msgs:    []string{`{"type":"unsubscribed","topic":"epoch"}`},
+		},
+		{
+			name:    "unknown action",
+			request: `{"action":"list"}`,
+			msgs:    []string{`{"type":"error","error":"unknown action \"list\""}`},
+		},
+		{
+			name:    "invalid message",
+			request: `subscribe`,
+			msgs:    []string{`{"type":"error","error":"invalid message"}`},
+		},
+	}
+	// the cases run in order on one connection
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h.handleWS(c, []byte(tt.request))
+			assert.DeepEqual(t, received(c), tt.msgs)
+		})
+	}
+
+	assert.Assert(t, !h.hub.subscribed(events.TopicEpoch))
+	assert.Assert(t, h.hub.subscribed(events.TopicDelinquency))
+	h.hub.publish(events.TopicEpoch, []byte(dataMessage(events.TopicEpoch, "301")))
+	assert.Equal(t, len(received(c)), 0)
+}
+
+func TestWSHubTopicLimit(t *testing.T) {
+	hub := newWSHub()
+	c := newWSConn()
+	hub.add(c)
+
+	for i := 0; i < wsMaxTopics; i++ {
+		assert.NilError(t, hub.subscribe(c, events.ValidatorTopic(fmt.Sprintf("vote%d", i))))
+	}
+	assert.Error(t, hub.subscribe(c, events.ValidatorTopic("one-more")), "too many topics, the limit is 50")
+	// subscribing again to a topic of the connection does not count
+	assert.NilError(t, hub.subscribe(c, events.ValidatorTopic("vote0")))
+
+	hub.unsubscribe(c, events.ValidatorTopic("vote0"))
+	assert.NilError(t, hub.subscribe(c, events.ValidatorTopic("one-more")))
+}
+
+func TestWSHubSnapshotAndPublish(t *testing.T) {
+	topic := events.PoolTopic("Eversol")
+	first, second, third := []byte(dataMessage(topic, "1")), []byte(dataMessage(topic, "2")), []byte(dataMessage(topic, "3"))
+
+	hub := newWSHub()
+	a, b, other := newWSConn(), newWSConn(), newWSConn()
+	for _, c := range []*wsConn{a, b, other} {
+		hub.add(c)
+	}
+	assert.NilError(t, hub.subscribe(a, topic))
+	assert.NilError(t, hub.subscribe(other, events.PoolTopic("Marinade")))
+
+	// the first subscriber gets the snapshot, publishing the same payload again is dropped
+	hub.snapshot(a, topic, first)
+	hub.publish(topic, first)
+	assert.DeepEqual(t, received(a), []string{string(first)})
+
+	hub.publish(topic, second)
+	assert.DeepEqual(t, received(a), []string{string(second)})
+
+	// a new subscriber with the payload the others were sent last only gets its snapshot
+	assert.NilError(t, hub.subscribe(b, topic))
+	hub.snapshot(b, topic, second)
+	assert.Equal(t, len(received(a)), 0)
+	assert.DeepEqual(t, received(b), []string{string(second)})
+
+	// a newer snapshot goes to every subscriber, so none of them keeps an older payload
+	hub.snapshot(b, topic, third)
+	hub.publish(topic, third)
+	assert.DeepEqual(t, received(a), []string{string(third)})
+	assert.DeepEqual(t, received(b), []string{string(third)})
+	assert.Equal(t, len(received(other)), 0)
+
+	// once nobody is subscribed the payload is forgotten and the next subscriber gets it again
+	hub.unsubscribe(a, topic)
+	hub.remove(b)
+	assert.Assert(t, !hub.subscribed(topic))
+	assert.NilError(t, hub.subscribe(a, topic))
+	hub.snapshot(a, topic, third)
+	assert.DeepEqual(t, received(a), []string{string(third)})
+}
+
+func TestWSHubBroadcast(t *testing.T) {
+	hub := newWSHub()
+	a, b := newWSConn(), newWSConn()
+	hub.add(a)
+	hub.add(b)
+	assert.NilError(t, hub.subscribe(a, events.TopicDelinquency))
+
+	alert := &smodels.DelinquencyAlert{VotePK: "vote", Name: "Everstake", Delinquent: true}
+	hub.broadcast(events.TopicDelinquency, wsPayload(alert))
+	// alerts are events of their own and are sent even when they repeat
+	hub.broadcast(events.TopicDelinquency, wsPayload(alert))
+
+	msgs := received(a)
+	assert.Equal(t, len(msgs), 2)
+	var msg struct {
+		Type  string
+		Topic string
+		Data  delinquencyAlert
+	}
+	assert.NilError(t, json.Unmarshal([]byte(msgs[0]), &msg))
+	assert.Equal(t, msg.Type, wsTypeData)
+	assert.Equal(t, msg.Data.VotePK, "vote")
+	assert.Assert(t, msg.Data.Delinquent)
+	assert.Equal(t, len(received(b)), 0)
+}
+
+func TestWSHubSlowClient(t *testing.T) {
+	topic := events.PoolTopic("Eversol")
+	hub := newWSHub()
+	slow, fast := newWSConn(), newWSConn()
+	hub.add(slow)
+	hub.add(fast)
+	assert.NilError(t, hub.subscribe(slow, topic))
+	assert.NilError(t, hub.subscribe(fast, topic))
+
+	for i := 0; i < wsSendBuffer; i++ {
+		hub.publish(topic, []byte(dataMessage(topic, fmt.Sprint(i))))
+		received(fast)
+	}
+	assert.Assert(t, !closed(slow))
+
+	hub.publish(topic, []byte(dataMessage(topic, "full")))
+	assert.Assert(t, closed(slow))
+	assert.Assert(t, !closed(fast))
+	// a closed connection is not written to anymore
+	hub.publish(topic, []byte(dataMessage(topic, "after")))
+	assert.DeepEqual(t, received(fast), []string{dataMessage(topic, "full"), dataMessage(topic, "after")})
+	assert.Equal(t, len(received(slow)), wsSendBuffer)
+}
@@ -0,0 +1,40 @@
+package v2
+
+import (
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	"time"
+)
+
+// GetEpoch godoc
+// @Summary Current epoch
+// @Description The current epoch and its progress.
+// @Tags epoch
+// @Produce json
+// @Success 200 {object} response{data=epoch} "Ok"
+// @Failure 500 {object} problem "internal server error"
+// @Router /epoch [get]
+func (h *Handler) GetEpoch(ctx *gin.Context) (*response, error) {
+	e, err := h.svc.GetEpoch()
+	if err != nil {
+		return nil, err
+	}
+	return &response{Data: (&epoch{}).Set(e)}, nil
+}
+
+type epoch struct {
+	Epoch        uint64    `json:"epoch"`
+	SlotsInEpoch uint64    `json:"slots_in_epoch"`
+	SPS          float64   `json:"sps"`
+	EndEpoch     time.Time `json:"end_epoch"`
+	Progress     uint8     `json:"progress"`
+}
+
+func (e *epoch) Set(data *smodels.EpochInfo) *epoch {
+	e.Epoch = data.Epoch
+	e.SlotsInEpoch = data.SlotsInEpoch
+	e.SPS = data.SPS
+	e.EndEpoch = data.EndEpoch
+	e.Progress = data.Progress
+	return e
+}
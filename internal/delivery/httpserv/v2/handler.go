@@ -0,0 +1,31 @@
+package v2
+
+import (
+	"github.com/everstake/solana-pools/internal/services"
+	"go.uber.org/zap"
+)
+
+//go:generate swag init -g ./handler.go -d ./ --instanceName v2 -o ../../../../docs/v2
+
+// @BasePath /v2
+// @query.collection.format multi
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description Optional; requests without a key are rate limited per client IP.
+
+// the last description is the one of the document, swag 1.7 doesn't keep it for the security definition
+// @description Pools, validators and the epoch with exact decimal amounts. Coins, pool coins, governance and liquidity pools with their USD fields are only served by /v1. Requests without an X-API-Key are rate limited per client IP.
+
+type Handler struct {
+	svc services.Service
+	log *zap.Logger
+}
+
+func New(svc services.Service, log *zap.Logger) *Handler {
+	return &Handler{
+		svc: svc,
+		log: log,
+	}
+}
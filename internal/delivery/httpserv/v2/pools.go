@@ -0,0 +1,252 @@
+package v2
+
+import (
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/models/sol"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"strings"
+	"time"
+)
+
+// GetPools godoc
+// @Summary Pools
+// @Description The pools, searched by name and sorted.
+// @Tags pool
+// @Produce json
+// @Param name query string false "Part of the pool name, case insensitive"
+// @Param epoch query integer false "Epoch aggregation of the APY" Enums(1, 10) default(10)
+// @Param sort query string false "Sort field" Enums(apy, pool_stake, validators, score, skipped_slot, token_price) default(apy)
+// @Param desc query bool false "Sort in descending order" default(true)
+// @Param offset query integer false "Offset" default(0)
+// @Param limit query integer false "Limit" minimum(1) maximum(100) default(10)
+// @Success 200 {object} response{data=[]pool,pagination=pagination} "Ok"
+// @Failure 400 {object} problem "invalid parameters"
+// @Failure 500 {object} problem "internal server error"
+// @Router /pools [get]
+func (h *Handler) GetPools(ctx *gin.Context) (*response, error) {
+	q := struct {
+		Name   string `form:"name"`
+		Epoch  uint64 `form:"epoch,default=10" binding:"oneof=1 10"`
+		Sort   string `form:"sort,default=apy" binding:"oneof=apy pool_stake validators score skipped_slot token_price"`
+		Desc   bool   `form:"desc,default=true"`
+		Offset uint64 `form:"offset,default=0"`
+		Limit  uint64 `form:"limit,default=10" binding:"min=1,max=100"`
+	}{}
+	if err := bindQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+
+	pools, total, err := h.svc.GetPools(q.Name, sortParam(q.Sort), q.Desc, q.Epoch, q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pool, len(pools))
+	for i, p := range pools {
+		data[i] = (&pool{}).Set(&p.Pool)
+	}
+	return &response{Data: data, Pagination: &pagination{Offset: q.Offset, Limit: q.Limit, Total: total}}, nil
+}
+
+// GetPool godoc
+// @Summary Pool
+// @Description The pool with the name.
+// @Tags pool
+// @Produce json
+// @Param name path string true "Name of the pool, case sensitive" default(Eversol)
+// @Param epoch query integer false "Epoch aggregation of the APY" Enums(1, 10) default(10)
+// @Success 200 {object} response{data=pool} "Ok"
+// @Failure 400,404 {object} problem "invalid parameters or unknown pool"
+// @Failure 500 {object} problem "internal server error"
+// @Router /pools/{name} [get]
+func (h *Handler) GetPool(ctx *gin.Context) (*response, error) {
+	q := struct {
+		Epoch uint64 `form:"epoch,default=10" binding:"oneof=1 10"`
+	}{}
+	if err := bindQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+
+	name := ctx.Param("name")
+	p, err := h.svc.GetPool(name, q.Epoch)
+	if err != nil {
+		return nil, poolNotFound(name, err)
+	}
+	return &response{Data: (&pool{}).Set(&p.Pool)}, nil
+}
+
+// GetPoolStatistic godoc
+// @Summary Pool statistic
+// @Description The history of the pool for the aggregation, with the USD prices at every point.
+// @Tags pool
+// @Produce json
+// @Param name path string true "Name of the pool, case sensitive" default(Eversol)
+// @Param aggregation query string true "Time period" Enums(week, month, quarter, half-year, year)
+// @Success 200 {object} response{data=[]poolStatistic} "Ok"
+// @Failure 400,404 {object} problem "invalid parameters or unknown pool"
+// @Failure 500 {object} problem "internal server error"
+// @Router /pools/{name}/statistic [get]
+func (h *Handler) GetPoolStatistic(ctx *gin.Context) (*response, error) {
+	q := struct {
+		Aggregation string `form:"aggregation" binding:"required,oneof=week month quarter half-year year"`
+	}{}
+	if err := bindQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+
+	name := ctx.Param("name")
+	pools, err := h.svc.GetPoolStatistic(name, q.Aggregation)
+	if err != nil {
+		return nil, poolNotFound(name, err)
+	}
+	data := make([]*poolStatistic, len(pools))
+	for i, p := range pools {
+		data[i] = (&poolStatistic{}).Set(p)
+	}
+	return &response{Data: data}, nil
+}
+
+// sortParam turns a sort enum value into the sort parameter of the service, e.g. pool_stake into "pool stake".
+func sortParam(value string) string {
+	return strings.ReplaceAll(value, "_", " ")
+}
+
+func poolNotFound(name string, err error) error {
+	if isNotFound(err) {
+		return notFound("pool %s not found", name)
+	}
+	return err
+}
+
+type (
+	// amount is a SOL amount, exact in lamports.
+	amount struct {
+		Lamports uint64          `json:"lamports,string" swaggertype:"string" example:"1500000000000"`
+		SOL      decimal.Decimal `json:"sol" swaggertype:"string" example:"1500"`
+	}
+	pool struct {
+		Address          string          `json:"address"`
+		Name             string          `json:"name"`
+		Image            string          `json:"image"`
+		Currency         string          `json:"currency"`
+		ActiveStake      amount          `json:"active_stake"`
+		TokensSupply     amount          `json:"tokens_supply"`
+		TotalSOL         amount          `json:"total_sol"`
+		UnstakeLiquidity amount          `json:"unstake_liquidity"`
+		APY              decimal.Decimal `json:"apy" swaggertype:"string" example:"0.0712"`
+		Validators       int64           `json:"validators"`
+		AVGSkippedSlots  decimal.Decimal `json:"avg_skipped_slots" swaggertype:"string"`
+		AVGScore         int64           `json:"avg_score"`
+		StakingAccounts  uint64          `json:"staking_accounts"`
+		Delinquent       uint64          `json:"delinquent"`
+		DepositFee       decimal.Decimal `json:"deposit_fee" swaggertype:"string"`
+		WithdrawalFee    decimal.Decimal `json:"withdrawal_fee" swaggertype:"string"`
+		RewardsFee       decimal.Decimal `json:"rewards_fee" swaggertype:"string"`
+		StakeWeighted    stakeWeighted   `json:"stake_weighted"`
+		Yield            yield           `json:"yield"`
+		Peg              *peg            `json:"peg,omitempty"`
+	}
+	stakeWeighted struct {
+		APY          decimal.Decimal `json:"apy" swaggertype:"string"`
+		Score        decimal.Decimal `json:"score" swaggertype:"string"`
+		SkippedSlots decimal.Decimal `json:"skipped_slots" swaggertype:"string"`
+	}
+	yield struct {
+		ValidatorAPY decimal.Decimal `json:"validator_apy" swaggertype:"string"`
+		Realized     []*realizedAPY  `json:"realized"`
+	}
+	realizedAPY struct {
+		Epochs  uint64          `json:"epochs,omitempty"`
+		Days    uint64          `json:"days,omitempty"`
+		APY     decimal.Decimal `json:"apy" swaggertype:"string"`
+		FeeDrag decimal.Decimal `json:"fee_drag" swaggertype:"string"`
+	}
+	peg struct {
+		Epoch     uint64          `json:"epoch"`
+		FairValue decimal.Decimal `json:"fair_value" swaggertype:"string"`
+		DEXPrice  decimal.Decimal `json:"dex_price" swaggertype:"string"`
+		Deviation decimal.Decimal `json:"deviation" swaggertype:"string"`
+		Liquidity float64         `json:"liquidity"`
+		Depegged  bool            `json:"depegged"`
+		CreatedAt time.Time       `json:"created_at"`
+	}
+	poolStatistic struct {
+		TotalSOL         amount          `json:"total_sol"`
+		TokensSupply     amount          `json:"tokens_supply"`
+		ActiveStake      amount          `json:"active_stake"`
+		UnstakeLiquidity amount          `json:"unstake_liquidity"`
+		APY              decimal.Decimal `json:"apy" swaggertype:"string"`
+		Delinquent       uint64          `json:"delinquent"`
+		Validators       int64           `json:"validators"`
+		// SOLUSD and TokenUSD are the prices saved last before CreatedAt, zero when there is none.
+		SOLUSD    decimal.Decimal `json:"sol_usd" swaggertype:"string"`
+		TokenUSD  decimal.Decimal `json:"token_usd" swaggertype:"string"`
+		CreatedAt time.Time       `json:"created_at"`
+	}
+)
+
+func (a *amount) Set(s sol.SOL) *amount {
+	a.Lamports = uint64(s.Shift(9).IntPart())
+	a.SOL = s.Decimal
+	return a
+}
+
+func (pl *pool) Set(p *smodels.Pool) *pool {
+	pl.Address = p.Address
+	pl.Name = p.Name
+	pl.Image = p.Image
+	pl.Currency = p.Currency
+	pl.ActiveStake.Set(p.ActiveStake)
+	pl.TokensSupply.Set(p.TokensSupply)
+	pl.TotalSOL.Set(p.TotalLamports)
+	pl.UnstakeLiquidity.Set(p.UnstakeLiquidity)
+	pl.APY = p.APY
+	pl.Validators = p.ValidatorCount
+	pl.AVGSkippedSlots = p.AVGSkippedSlots
+	pl.AVGScore = p.AVGScore
+	pl.StakingAccounts = p.StakingAccounts
+	pl.Delinquent = p.Delinquent
+	pl.DepositFee = p.DepossitFee
+	pl.WithdrawalFee = p.WithdrawalFee
+	pl.RewardsFee = p.RewardsFee
+	pl.StakeWeighted = stakeWeighted{APY: p.StakeWeighted.APY, Score: p.StakeWeighted.Score, SkippedSlots: p.StakeWeighted.SkippedSlots}
+	pl.Yield.Set(&p.Yield)
+	if p.Peg != nil {
+		pl.Peg = (&peg{}).Set(p.Peg)
+	}
+	return pl
+}
+
+func (y *yield) Set(data *smodels.Yield) *yield {
+	y.ValidatorAPY = data.ValidatorAPY
+	y.Realized = make([]*realizedAPY, len(data.Realized))
+	for i, r := range data.Realized {
+		y.Realized[i] = &realizedAPY{Epochs: r.Epochs, Days: r.Days, APY: r.APY, FeeDrag: r.FeeDrag}
+	}
+	return y
+}
+
+func (p *peg) Set(data *smodels.Peg) *peg {
+	p.Epoch = data.Epoch
+	p.FairValue = data.FairValue
+	p.DEXPrice = data.DEXPrice
+	p.Deviation = data.Deviation
+	p.Liquidity = data.Liquidity
+	p.Depegged = data.Depegged
+	p.CreatedAt = data.CreatedAt
+	return p
+}
+
+func (ps *poolStatistic) Set(p *smodels.Pool) *poolStatistic {
+	ps.TotalSOL.Set(p.TotalLamports)
+	ps.TokensSupply.Set(p.TokensSupply)
+	ps.ActiveStake.Set(p.ActiveStake)
+	ps.UnstakeLiquidity.Set(p.UnstakeLiquidity)
+	ps.APY = p.APY
+	ps.Delinquent = p.Delinquent
+	ps.Validators = p.ValidatorCount
+	ps.SOLUSD = p.SOLUSD
+	ps.TokenUSD = p.TokenUSD
+	ps.CreatedAt = p.CreatedAt
+	return ps
+}
@@ -0,0 +1,156 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/delivery/httpserv/tools"
+	"github.com/gin-gonic/gin"
+	validate "github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Problem codes clients can branch on.
+const (
+	CodeInvalidParameter = "invalid_parameter"
+	CodeNotFound         = "not_found"
+	CodeUnauthorized     = "unauthorized"
+	CodeRateLimited      = "rate_limited"
+	CodeInternal         = "internal"
+)
+
+var statusCodes = map[int]string{
+	http.StatusBadRequest:      CodeInvalidParameter,
+	http.StatusUnauthorized:    CodeUnauthorized,
+	http.StatusNotFound:        CodeNotFound,
+	http.StatusTooManyRequests: CodeRateLimited,
+}
+
+type (
+	HandlerFunc func(*gin.Context) (*response, error)
+
+	// response is the envelope of every successful response; Pagination is set for lists.
+	response struct {
+		Data       interface{} `json:"data"`
+		Pagination *pagination `json:"pagination,omitempty"`
+	}
+	pagination struct {
+		Offset uint64 `json:"offset"`
+		Limit  uint64 `json:"limit"`
+		Total  uint64 `json:"total"`
+	}
+
+	// problem is an RFC 7807 problem detail, extended with a stable code and the invalid parameters.
+	problem struct {
+		Type   string        `json:"type" example:"about:blank"`
+		Title  string        `json:"title" example:"Bad Request"`
+		Status int           `json:"status" example:"400"`
+		Detail string        `json:"detail,omitempty" example:"invalid query parameters"`
+		Code   string        `json:"code" example:"invalid_parameter"`
+		Errors []*fieldError `json:"errors,omitempty"`
+	}
+	fieldError struct {
+		Field  string `json:"field" example:"sort"`
+		Reason string `json:"reason" example:"must be one of apy, pool_stake, validators, score, skipped_slot, token_price"`
+	}
+)
+
+func newProblem(status int, code string, detail string, errs ...*fieldError) *problem {
+	return &problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+		Errors: errs,
+	}
+}
+
+func notFound(format string, a ...interface{}) *problem {
+	return newProblem(http.StatusNotFound, CodeNotFound, fmt.Sprintf(format, a...))
+}
+
+func (p *problem) Error() string {
+	return p.Detail
+}
+
+// Must writes the response of f in the envelope, or its error as a problem.
+func (h *Handler) Must(f HandlerFunc) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		resp, err := f(ctx)
+		if err != nil {
+			h.Abort(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, resp)
+	}
+}
+
+// Abort stops the request with the problem for err. Statuses of the tools package, written by the shared middlewares,
+// keep their status; other errors are internal unless they wrap a missing record.
+func (h *Handler) Abort(ctx *gin.Context, err error) {
+	var (
+		p      *problem
+		status *tools.Status
+	)
+	switch {
+	case errors.As(err, &p):
+	case isNotFound(err):
+		p = newProblem(http.StatusNotFound, CodeNotFound, "not found")
+	case errors.As(err, &status) && status.Code() < http.StatusInternalServerError:
+		p = newProblem(status.Code(), statusCodes[status.Code()], status.Error())
+	default:
+		h.log.Error("API v2 "+ctx.FullPath(), zap.Error(err))
+		p = newProblem(http.StatusInternalServerError, CodeInternal, "")
+	}
+	ctx.Header("Content-Type", "application/problem+json")
+	ctx.AbortWithStatusJSON(p.Status, p)
+}
+
+// bindQuery binds the query parameters to q, the tags of which name and validate them; enums are validated with oneof.
+func bindQuery(ctx *gin.Context, q interface{}) error {
+	err := ctx.ShouldBindQuery(q)
+	if err == nil {
+		return nil
+	}
+	var verrs validate.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return newProblem(http.StatusBadRequest, CodeInvalidParameter, err.Error())
+	}
+	t := reflect.TypeOf(q).Elem()
+	errs := make([]*fieldError, len(verrs))
+	for i, e := range verrs {
+		errs[i] = &fieldError{Field: formName(t, e.StructField()), Reason: reason(e)}
+	}
+	return newProblem(http.StatusBadRequest, CodeInvalidParameter, "invalid query parameters", errs...)
+}
+
+func formName(t reflect.Type, field string) string {
+	if f, ok := t.FieldByName(field); ok {
+		if name := strings.Split(f.Tag.Get("form"), ",")[0]; name != "" {
+			return name
+		}
+	}
+	return field
+}
+
+func reason(e validate.FieldError) string {
+	switch e.Tag() {
+	case "oneof":
+		return "must be one of " + strings.Join(strings.Fields(e.Param()), ", ")
+	case "required":
+		return "is required"
+	case "min":
+		return "must be at least " + e.Param()
+	case "max":
+		return "must be at most " + e.Param()
+	}
+	return "is invalid"
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, postgres.ErrorRecordNotFounded)
+}
@@ -0,0 +1,190 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	v2 "github.com/everstake/solana-pools/internal/delivery/httpserv/v2"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/models/sol"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"gotest.tools/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newService() *services.ServiceMock {
+	return &services.ServiceMock{
+		GetPoolFunc: func(name string, epoch uint64) (*smodels.PoolDetails, error) {
+			switch name {
+			case "Eversol":
+				p := smodels.Pool{Name: "Eversol", APY: decimal.RequireFromString("0.0712")}
+				p.ActiveStake.SetLamports(1500000000123456789)
+				return &smodels.PoolDetails{Pool: p}, nil
+			case "broken":
+				return nil, errors.New("connection refused")
+			}
+			return nil, fmt.Errorf("DAO.GetPool(%s): %w", name, postgres.ErrorRecordNotFounded)
+		},
+		GetPoolsFunc: func(name string, sort string, desc bool, epoch uint64, from uint64, to uint64) ([]*smodels.PoolDetails, uint64, error) {
+			return []*smodels.PoolDetails{{Pool: smodels.Pool{Name: "Eversol", ActiveStake: sol.SOL{Decimal: decimal.New(15, -1)}}}}, 42, nil
+		},
+		GetAllValidatorsFunc: func(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) ([]*smodels.Validator, uint64, error) {
+			return nil, 0, nil
+		},
+	}
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestHandlers(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		url         string
+		status      int
+		contentType string
+		body        string
+		sort        string
+	}{
+		{
+			name:        "pool",
+			url:         "/v2/pools/Eversol",
+			status:      http.StatusOK,
+			contentType: "application/json; charset=utf-8",
+			body:        `{"data":{"name":"Eversol","active_stake":{"lamports":"1500000000123456789","sol":"1500000000.123456789"},"apy":"0.0712"}}`,
+		},
+		{
+			name:        "pools",
+			url:         "/v2/pools?sort=pool_stake&limit=5",
+			status:      http.StatusOK,
+			contentType: "application/json; charset=utf-8",
+			body:        `{"data":[{"name":"Eversol","active_stake":{"lamports":"1500000000","sol":"1.5"}}],"pagination":{"offset":0,"limit":5,"total":42}}`,
+			sort:        "pool stake",
+		},
+		{
+			name:        "invalid enums",
+			url:         "/v2/pools?sort=stake&epoch=5&limit=500",
+			status:      http.StatusBadRequest,
+			contentType: "application/problem+json",
+			body: `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid query parameters","code":"invalid_parameter","errors":[` +
+				`{"field":"epoch","reason":"must be one of 1, 10"},` +
+				`{"field":"sort","reason":"must be one of apy, pool_stake, validators, score, skipped_slot, token_price"},` +
+				`{"field":"limit","reason":"must be at most 100"}]}`,
+		},
+		{
+			name:        "invalid number",
+			url:         "/v2/pools/Eversol?epoch=ten",
+			status:      http.StatusBadRequest,
+			contentType: "application/problem+json",
+		},
+		{
+			name:        "not found",
+			url:         "/v2/pools/unknown",
+			status:      http.StatusNotFound,
+			contentType: "application/problem+json",
+			body:        `{"type":"about:blank","title":"Not Found","status":404,"detail":"pool unknown not found","code":"not_found"}`,
+		},
+		{
+			name:        "internal",
+			url:         "/v2/pools/broken",
+			status:      http.StatusInternalServerError,
+			contentType: "application/problem+json",
+			body:        `{"type":"about:blank","title":"Internal Server Error","status":500,"code":"internal"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newService()
+			h := v2.New(svc, zap.NewNop())
+			router := gin.New()
+			router.GET("/v2/pools", h.Must(h.GetPools))
+			router.GET("/v2/pools/:name", h.Must(h.GetPool))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			assert.Equal(t, w.Code, tt.status)
+			assert.Equal(t, w.Header().Get("Content-Type"), tt.contentType)
+			var sorts []string
+			for _, c := range svc.GetPoolsCalls() {
+				sorts = append(sorts, c.Sort)
+			}
+			if tt.sort != "" {
+				assert.DeepEqual(t, sorts, []string{tt.sort})
+			} else {
+				assert.Equal(t, len(sorts), 0)
+			}
+			if tt.body != "" {
+				assertSubset(t, w.Body.Bytes(), tt.body)
+			}
+		})
+	}
+}
+
+func TestValidatorFilter(t *testing.T) {
+	svc := newService()
+	h := v2.New(svc, zap.NewNop())
+	router := gin.New()
+	router.GET("/v2/validators", h.Must(h.GetValidators))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v2/validators?max_fee=0.05&min_score=12&delinquent=false"+
+		"&data_centers=a&data_centers=b&min_stake=1000000000&delegated_by=Eversol", nil))
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, len(svc.GetAllValidatorsCalls()), 1)
+	filter := svc.GetAllValidatorsCalls()[0].Filter
+	assert.Equal(t, filter.MaxFee.String(), "0.05")
+	assert.Equal(t, *filter.MinScore, int64(12))
+	assert.Equal(t, *filter.Delinquent, false)
+	assert.DeepEqual(t, filter.DataCenters, []string{"a", "b"})
+	assert.Equal(t, *filter.MinStake, uint64(1000000000))
+	assert.DeepEqual(t, filter.DelegatedBy, []string{"Eversol"})
+	assert.Assert(t, filter.MinFee == nil && filter.MaxStake == nil && !filter.Undelegated)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v2/validators?min_apy=high", nil))
+	assert.Equal(t, w.Code, http.StatusBadRequest)
+}
+
+// assertSubset compares body to want on the fields present in want, so tests need not list every field.
+func assertSubset(t *testing.T, body []byte, want string) {
+	var b, w interface{}
+	assert.NilError(t, json.Unmarshal(body, &b))
+	assert.NilError(t, json.Unmarshal([]byte(want), &w))
+	assert.DeepEqual(t, pick(b, w), w)
+}
+
+func pick(b, w interface{}) interface{} {
+	switch w := w.(type) {
+	case map[string]interface{}:
+		bm, ok := b.(map[string]interface{})
+		if !ok {
+			return b
+		}
+		out := make(map[string]interface{}, len(w))
+		for k, v := range w {
+			if bv, ok := bm[k]; ok {
+				out[k] = pick(bv, v)
+			}
+		}
+		return out
+	case []interface{}:
+		ba, ok := b.([]interface{})
+		if !ok || len(ba) != len(w) {
+			return b
+		}
+		out := make([]interface{}, len(w))
+		for i := range w {
+			out[i] = pick(ba[i], w[i])
+		}
+		return out
+	}
+	return b
+}
@@ -0,0 +1,294 @@
+package v2
+
+import (
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// GetPoolValidators godoc
+// @Summary Pool validators
+// @Description The validators the pool delegates to, with the stake of the pool. The filters combine.
+// @Tags validator
+// @Produce json
+// @Param name path string true "Name of the pool, case sensitive" default(Eversol)
+// @Param validator query string false "Part of the validator name, case insensitive"
+// @Param epoch query integer false "Epoch aggregation of the APY" Enums(1, 10) default(10)
+// @Param sort query string false "Sort field" Enums(apy, pool_stake, stake, fee, score, skipped_slot, data_center) default(apy)
+// @Param desc query bool false "Sort in descending order" default(true)
+// @Param min_fee query string false "Minimum commission, a fraction"
+// @Param max_fee query string false "Maximum commission, a fraction"
+// @Param min_apy query string false "Minimum APY"
+// @Param max_apy query string false "Maximum APY"
+// @Param min_score query integer false "Minimum score"
+// @Param max_skipped_slots query string false "Maximum skipped slots"
+// @Param delinquent query bool false "Only delinquent validators when true, none when false"
+// @Param data_centers query []string false "Data centers" collectionFormat(multi)
+// @Param min_stake query string false "Minimum active stake in lamports"
+// @Param max_stake query string false "Maximum active stake in lamports"
+// @Param offset query integer false "Offset" default(0)
+// @Param limit query integer false "Limit" minimum(1) maximum(100) default(10)
+// @Success 200 {object} response{data=[]poolValidator,pagination=pagination} "Ok"
+// @Failure 400,404 {object} problem "invalid parameters or unknown pool"
+// @Failure 500 {object} problem "internal server error"
+// @Router /pools/{name}/validators [get]
+func (h *Handler) GetPoolValidators(ctx *gin.Context) (*response, error) {
+	q := struct {
+		Validator string `form:"validator"`
+		Epoch     uint64 `form:"epoch,default=10" binding:"oneof=1 10"`
+		Sort      string `form:"sort,default=apy" binding:"oneof=apy pool_stake stake fee score skipped_slot data_center"`
+		Desc      bool   `form:"desc,default=true"`
+		Offset    uint64 `form:"offset,default=0"`
+		Limit     uint64 `form:"limit,default=10" binding:"min=1,max=100"`
+		validatorFilter
+	}{}
+	if err := bindQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+
+	name := ctx.Param("name")
+	validators, total, err := h.svc.GetPoolValidators(name, q.Validator, sortParam(q.Sort), q.Desc, q.Epoch, q.filter(), q.Limit, q.Offset)
+	if err != nil {
+		return nil, poolNotFound(name, err)
+	}
+	data := make([]*poolValidator, len(validators))
+	for i, v := range validators {
+		data[i] = (&poolValidator{}).Set(v)
+	}
+	return &response{Data: data, Pagination: &pagination{Offset: q.Offset, Limit: q.Limit, Total: total}}, nil
+}
+
+// GetValidators godoc
+// @Summary Validators
+// @Description The validators, searched by name, filtered and sorted. The filters combine.
+// @Tags validator
+// @Produce json
+// @Param name query string false "Part of the validator name, case insensitive"
+// @Param epoch query integer false "Epoch aggregation of the APY" Enums(1, 10) default(10)
+// @Param epochs query []integer false "Epochs of the data" collectionFormat(multi)
+// @Param sort query string false "Sort field" Enums(apy, stake, fee, score, skipped_slot, data_center, staking_accounts) default(apy)
+// @Param desc query bool false "Sort in descending order" default(true)
+// @Param min_fee query string false "Minimum commission, a fraction"
+// @Param max_fee query string false "Maximum commission, a fraction"
+// @Param min_apy query string false "Minimum APY"
+// @Param max_apy query string false "Maximum APY"
+// @Param min_score query integer false "Minimum score"
+// @Param max_skipped_slots query string false "Maximum skipped slots"
+// @Param delinquent query bool false "Only delinquent validators when true, none when false"
+// @Param data_centers query []string false "Data centers" collectionFormat(multi)
+// @Param min_stake query string false "Minimum active stake in lamports"
+// @Param max_stake query string false "Maximum active stake in lamports"
+// @Param delegated_by query []string false "Only validators some of the pools delegate to" collectionFormat(multi)
+// @Param undelegated query bool false "Only validators no pool delegates to"
+// @Param offset query integer false "Offset" default(0)
+// @Param limit query integer false "Limit" minimum(1) maximum(100) default(10)
+// @Success 200 {object} response{data=[]validator,pagination=pagination} "Ok"
+// @Failure 400 {object} problem "invalid parameters"
+// @Failure 500 {object} problem "internal server error"
+// @Router /validators [get]
+func (h *Handler) GetValidators(ctx *gin.Context) (*response, error) {
+	q := struct {
+		Name   string   `form:"name"`
+		Epoch  uint64   `form:"epoch,default=10" binding:"oneof=1 10"`
+		Epochs []uint64 `form:"epochs"`
+		Sort   string   `form:"sort,default=apy" binding:"oneof=apy stake fee score skipped_slot data_center staking_accounts"`
+		Desc   bool     `form:"desc,default=true"`
+		Offset uint64   `form:"offset,default=0"`
+		Limit  uint64   `form:"limit,default=10" binding:"min=1,max=100"`
+		validatorFilter
+		DelegatedBy []string `form:"delegated_by"`
+		Undelegated bool     `form:"undelegated"`
+	}{}
+	if err := bindQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+
+	filter := q.filter()
+	filter.DelegatedBy, filter.Undelegated = q.DelegatedBy, q.Undelegated
+	validators, total, err := h.svc.GetAllValidators(q.Name, sortParam(q.Sort), q.Desc, q.Epoch, q.Epochs, filter, q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*validator, len(validators))
+	for i, v := range validators {
+		data[i] = (&validator{}).Set(v)
+	}
+	return &response{Data: data, Pagination: &pagination{Offset: q.Offset, Limit: q.Limit, Total: total}}, nil
+}
+
+// validatorFilter are the query parameters filtering validators by their data.
+type validatorFilter struct {
+	MinFee          *decimal.Decimal `form:"min_fee"`
+	MaxFee          *decimal.Decimal `form:"max_fee"`
+	MinAPY          *decimal.Decimal `form:"min_apy"`
+	MaxAPY          *decimal.Decimal `form:"max_apy"`
+	MinScore        *int64           `form:"min_score"`
+	MaxSkippedSlots *decimal.Decimal `form:"max_skipped_slots"`
+	Delinquent      *bool            `form:"delinquent"`
+	DataCenters     []string         `form:"data_centers"`
+	MinStake        *uint64          `form:"min_stake"`
+	MaxStake        *uint64          `form:"max_stake"`
+}
+
+func (f validatorFilter) filter() smodels.ValidatorFilter {
+	return smodels.ValidatorFilter{
+		MinFee:          f.MinFee,
+		MaxFee:          f.MaxFee,
+		MinAPY:          f.MinAPY,
+		MaxAPY:          f.MaxAPY,
+		MinScore:        f.MinScore,
+		MaxSkippedSlots: f.MaxSkippedSlots,
+		Delinquent:      f.Delinquent,
+		DataCenters:     f.DataCenters,
+		MinStake:        f.MinStake,
+		MaxStake:        f.MaxStake,
+	}
+}
+
+// GetValidator godoc
+// @Summary Validator
+// @Description The validator with its epoch history and the pools delegating to it.
+// @Tags validator
+// @Produce json
+// @Param vote path string true "Vote account of the validator"
+// @Param epoch query integer false "Epoch aggregation of the APY" Enums(1, 10) default(10)
+// @Param limit query integer false "Epochs of history" minimum(1) maximum(100) default(10)
+// @Success 200 {object} response{data=validatorDetails} "Ok"
+// @Failure 400,404 {object} problem "invalid parameters or unknown validator"
+// @Failure 500 {object} problem "internal server error"
+// @Router /validators/{vote} [get]
+func (h *Handler) GetValidator(ctx *gin.Context) (*response, error) {
+	q := struct {
+		Epoch uint64 `form:"epoch,default=10" binding:"oneof=1 10"`
+		Limit uint64 `form:"limit,default=10" binding:"min=1,max=100"`
+	}{}
+	if err := bindQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+
+	vote := ctx.Param("vote")
+	v, err := h.svc.GetValidator(vote, q.Epoch, q.Limit)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, notFound("validator %s not found", vote)
+		}
+		return nil, err
+	}
+	return &response{Data: (&validatorDetails{}).Set(v)}, nil
+}
+
+type (
+	validator struct {
+		VotePK          string          `json:"vote_pk"`
+		NodePK          string          `json:"node_pk"`
+		Name            string          `json:"name"`
+		Image           string          `json:"image"`
+		Delinquent      bool            `json:"delinquent"`
+		APY             decimal.Decimal `json:"apy" swaggertype:"string"`
+		ActiveStake     amount          `json:"active_stake"`
+		StakingAccounts uint64          `json:"staking_accounts"`
+		Fee             decimal.Decimal `json:"fee" swaggertype:"string"`
+		Score           int64           `json:"score"`
+		SkippedSlots    decimal.Decimal `json:"skipped_slots" swaggertype:"string"`
+		DataCenter      string          `json:"data_center"`
+		Epoch           uint64          `json:"epoch"`
+	}
+	poolValidator struct {
+		validator
+		PoolActiveStake amount `json:"pool_active_stake"`
+	}
+	validatorDetails struct {
+		validator
+		History []*validatorEpoch `json:"history"`
+		Pools   []*validatorPool  `json:"pools"`
+	}
+	validatorEpoch struct {
+		Epoch           uint64          `json:"epoch"`
+		APY             decimal.Decimal `json:"apy" swaggertype:"string"`
+		StakingAccounts uint64          `json:"staking_accounts"`
+		ActiveStake     amount          `json:"active_stake"`
+		Fee             decimal.Decimal `json:"fee" swaggertype:"string"`
+		Score           int64           `json:"score"`
+		SkippedSlots    decimal.Decimal `json:"skipped_slots" swaggertype:"string"`
+		CreatedAt       time.Time       `json:"created_at"`
+	}
+	validatorPool struct {
+		Name        string          `json:"name"`
+		Address     string          `json:"address"`
+		Image       string          `json:"image"`
+		ActiveStake amount          `json:"active_stake"`
+		PoolShare   decimal.Decimal `json:"pool_share" swaggertype:"string"`
+	}
+)
+
+func (v *validator) Set(data *smodels.Validator) *validator {
+	v.VotePK = data.VotePK
+	v.NodePK = data.NodePK
+	v.Name = data.Name
+	v.Image = data.Image
+	v.Delinquent = data.Delinquent
+	v.APY = data.APY
+	v.ActiveStake.Set(data.TotalActiveStake)
+	v.StakingAccounts = data.StakingAccounts
+	v.Fee = data.Fee
+	v.Score = data.Score
+	v.SkippedSlots = data.SkippedSlots
+	v.DataCenter = data.DataCenter
+	v.Epoch = data.Epoch
+	return v
+}
+
+func (pv *poolValidator) Set(data *smodels.PoolValidatorData) *poolValidator {
+	pv.validator.Set(&smodels.Validator{
+		Image:            data.Image,
+		Name:             data.Name,
+		Delinquent:       data.Delinquent,
+		StakingAccounts:  data.StakingAccounts,
+		NodePK:           data.NodePK,
+		APY:              data.APY,
+		VotePK:           data.VotePK,
+		TotalActiveStake: data.TotalActiveStake,
+		Fee:              data.Fee,
+		Score:            data.Score,
+		SkippedSlots:     data.SkippedSlots,
+		DataCenter:       data.DataCenter,
+		Epoch:            data.Epoch,
+	})
+	pv.PoolActiveStake.Set(data.PoolActiveStake)
+	return pv
+}
+
+func (vd *validatorDetails) Set(data *smodels.ValidatorDetails) *validatorDetails {
+	vd.validator.Set(&data.Validator)
+	vd.History = make([]*validatorEpoch, len(data.History))
+	for i, e := range data.History {
+		vd.History[i] = (&validatorEpoch{}).Set(e)
+	}
+	vd.Pools = make([]*validatorPool, len(data.Pools))
+	for i, p := range data.Pools {
+		vd.Pools[i] = (&validatorPool{}).Set(p)
+	}
+	return vd
+}
+
+func (ve *validatorEpoch) Set(data *smodels.ValidatorEpoch) *validatorEpoch {
+	ve.Epoch = data.Epoch
+	ve.APY = data.APY
+	ve.StakingAccounts = data.StakingAccounts
+	ve.ActiveStake.Set(data.ActiveStake)
+	ve.Fee = data.Fee
+	ve.Score = data.Score
+	ve.SkippedSlots = data.SkippedSlots
+	ve.CreatedAt = data.CreatedAt
+	return ve
+}
+
+func (vp *validatorPool) Set(data *smodels.ValidatorPool) *validatorPool {
+	vp.Name = data.Name
+	vp.Address = data.Address
+	vp.Image = data.Image
+	vp.ActiveStake.Set(data.ActiveStake)
+	vp.PoolShare = data.PoolShare
+	return vp
+}
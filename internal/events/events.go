@@ -0,0 +1,207 @@
+package events
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	TopicNetworkStats = "network-stats"
+	TopicEpoch        = "epoch"
+	TopicDelinquency  = "delinquency"
+	TopicCoins        = "coins"
+
+	// Types of the per-entity topics, which are written as <type>:<entity>.
+	TypePool          = "pool"
+	TypeValidator     = "validator"
+	TypeLiquidityPool = "liquidity-pool"
+
+	poolPrefix          = TypePool + ":"
+	validatorPrefix     = TypeValidator + ":"
+	liquidityPoolPrefix = TypeLiquidityPool + ":"
+
+	// ReplaySize is the number of recent events kept for subscribers resuming after a disconnect.
+	ReplaySize = 4096
+)
+
+type (
+	// Event tells subscribers a topic changed; Data is only set for events that carry their own payload,
+	// other topics are re-read by the subscriber. ID and CreatedAt are set by the Bus.
+	Event struct {
+		ID        uint64
+		Topic     string
+		Data      interface{}
+		CreatedAt time.Time
+		// Dropped is set on the first event a subscriber receives after events it subscribed to were dropped
+		// because its buffer was full.
+		Dropped bool
+	}
+	subscription struct {
+		filters []string
+		dropped bool
+	}
+	// Bus fans events out to subscribers without blocking publishers and keeps the last ReplaySize events;
+	// a nil Bus drops everything.
+	Bus struct {
+		mu     sync.RWMutex
+		subs   map[chan Event]*subscription
+		lastID uint64
+		replay []Event
+		next   int
+	}
+)
+
+// NewBus starts event IDs at the current time, so IDs handed out before a restart are older than the new ones.
+func NewBus() *Bus {
+	return &Bus{
+		subs:   make(map[chan Event]*subscription),
+		lastID: uint64(time.Now().UnixNano()),
+		replay: make([]Event, 0, ReplaySize),
+	}
+}
+
+func PoolTopic(name string) string {
+	return poolPrefix + name
+}
+
+func ValidatorTopic(vote string) string {
+	return validatorPrefix + vote
+}
+
+func LiquidityPoolTopic(name string) string {
+	return liquidityPoolPrefix + name
+}
+
+// Type is the kind of the topic: its prefix for per-entity topics, the topic itself otherwise.
+func Type(topic string) string {
+	if i := strings.IndexByte(topic, ':'); i > 0 {
+		return topic[:i]
+	}
+	return topic
+}
+
+// Match reports whether topic is selected by filters; a filter ending in ":*" selects every topic of that type
+// and no filters select everything.
+func Match(filters []string, topic string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if f == topic || (strings.HasSuffix(f, ":*") && strings.HasPrefix(topic, f[:len(f)-1])) {
+			return true
+		}
+	}
+	return false
+}
+
+// PoolName returns the pool name of a pool:<name> topic.
+func PoolName(topic string) (string, bool) {
+	return cut(topic, poolPrefix)
+}
+
+// ValidatorVote returns the vote account of a validator:<vote> topic.
+func ValidatorVote(topic string) (string, bool) {
+	return cut(topic, validatorPrefix)
+}
+
+// Valid reports whether topic is one clients can subscribe to.
+func Valid(topic string) bool {
+	if _, ok := PoolName(topic); ok {
+		return true
+	}
+	if _, ok := ValidatorVote(topic); ok {
+		return true
+	}
+	if _, ok := cut(topic, liquidityPoolPrefix); ok {
+		return true
+	}
+	return topic == TopicNetworkStats || topic == TopicEpoch || topic == TopicDelinquency || topic == TopicCoins
+}
+
+// ValidFilter reports whether f is a topic or a <type>:* wildcard of a per-entity topic type.
+func ValidFilter(f string) bool {
+	switch f {
+	case TypePool + ":*", TypeValidator + ":*", TypeLiquidityPool + ":*":
+		return true
+	}
+	return Valid(f)
+}
+
+// Publish delivers e to every subscriber of the topic with room in its buffer; events for full subscribers are dropped
+// and the next event they receive is marked Dropped.
+func (b *Bus) Publish(e Event) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastID++
+	e.ID, e.CreatedAt = b.lastID, time.Now()
+	if len(b.replay) < ReplaySize {
+		b.replay = append(b.replay, e)
+	} else {
+		b.replay[b.next] = e
+		b.next = (b.next + 1) % ReplaySize
+	}
+	for ch, sub := range b.subs {
+		if !Match(sub.filters, e.Topic) {
+			continue
+		}
+		se := e
+		se.Dropped = sub.dropped
+		select {
+		case ch <- se:
+			sub.dropped = false
+		default:
+			sub.dropped = true
+		}
+	}
+}
+
+// Subscribe returns a channel of the published events matching filters, as in Match, and a function that unsubscribes
+// and closes it. Events of other topics do not take room in the buffer.
+func (b *Bus) Subscribe(buffer int, filters ...string) (<-chan Event, func()) {
+	ch := make(chan Event, buffer)
+	if b == nil {
+		close(ch)
+		return ch, func() {}
+	}
+	b.mu.Lock()
+	b.subs[ch] = &subscription{filters: filters}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Since returns the kept events published after the event with the given ID, oldest first. It reports false
+// when events after that ID were already dropped, so the caller missed some.
+func (b *Bus) Since(id uint64) ([]Event, bool) {
+	if b == nil {
+		return nil, false
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	kept := append(append(make([]Event, 0, len(b.replay)), b.replay[b.next:]...), b.replay[:b.next]...)
+	i := len(kept)
+	for i > 0 && kept[i-1].ID > id {
+		i--
+	}
+	complete := id >= b.lastID-uint64(len(kept)) && id <= b.lastID
+	return kept[i:], complete
+}
+
+func cut(topic, prefix string) (string, bool) {
+	if !strings.HasPrefix(topic, prefix) || len(topic) == len(prefix) {
+		return "", false
+	}
+	return topic[len(prefix):], true
+}
@@ -0,0 +1,133 @@
+package events_test
+
+import (
+	"github.com/everstake/solana-pools/internal/events"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestBus(t *testing.T) {
+	b := events.NewBus()
+	ch, unsubscribe := b.Subscribe(1)
+
+	b.Publish(events.Event{Topic: events.PoolTopic("Eversol")})
+	b.Publish(events.Event{Topic: events.TopicEpoch})
+	e := <-ch
+	assert.Equal(t, e.Topic, "pool:Eversol")
+	select {
+	case e := <-ch:
+		t.Fatalf("event %s should be dropped for a full subscriber", e.Topic)
+	default:
+	}
+
+	unsubscribe()
+	unsubscribe()
+	b.Publish(events.Event{Topic: events.TopicEpoch})
+	_, ok := <-ch
+	assert.Assert(t, !ok, "channel is closed")
+
+	var nilBus *events.Bus
+	nilBus.Publish(events.Event{Topic: events.TopicEpoch})
+	ch, _ = nilBus.Subscribe(1)
+	_, ok = <-ch
+	assert.Assert(t, !ok)
+}
+
+func TestBusFilteredSubscription(t *testing.T) {
+	b := events.NewBus()
+	ch, unsubscribe := b.Subscribe(1, "pool:*")
+	defer unsubscribe()
+
+	for i := 0; i < 10; i++ {
+		b.Publish(events.Event{Topic: events.ValidatorTopic("vote")})
+	}
+	b.Publish(events.Event{Topic: events.PoolTopic("Eversol")})
+	e := <-ch
+	assert.Equal(t, e.Topic, "pool:Eversol")
+	assert.Assert(t, !e.Dropped, "other topics do not fill the buffer")
+
+	b.Publish(events.Event{Topic: events.PoolTopic("Eversol")})
+	b.Publish(events.Event{Topic: events.PoolTopic("Marinade")})
+	<-ch
+	b.Publish(events.Event{Topic: events.PoolTopic("Lido")})
+	e = <-ch
+	assert.Equal(t, e.Topic, "pool:Lido")
+	assert.Assert(t, e.Dropped, "the event after a drop is marked")
+}
+
+func TestTopics(t *testing.T) {
+	data := map[string]bool{
+		"pool:Eversol": true,
+		"validator:9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF": true,
+		"network-stats":       true,
+		"epoch":               true,
+		"delinquency":         true,
+		"coins":               true,
+		"liquidity-pool:Orca": true,
+		"pool:":               false,
+		"validators":          false,
+		"":                    false,
+	}
+	for topic, valid := range data {
+		assert.Equal(t, events.Valid(topic), valid, topic)
+	}
+
+	name, ok := events.PoolName("pool:Eversol")
+	assert.Assert(t, ok)
+	assert.Equal(t, name, "Eversol")
+	_, ok = events.ValidatorVote("pool:Eversol")
+	assert.Assert(t, !ok)
+}
+
+func TestBusSince(t *testing.T) {
+	b := events.NewBus()
+	for i := 0; i < events.ReplaySize+2; i++ {
+		b.Publish(events.Event{Topic: events.TopicEpoch})
+	}
+	kept, complete := b.Since(0)
+	assert.Assert(t, !complete, "events before the kept ones were dropped")
+	assert.Equal(t, len(kept), events.ReplaySize)
+	for i := 1; i < len(kept); i++ {
+		assert.Equal(t, kept[i].ID, kept[i-1].ID+1)
+	}
+
+	last := kept[len(kept)-1].ID
+	missed, complete := b.Since(last - 2)
+	assert.Assert(t, complete)
+	assert.Equal(t, len(missed), 2)
+	assert.Equal(t, missed[0].ID, last-1)
+	assert.Assert(t, !missed[0].CreatedAt.IsZero())
+
+	missed, complete = b.Since(last)
+	assert.Assert(t, complete)
+	assert.Equal(t, len(missed), 0)
+
+	_, complete = b.Since(kept[0].ID - 1)
+	assert.Assert(t, complete, "the oldest kept event follows the given ID")
+}
+
+func TestMatch(t *testing.T) {
+	data := map[string]struct {
+		Filters []string
+		Topic   string
+		Match   bool
+	}{
+		"no filters":  {Topic: "epoch", Match: true},
+		"exact":       {Filters: []string{"epoch", "pool:Eversol"}, Topic: "pool:Eversol", Match: true},
+		"other pool":  {Filters: []string{"pool:Eversol"}, Topic: "pool:Marinade"},
+		"wildcard":    {Filters: []string{"pool:*"}, Topic: "pool:Marinade", Match: true},
+		"other type":  {Filters: []string{"pool:*"}, Topic: "liquidity-pool:Orca"},
+		"other topic": {Filters: []string{"coins"}, Topic: "epoch"},
+	}
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			assert.Equal(t, events.Match(s2.Filters, s2.Topic), s2.Match)
+		})
+	}
+
+	assert.Assert(t, events.ValidFilter("validator:*"))
+	assert.Assert(t, events.ValidFilter("coins"))
+	assert.Assert(t, !events.ValidFilter("epoch:*"))
+	assert.Equal(t, events.Type("liquidity-pool:Orca"), events.TypeLiquidityPool)
+	assert.Equal(t, events.Type("network-stats"), events.TopicNetworkStats)
+}
@@ -0,0 +1,470 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dfuse-io/solana-go"
+	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/pools"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+	"time"
+)
+
+var ErrInvalidEntity = errors.New("invalid entity")
+
+// dataTypes are the versioned data types the audited entities belong to.
+var dataTypes = map[string]string{
+	dmodels.AuditEntityPool:          events.TypePool,
+	dmodels.AuditEntityCoin:          events.TopicCoins,
+	dmodels.AuditEntityLiquidityPool: events.TypeLiquidityPool,
+	dmodels.AuditEntityGovernance:    DataGovernance,
+}
+
+func (s Imp) AdminGetPools() ([]*smodels.AdminPool, error) {
+	pools, err := s.DAO.GetPools(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPools: %w", err)
+	}
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	names := make(map[uuid.UUID]string, len(coins))
+	for _, c := range coins {
+		names[c.ID] = c.Name
+	}
+
+	res := make([]*smodels.AdminPool, len(pools))
+	for i, p := range pools {
+		res[i] = (&smodels.AdminPool{}).Set(p, names[p.CoinID])
+	}
+	return res, nil
+}
+
+// AdminSavePool creates the pool when its ID is empty and updates it otherwise.
+func (s Imp) AdminSavePool(actor string, pool *smodels.AdminPool) (*smodels.AdminPool, error) {
+	coin, err := s.validatePool(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	dpool := &dmodels.Pool{ID: uuid.NewV4()}
+	action, before := dmodels.AuditActionCreate, ""
+	if pool.ID != uuid.Nil {
+		old, err := s.adminPool(pool.ID)
+		if err != nil {
+			return nil, err
+		}
+		dpool, action, before = old, dmodels.AuditActionUpdate, snapshot((&smodels.AdminPool{}).Set(old, ""))
+	}
+	dpool.Name = pool.Name
+	dpool.Active = pool.Active
+	dpool.CoinID = coin.ID
+	dpool.Address = pool.Address
+	dpool.Network = pool.Network
+	dpool.Program = pool.Program
+	dpool.Image = orDefault(pool.Image, "Default")
+
+	after := (&smodels.AdminPool{}).Set(dpool, coin.Name)
+	if err := s.DAO.ApplyAudited(dpool, newAuditLog(actor, action, dmodels.AuditEntityPool, dpool.ID, before, snapshot(after))); err != nil {
+		return nil, fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityPool])
+	s.Cache.InvalidatePools()
+
+	return after, nil
+}
+
+func (s Imp) AdminDeletePool(actor string, id uuid.UUID) error {
+	old, err := s.adminPool(id)
+	if err != nil {
+		return err
+	}
+	if err := s.DAO.ApplyAudited(old, newAuditLog(actor, dmodels.AuditActionDelete, dmodels.AuditEntityPool, id, snapshot((&smodels.AdminPool{}).Set(old, "")), "")); err != nil {
+		return fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityPool])
+	s.Cache.InvalidatePools()
+	return nil
+}
+
+func (s Imp) adminPool(id uuid.UUID) (*dmodels.Pool, error) {
+	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{IDs: []uuid.UUID{id}}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPools: %w", err)
+	}
+	if len(pools) == 0 {
+		return nil, fmt.Errorf("DAO.GetPools(%s): %w", id, postgres.ErrorRecordNotFounded)
+	}
+	return pools[0], nil
+}
+
+// validatePool checks the pool fields and returns its coin; the address must be an existing account on the pool network.
+func (s Imp) validatePool(pool *smodels.AdminPool) (*dmodels.Coin, error) {
+	if pool.Name == "" {
+		return nil, fmt.Errorf("%w: pool name is required", ErrInvalidEntity)
+	}
+	existing, err := s.DAO.GetPool(pool.Name)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPool: %w", err)
+	}
+	if existing != nil && existing.ID != pool.ID {
+		return nil, fmt.Errorf("%w: pool name %s is already used", ErrInvalidEntity, pool.Name)
+	}
+
+	coins, err := s.DAO.GetCoins(&postgres.CoinCondition{Names: []string{pool.Coin}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	if len(coins) == 0 {
+		return nil, fmt.Errorf("%w: coin %s not found", ErrInvalidEntity, pool.Coin)
+	}
+
+	if pool.Program != "" && !contains(pools.Programs, pool.Program) {
+		return nil, fmt.Errorf("%w: unknown program %s", ErrInvalidEntity, pool.Program)
+	}
+
+	rpc, ok := s.rpcClients[config.Network(pool.Network)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown network %s", ErrInvalidEntity, pool.Network)
+	}
+	if _, err := solana.PublicKeyFromBase58(pool.Address); err != nil {
+		return nil, fmt.Errorf("%w: address %s is not a base58 public key", ErrInvalidEntity, pool.Address)
+	}
+	account, err := rpc.GetAccountInfo(context.Background(), pool.Address)
+	if err != nil {
+		return nil, fmt.Errorf("GetAccountInfo: %w", err)
+	}
+	if account.Owner == "" {
+		return nil, fmt.Errorf("%w: account %s not found on %s", ErrInvalidEntity, pool.Address, pool.Network)
+	}
+
+	return coins[0], nil
+}
+
+func (s Imp) AdminGetCoins() ([]*smodels.AdminCoin, error) {
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	res := make([]*smodels.AdminCoin, len(coins))
+	for i, c := range coins {
+		res[i] = (&smodels.AdminCoin{}).Set(c)
+	}
+	return res, nil
+}
+
+// AdminSaveCoin creates the coin when its ID is empty and updates it otherwise; prices are kept on update.
+func (s Imp) AdminSaveCoin(actor string, coin *smodels.AdminCoin) (*smodels.AdminCoin, error) {
+	coin.GeckoKey = orDefault(coin.GeckoKey, "null")
+	if err := s.validateCoin(coin); err != nil {
+		return nil, err
+	}
+
+	dcoin := &dmodels.Coin{ID: uuid.NewV4()}
+	action, before := dmodels.AuditActionCreate, ""
+	if coin.ID != uuid.Nil {
+		old, err := s.adminCoin(coin.ID)
+		if err != nil {
+			return nil, err
+		}
+		dcoin, action, before = old, dmodels.AuditActionUpdate, snapshot((&smodels.AdminCoin{}).Set(old))
+	}
+	dcoin.Name = coin.Name
+	dcoin.GeckoKey = coin.GeckoKey
+	dcoin.Address = coin.Address
+	dcoin.ThumbImage = orDefault(coin.ThumbImage, "NaN")
+	dcoin.SmallImage = orDefault(coin.SmallImage, "NaN")
+	dcoin.LargeImage = orDefault(coin.LargeImage, "NaN")
+
+	after := (&smodels.AdminCoin{}).Set(dcoin)
+	if err := s.DAO.ApplyAudited(dcoin, newAuditLog(actor, action, dmodels.AuditEntityCoin, dcoin.ID, before, snapshot(after))); err != nil {
+		return nil, fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityCoin])
+	s.Cache.InvalidatePools()
+
+	return after, nil
+}
+
+func (s Imp) AdminDeleteCoin(actor string, id uuid.UUID) error {
+	old, err := s.adminCoin(id)
+	if err != nil {
+		return err
+	}
+	if err := s.DAO.ApplyAudited(old, newAuditLog(actor, dmodels.AuditActionDelete, dmodels.AuditEntityCoin, id, snapshot((&smodels.AdminCoin{}).Set(old)), "")); err != nil {
+		return fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityCoin])
+	s.Cache.InvalidatePools()
+	return nil
+}
+
+func (s Imp) adminCoin(id uuid.UUID) (*dmodels.Coin, error) {
+	coins, err := s.DAO.GetCoins(&postgres.CoinCondition{Condition: &postgres.Condition{IDs: []uuid.UUID{id}}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	if len(coins) == 0 {
+		return nil, fmt.Errorf("DAO.GetCoins(%s): %w", id, postgres.ErrorRecordNotFounded)
+	}
+	return coins[0], nil
+}
+
+func (s Imp) validateCoin(coin *smodels.AdminCoin) error {
+	if coin.Name == "" {
+		return fmt.Errorf("%w: coin name is required", ErrInvalidEntity)
+	}
+	if coin.Address != "" {
+		if _, err := solana.PublicKeyFromBase58(coin.Address); err != nil {
+			return fmt.Errorf("%w: address %s is not a base58 public key", ErrInvalidEntity, coin.Address)
+		}
+	}
+
+	coins, err := s.DAO.GetCoins(&postgres.CoinCondition{Names: []string{coin.Name}})
+	if err != nil {
+		return fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	if len(coins) != 0 && coins[0].ID != coin.ID {
+		return fmt.Errorf("%w: coin name %s is already used", ErrInvalidEntity, coin.Name)
+	}
+
+	if coin.GeckoKey == "null" {
+		return nil
+	}
+	coins, err = s.DAO.GetCoins(&postgres.CoinCondition{GeckoIDs: []string{coin.GeckoKey}})
+	if err != nil {
+		return fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	if len(coins) != 0 && coins[0].ID != coin.ID {
+		return fmt.Errorf("%w: gecko key %s is already used by %s", ErrInvalidEntity, coin.GeckoKey, coins[0].Name)
+	}
+	return nil
+}
+
+func (s Imp) AdminGetLiquidityPools() ([]*smodels.AdminLiquidityPool, error) {
+	pools, err := s.DAO.GetLiquidityPools(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetLiquidityPools: %w", err)
+	}
+	res := make([]*smodels.AdminLiquidityPool, len(pools))
+	for i, p := range pools {
+		res[i] = (&smodels.AdminLiquidityPool{}).Set(p)
+	}
+	return res, nil
+}
+
+// AdminSaveLiquidityPool creates the liquidity pool when its ID is empty and updates it otherwise; the sync status is kept on update.
+func (s Imp) AdminSaveLiquidityPool(actor string, pool *smodels.AdminLiquidityPool) (*smodels.AdminLiquidityPool, error) {
+	if pool.Name == "" {
+		return nil, fmt.Errorf("%w: liquidity pool name is required", ErrInvalidEntity)
+	}
+	existing, err := s.DAO.GetLiquidityPool(&postgres.Condition{Names: []string{pool.Name}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetLiquidityPool: %w", err)
+	}
+	if existing != nil && existing.ID != pool.ID {
+		return nil, fmt.Errorf("%w: liquidity pool name %s is already used", ErrInvalidEntity, pool.Name)
+	}
+
+	dpool := &dmodels.LiquidityPool{ID: uuid.NewV4()}
+	action, before := dmodels.AuditActionCreate, ""
+	if pool.ID != uuid.Nil {
+		old, err := s.adminLiquidityPool(pool.ID)
+		if err != nil {
+			return nil, err
+		}
+		dpool, action, before = old, dmodels.AuditActionUpdate, snapshot((&smodels.AdminLiquidityPool{}).Set(old))
+	}
+	dpool.Name = pool.Name
+	dpool.About = pool.About
+	dpool.Image = orDefault(pool.Image, "null")
+	dpool.URL = orDefault(pool.URL, "null")
+
+	after := (&smodels.AdminLiquidityPool{}).Set(dpool)
+	if err := s.DAO.ApplyAudited(dpool, newAuditLog(actor, action, dmodels.AuditEntityLiquidityPool, dpool.ID, before, snapshot(after))); err != nil {
+		return nil, fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityLiquidityPool])
+
+	return after, nil
+}
+
+func (s Imp) AdminDeleteLiquidityPool(actor string, id uuid.UUID) error {
+	old, err := s.adminLiquidityPool(id)
+	if err != nil {
+		return err
+	}
+	if err := s.DAO.ApplyAudited(old, newAuditLog(actor, dmodels.AuditActionDelete, dmodels.AuditEntityLiquidityPool, id, snapshot((&smodels.AdminLiquidityPool{}).Set(old)), "")); err != nil {
+		return fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityLiquidityPool])
+	return nil
+}
+
+func (s Imp) adminLiquidityPool(id uuid.UUID) (*dmodels.LiquidityPool, error) {
+	pool, err := s.DAO.GetLiquidityPool(&postgres.Condition{IDs: []uuid.UUID{id}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetLiquidityPool: %w", err)
+	}
+	if pool == nil {
+		return nil, fmt.Errorf("DAO.GetLiquidityPool(%s): %w", id, postgres.ErrorRecordNotFounded)
+	}
+	return pool, nil
+}
+
+func (s Imp) AdminGetGovernance() ([]*smodels.AdminGovernance, error) {
+	gov, err := s.DAO.GetGovernance(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetGovernance: %w", err)
+	}
+	res := make([]*smodels.AdminGovernance, len(gov))
+	for i, g := range gov {
+		res[i] = (&smodels.AdminGovernance{}).Set(g)
+	}
+	return res, nil
+}
+
+// AdminSaveGovernance creates the governance token when its ID is empty and updates it otherwise; supply and price are kept on update.
+func (s Imp) AdminSaveGovernance(actor string, governance *smodels.AdminGovernance) (*smodels.AdminGovernance, error) {
+	governance.GeckoKey = orDefault(governance.GeckoKey, "null")
+	if err := s.validateGovernance(governance); err != nil {
+		return nil, err
+	}
+
+	dgov := &dmodels.Governance{ID: uuid.NewV4()}
+	action, before := dmodels.AuditActionCreate, ""
+	if governance.ID != uuid.Nil {
+		old, err := s.adminGovernance(governance.ID)
+		if err != nil {
+			return nil, err
+		}
+		dgov, action, before = old, dmodels.AuditActionUpdate, snapshot((&smodels.AdminGovernance{}).Set(old))
+	}
+	dgov.Name = governance.Name
+	dgov.Symbol = governance.Symbol
+	dgov.VoteURL = orDefault(governance.VoteURL, "null")
+	dgov.WebSiteURL = orDefault(governance.WebSiteURL, "null")
+	dgov.Image = orDefault(governance.Image, "null")
+	dgov.GeckoKey = governance.GeckoKey
+	dgov.Blockchain = governance.Blockchain
+	dgov.ContractAddress = governance.ContractAddress
+
+	after := (&smodels.AdminGovernance{}).Set(dgov)
+	if err := s.DAO.ApplyAudited(dgov, newAuditLog(actor, action, dmodels.AuditEntityGovernance, dgov.ID, before, snapshot(after))); err != nil {
+		return nil, fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityGovernance])
+
+	return after, nil
+}
+
+func (s Imp) AdminDeleteGovernance(actor string, id uuid.UUID) error {
+	old, err := s.adminGovernance(id)
+	if err != nil {
+		return err
+	}
+	if err := s.DAO.ApplyAudited(old, newAuditLog(actor, dmodels.AuditActionDelete, dmodels.AuditEntityGovernance, id, snapshot((&smodels.AdminGovernance{}).Set(old)), "")); err != nil {
+		return fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.touch(dataTypes[dmodels.AuditEntityGovernance])
+	return nil
+}
+
+func (s Imp) adminGovernance(id uuid.UUID) (*dmodels.Governance, error) {
+	gov, err := s.DAO.GetGovernance(&postgres.GovernanceCondition{Condition: &postgres.Condition{IDs: []uuid.UUID{id}}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetGovernance: %w", err)
+	}
+	if len(gov) == 0 {
+		return nil, fmt.Errorf("DAO.GetGovernance(%s): %w", id, postgres.ErrorRecordNotFounded)
+	}
+	return gov[0], nil
+}
+
+func (s Imp) validateGovernance(governance *smodels.AdminGovernance) error {
+	if governance.Name == "" {
+		return fmt.Errorf("%w: governance name is required", ErrInvalidEntity)
+	}
+	if governance.ContractAddress == "" {
+		return fmt.Errorf("%w: contract address is required", ErrInvalidEntity)
+	}
+	if strings.EqualFold(governance.Blockchain, "solana") {
+		if _, err := solana.PublicKeyFromBase58(governance.ContractAddress); err != nil {
+			return fmt.Errorf("%w: contract address %s is not a base58 public key", ErrInvalidEntity, governance.ContractAddress)
+		}
+	}
+
+	gov, err := s.DAO.GetGovernance(&postgres.GovernanceCondition{ContractAddresses: []string{governance.ContractAddress}})
+	if err != nil {
+		return fmt.Errorf("DAO.GetGovernance: %w", err)
+	}
+	if len(gov) != 0 && gov[0].ID != governance.ID {
+		return fmt.Errorf("%w: contract address %s is already used by %s", ErrInvalidEntity, governance.ContractAddress, gov[0].Name)
+	}
+
+	if governance.GeckoKey == "null" {
+		return nil
+	}
+	gov, err = s.DAO.GetGovernance(&postgres.GovernanceCondition{GeckoKeys: []string{governance.GeckoKey}})
+	if err != nil {
+		return fmt.Errorf("DAO.GetGovernance: %w", err)
+	}
+	if len(gov) != 0 && gov[0].ID != governance.ID {
+		return fmt.Errorf("%w: gecko key %s is already used by %s", ErrInvalidEntity, governance.GeckoKey, gov[0].Name)
+	}
+	return nil
+}
+
+func (s Imp) GetAuditLogs(entity string, limit uint64, offset uint64) ([]*smodels.AuditLog, uint64, error) {
+	logs, err := s.DAO.GetAuditLogs(&postgres.AuditLogCondition{
+		Condition: &postgres.Condition{Pagination: postgres.Pagination{Limit: limit, Offset: offset}},
+		Entity:    entity,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("DAO.GetAuditLogs: %w", err)
+	}
+
+	count, err := s.DAO.GetAuditLogsCount(&postgres.AuditLogCondition{Entity: entity})
+	if err != nil {
+		return nil, 0, fmt.Errorf("DAO.GetAuditLogsCount: %w", err)
+	}
+
+	res := make([]*smodels.AuditLog, len(logs))
+	for i, l := range logs {
+		res[i] = (&smodels.AuditLog{}).Set(l)
+	}
+	return res, uint64(count), nil
+}
+
+func newAuditLog(actor, action, entity string, id uuid.UUID, before, after string) *dmodels.AuditLog {
+	return &dmodels.AuditLog{
+		Actor:     actor,
+		Action:    action,
+		Entity:    entity,
+		EntityID:  id,
+		Before:    before,
+		After:     after,
+		CreatedAt: time.Now(),
+	}
+}
+
+// snapshot renders the entity for the audit log; the admin models always marshal.
+func snapshot(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func orDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
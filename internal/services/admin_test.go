@@ -0,0 +1,180 @@
+package services_test
+
+import (
+	"errors"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/cache"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestAdminSaveCoin(t *testing.T) {
+	mSOL := &dmodels.Coin{ID: uuid.NewV4(), Name: "mSOL", GeckoKey: "msol", Address: "mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So", USD: 104, LargeImage: "img"}
+
+	data := map[string]struct {
+		Coin   *smodels.AdminCoin
+		Action string
+		Err    error
+	}{
+		"create": {
+			Coin:   &smodels.AdminCoin{Name: "stSOL", GeckoKey: "lido-staked-sol", Address: "7dHbWXmci3dT8UFYWYZweBLXgycu7Y3iL6trKn1Y7ARj"},
+			Action: dmodels.AuditActionCreate,
+		},
+		"update keeps price": {
+			Coin:   &smodels.AdminCoin{ID: mSOL.ID, Name: "mSOL", GeckoKey: "msol", Address: mSOL.Address, LargeImage: "new"},
+			Action: dmodels.AuditActionUpdate,
+		},
+		"duplicate gecko key": {
+			Coin: &smodels.AdminCoin{Name: "mSOL2", GeckoKey: "msol"},
+			Err:  services.ErrInvalidEntity,
+		},
+		"bad address": {
+			Coin: &smodels.AdminCoin{Name: "stSOL", Address: "0xdead"},
+			Err:  services.ErrInvalidEntity,
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			var saved *dmodels.Coin
+			var audit *dmodels.AuditLog
+			var touched []string
+			c := cache.New(time.Hour, time.Hour)
+			c.SetPool(&smodels.PoolDetails{Pool: smodels.Pool{Name: "Marinade"}}, time.Hour)
+			d := services.Imp{
+				Cache: c,
+				DAO: &dao.PostgresMock{
+					GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+						m := *mSOL
+						switch {
+						case cond.Condition != nil && cond.IDs[0] == mSOL.ID,
+							len(cond.Names) != 0 && cond.Names[0] == mSOL.Name,
+							len(cond.GeckoIDs) != 0 && cond.GeckoIDs[0] == mSOL.GeckoKey:
+							return []*dmodels.Coin{&m}, nil
+						}
+						return nil, nil
+					},
+					ApplyAuditedFunc: func(entity interface{}, a *dmodels.AuditLog) error {
+						saved = entity.(*dmodels.Coin)
+						audit = a
+						return nil
+					},
+					TouchDataVersionsFunc: func(types ...string) error {
+						touched = append(touched, types...)
+						return nil
+					},
+				},
+			}
+
+			coin, err := d.AdminSaveCoin("alice", s2.Coin)
+			if s2.Err != nil {
+				assert.Assert(t, errors.Is(err, s2.Err), err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, coin.Name, s2.Coin.Name)
+			assert.Equal(t, audit.Actor, "alice")
+			assert.Equal(t, audit.Action, s2.Action)
+			assert.Equal(t, audit.Entity, dmodels.AuditEntityCoin)
+			assert.Equal(t, audit.EntityID, saved.ID)
+			assert.Equal(t, audit.Before == "", s2.Action == dmodels.AuditActionCreate)
+			_, err = c.GetPool("Marinade")
+			assert.Assert(t, errors.Is(err, cache.KeyWasNotFound))
+			assert.DeepEqual(t, touched, []string{events.TopicCoins})
+
+			if s2.Action == dmodels.AuditActionUpdate {
+				assert.Equal(t, saved.ID, mSOL.ID)
+				assert.Equal(t, saved.USD, mSOL.USD)
+				assert.Equal(t, saved.LargeImage, "new")
+				assert.Equal(t, saved.ThumbImage, "NaN")
+			}
+		})
+	}
+}
+
+func TestAdminSavePool(t *testing.T) {
+	marinade := &dmodels.Pool{ID: uuid.NewV4(), Name: "Marinade", Network: "mainnet"}
+	mSOL := &dmodels.Coin{ID: uuid.NewV4(), Name: "mSOL"}
+
+	data := map[string]struct {
+		Pool *smodels.AdminPool
+		Err  error
+	}{
+		"duplicate name": {
+			Pool: &smodels.AdminPool{Name: "Marinade", Coin: "mSOL", Network: "mainnet", Address: "8szGkuLTAux9XMgZ2vtY39jVSowEcpBfFfD8hXSEqdGC"},
+			Err:  services.ErrInvalidEntity,
+		},
+		"unknown coin": {
+			Pool: &smodels.AdminPool{ID: marinade.ID, Name: "Marinade", Coin: "xSOL", Network: "mainnet", Address: "8szGkuLTAux9XMgZ2vtY39jVSowEcpBfFfD8hXSEqdGC"},
+			Err:  services.ErrInvalidEntity,
+		},
+		"unknown network": {
+			Pool: &smodels.AdminPool{Name: "Lido", Coin: "mSOL", Network: "devnet", Address: "49Yi1TKkNyYjPAFdR9LBvoHcUjuPX4Df5T5yv39w2XTn"},
+			Err:  services.ErrInvalidEntity,
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			d := services.Imp{
+				DAO: &dao.PostgresMock{
+					GetPoolFunc: func(name string) (*dmodels.Pool, error) {
+						if name == marinade.Name {
+							return marinade, nil
+						}
+						return nil, nil
+					},
+					GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+						if cond.Names[0] == mSOL.Name {
+							return []*dmodels.Coin{mSOL}, nil
+						}
+						return nil, nil
+					},
+				},
+			}
+
+			_, err := d.AdminSavePool("alice", s2.Pool)
+			assert.Assert(t, errors.Is(err, s2.Err), err)
+		})
+	}
+}
+
+func TestAdminDeleteGovernance(t *testing.T) {
+	var audit *dmodels.AuditLog
+	var touched []string
+	d := services.Imp{
+		DAO: &dao.PostgresMock{
+			GetGovernanceFunc: func(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error) {
+				if cond.IDs[0] == GArr[0].ID {
+					return GArr[:1], nil
+				}
+				return nil, nil
+			},
+			ApplyAuditedFunc: func(entity interface{}, a *dmodels.AuditLog) error {
+				audit = a
+				return nil
+			},
+			TouchDataVersionsFunc: func(types ...string) error {
+				touched = append(touched, types...)
+				return nil
+			},
+		},
+	}
+
+	err := d.AdminDeleteGovernance("alice", uuid.NewV4())
+	assert.Assert(t, errors.Is(err, postgres.ErrorRecordNotFounded), err)
+
+	assert.NilError(t, d.AdminDeleteGovernance("alice", GArr[0].ID))
+	assert.Equal(t, audit.Action, dmodels.AuditActionDelete)
+	assert.Equal(t, audit.EntityID, GArr[0].ID)
+	assert.Equal(t, audit.After, "")
+	assert.Assert(t, audit.Before != "")
+	assert.DeepEqual(t, touched, []string{services.DataGovernance})
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// apiKeyCacheTime is how long a key lookup, including a failed one, is cached.
+const apiKeyCacheTime = time.Minute
+
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetAPIKey returns the active key; unknown and inactive keys return ErrInvalidAPIKey.
+func (s Imp) GetAPIKey(key string) (*smodels.APIKey, error) {
+	hash := hashAPIKey(key)
+	if k, err := s.Cache.GetAPIKey(hash); err == nil {
+		if k == nil {
+			return nil, ErrInvalidAPIKey
+		}
+		return k, nil
+	}
+
+	dkey, err := s.DAO.GetAPIKeyByHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetAPIKeyByHash: %w", err)
+	}
+	var k *smodels.APIKey
+	if dkey != nil && dkey.Active {
+		k = (&smodels.APIKey{}).Set(dkey)
+	}
+	s.Cache.SetAPIKey(hash, k, apiKeyCacheTime)
+	if k == nil {
+		return nil, ErrInvalidAPIKey
+	}
+	return k, nil
+}
+
+func (s Imp) RecordAPIKeyUsage(id uuid.UUID) {
+	s.APIKeyUsage.Add(id.String())
+}
+
+// FlushAPIKeyUsage adds the requests counted since the previous flush to today's usage.
+func (s Imp) FlushAPIKeyUsage() error {
+	counts := s.APIKeyUsage.Drain()
+	requests := make(map[uuid.UUID]int64, len(counts))
+	for id, n := range counts {
+		requests[uuid.FromStringOrNil(id)] = n
+	}
+	if err := s.DAO.AddAPIKeyUsage(time.Now().UTC().Truncate(24*time.Hour), requests); err != nil {
+		return fmt.Errorf("DAO.AddAPIKeyUsage: %w", err)
+	}
+	return nil
+}
+
+func (s Imp) AdminGetAPIKeys() ([]*smodels.APIKey, error) {
+	keys, err := s.DAO.GetAPIKeys()
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetAPIKeys: %w", err)
+	}
+	usage, err := s.DAO.GetAPIKeyUsage(time.Now().UTC().AddDate(0, 0, -30))
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetAPIKeyUsage: %w", err)
+	}
+
+	res := make([]*smodels.APIKey, len(keys))
+	for i, k := range keys {
+		res[i] = (&smodels.APIKey{}).Set(k)
+		res[i].Requests30d = usage[k.ID]
+	}
+	return res, nil
+}
+
+// AdminCreateAPIKey generates a key of the tier; the returned Key is not stored and can't be shown again.
+func (s Imp) AdminCreateAPIKey(actor string, name string, tier string) (*smodels.APIKey, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: api key name is required", ErrInvalidEntity)
+	}
+	if _, ok := s.cfg.RateLimitTiers[tier]; !ok {
+		return nil, fmt.Errorf("%w: unknown tier %s", ErrInvalidEntity, tier)
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("rand.Read: %w", err)
+	}
+	key := hex.EncodeToString(b)
+
+	dkey := &dmodels.APIKey{
+		ID:        uuid.NewV4(),
+		Name:      name,
+		KeyHash:   hashAPIKey(key),
+		Tier:      tier,
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+	res := (&smodels.APIKey{}).Set(dkey)
+	if err := s.DAO.ApplyAudited(dkey, newAuditLog(actor, dmodels.AuditActionCreate, dmodels.AuditEntityAPIKey, dkey.ID, "", snapshot(res))); err != nil {
+		return nil, fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.Cache.InvalidateAPIKeys()
+
+	res.Key = key
+	return res, nil
+}
+
+func (s Imp) AdminDeactivateAPIKey(actor string, id uuid.UUID) error {
+	keys, err := s.DAO.GetAPIKeys()
+	if err != nil {
+		return fmt.Errorf("DAO.GetAPIKeys: %w", err)
+	}
+	var dkey *dmodels.APIKey
+	for _, k := range keys {
+		if k.ID == id {
+			dkey = k
+		}
+	}
+	if dkey == nil {
+		return fmt.Errorf("DAO.GetAPIKeys(%s): %w", id, postgres.ErrorRecordNotFounded)
+	}
+
+	before := snapshot((&smodels.APIKey{}).Set(dkey))
+	dkey.Active = false
+	if err := s.DAO.ApplyAudited(dkey, newAuditLog(actor, dmodels.AuditActionUpdate, dmodels.AuditEntityAPIKey, id, before, snapshot((&smodels.APIKey{}).Set(dkey)))); err != nil {
+		return fmt.Errorf("DAO.ApplyAudited: %w", err)
+	}
+	s.Cache.InvalidateAPIKeys()
+	return nil
+}
@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"errors"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/cache"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/pkg/ratelimit"
+	uuid "github.com/satori/go.uuid"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestGetAPIKey(t *testing.T) {
+	// sha256 of "secret" and "revoked".
+	keys := map[string]*dmodels.APIKey{
+		"2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b": {ID: uuid.NewV4(), Name: "scraper", Tier: "free", Active: true},
+		"4bb47f186df233e48b09d241ee4defb821add0c35ac8311469fe1522c6813dd5": {ID: uuid.NewV4(), Name: "old", Tier: "free"},
+	}
+
+	lookups := 0
+	d := services.Imp{
+		Cache: cache.New(time.Hour, time.Hour),
+		DAO: &dao.PostgresMock{
+			GetAPIKeyByHashFunc: func(hash string) (*dmodels.APIKey, error) {
+				lookups++
+				return keys[hash], nil
+			},
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		k, err := d.GetAPIKey("secret")
+		assert.NilError(t, err)
+		assert.Equal(t, k.Name, "scraper")
+		assert.Equal(t, k.Tier, "free")
+	}
+	assert.Equal(t, lookups, 1, "keys are cached")
+
+	for i := 0; i < 2; i++ {
+		_, err := d.GetAPIKey("unknown")
+		assert.Assert(t, errors.Is(err, services.ErrInvalidAPIKey))
+	}
+	assert.Equal(t, lookups, 2, "unknown keys are cached")
+
+	_, err := d.GetAPIKey("revoked")
+	assert.Assert(t, errors.Is(err, services.ErrInvalidAPIKey), "inactive keys are rejected")
+}
+
+func TestFlushAPIKeyUsage(t *testing.T) {
+	id := uuid.NewV4()
+	var saved map[uuid.UUID]int64
+	var date time.Time
+	d := services.Imp{
+		APIKeyUsage: ratelimit.NewCounter(),
+		DAO: &dao.PostgresMock{
+			AddAPIKeyUsageFunc: func(d time.Time, requests map[uuid.UUID]int64) error {
+				date, saved = d, requests
+				return nil
+			},
+		},
+	}
+
+	d.RecordAPIKeyUsage(id)
+	d.RecordAPIKeyUsage(id)
+	assert.NilError(t, d.FlushAPIKeyUsage())
+	assert.DeepEqual(t, saved, map[uuid.UUID]int64{id: 2})
+	assert.Equal(t, date, time.Now().UTC().Truncate(24*time.Hour))
+
+	assert.NilError(t, d.FlushAPIKeyUsage())
+	assert.DeepEqual(t, saved, map[uuid.UUID]int64{})
+}
+
+func TestAdminCreateAPIKeyUnknownTier(t *testing.T) {
+	d := services.Imp{DAO: &dao.PostgresMock{}}
+	_, err := d.AdminCreateAPIKey("alice", "scraper", "platinum")
+	assert.Assert(t, errors.Is(err, services.ErrInvalidEntity), err)
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/catalog"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"reflect"
+)
+
+const (
+	// catalogActor is the audit log actor of catalog syncs.
+	catalogActor = "sync-catalog"
+
+	CatalogActionCreate     = "create"
+	CatalogActionUpdate     = "update"
+	CatalogActionDeactivate = "deactivate"
+)
+
+// SyncCatalog creates and updates coins, liquidity pools and pools to match the catalog and deactivates pools
+// missing from it on the networks it declares pools on. The changes are applied in one transaction;
+// with dryRun they are only returned.
+func (s Imp) SyncCatalog(c *catalog.Catalog, dryRun bool) ([]*smodels.CatalogChange, error) {
+	var changes []*smodels.CatalogChange
+	var entities []postgres.AuditedEntity
+	apply := func(entity interface{}, change *smodels.CatalogChange, audit *dmodels.AuditLog) error {
+		changes = append(changes, change)
+		entities = append(entities, postgres.AuditedEntity{Entity: entity, Audit: audit})
+		return nil
+	}
+
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	coinsByName := make(map[string]*dmodels.Coin, len(coins))
+	coinNames := make(map[uuid.UUID]string, len(coins))
+	for _, coin := range coins {
+		coinsByName[coin.Name] = coin
+		coinNames[coin.ID] = coin.Name
+	}
+	for _, cc := range c.Coins {
+		coin, ok := coinsByName[cc.Name]
+		action := CatalogActionUpdate
+		if !ok {
+			coin = &dmodels.Coin{ID: uuid.NewV4(), Name: cc.Name, ThumbImage: "NaN", SmallImage: "NaN", LargeImage: "NaN"}
+			coinsByName[cc.Name], coinNames[coin.ID] = coin, cc.Name
+			action = CatalogActionCreate
+		}
+		before := (&smodels.AdminCoin{}).Set(coin)
+		coin.GeckoKey = orDefault(cc.GeckoKey, "null")
+		coin.Address = cc.Mint
+		coin.ThumbImage = orDefault(cc.Images.Thumb, coin.ThumbImage)
+		coin.SmallImage = orDefault(cc.Images.Small, coin.SmallImage)
+		coin.LargeImage = orDefault(cc.Images.Large, coin.LargeImage)
+		after := (&smodels.AdminCoin{}).Set(coin)
+
+		if err := s.syncEntity(apply, coin, dmodels.AuditEntityCoin, coin.ID, cc.Name, action, before, after); err != nil {
+			return changes, err
+		}
+	}
+
+	lps, err := s.DAO.GetLiquidityPools(nil)
+	if err != nil {
+		return changes, fmt.Errorf("DAO.GetLiquidityPools: %w", err)
+	}
+	lpsByName := make(map[string]*dmodels.LiquidityPool, len(lps))
+	for _, lp := range lps {
+		lpsByName[lp.Name] = lp
+	}
+	for _, clp := range c.LiquidityPools {
+		lp, ok := lpsByName[clp.Name]
+		action := CatalogActionUpdate
+		if !ok {
+			lp = &dmodels.LiquidityPool{ID: uuid.NewV4(), Name: clp.Name}
+			action = CatalogActionCreate
+		}
+		before := (&smodels.AdminLiquidityPool{}).Set(lp)
+		lp.About = clp.About
+		lp.Image = orDefault(clp.Image, "null")
+		lp.URL = orDefault(clp.URL, "null")
+		after := (&smodels.AdminLiquidityPool{}).Set(lp)
+
+		if err := s.syncEntity(apply, lp, dmodels.AuditEntityLiquidityPool, lp.ID, clp.Name, action, before, after); err != nil {
+			return changes, err
+		}
+	}
+
+	pools, err := s.DAO.GetPools(nil)
+	if err != nil {
+		return changes, fmt.Errorf("DAO.GetPools: %w", err)
+	}
+	poolsByName := make(map[string]*dmodels.Pool, len(pools))
+	for _, pool := range pools {
+		poolsByName[pool.Name] = pool
+	}
+	networks := c.Networks()
+	declared := make(map[string]bool, len(c.Pools))
+	for _, cp := range c.Pools {
+		declared[cp.Name] = true
+		pool, ok := poolsByName[cp.Name]
+		action := CatalogActionUpdate
+		if !ok {
+			pool = &dmodels.Pool{ID: uuid.NewV4(), Name: cp.Name}
+			action = CatalogActionCreate
+		}
+		before := (&smodels.AdminPool{}).Set(pool, coinNames[pool.CoinID])
+		pool.Active = cp.IsActive()
+		pool.CoinID = coinsByName[cp.Coin].ID
+		pool.Address = cp.Address
+		pool.Network = cp.Network
+		pool.Program = cp.Program
+		pool.Image = orDefault(cp.Image, "Default")
+		after := (&smodels.AdminPool{}).Set(pool, cp.Coin)
+
+		if err := s.syncEntity(apply, pool, dmodels.AuditEntityPool, pool.ID, cp.Name, action, before, after); err != nil {
+			return changes, err
+		}
+	}
+	for _, pool := range pools {
+		if declared[pool.Name] || !pool.Active || !networks[pool.Network] {
+			continue
+		}
+		before := (&smodels.AdminPool{}).Set(pool, coinNames[pool.CoinID])
+		pool.Active = false
+		after := (&smodels.AdminPool{}).Set(pool, coinNames[pool.CoinID])
+
+		if err := s.syncEntity(apply, pool, dmodels.AuditEntityPool, pool.ID, pool.Name, CatalogActionDeactivate, before, after); err != nil {
+			return changes, err
+		}
+	}
+
+	if dryRun || len(entities) == 0 {
+		return changes, nil
+	}
+	if err := s.DAO.ApplyAuditedBatch(entities...); err != nil {
+		return nil, fmt.Errorf("DAO.ApplyAuditedBatch: %w", err)
+	}
+	s.Cache.InvalidatePools()
+	types := make([]string, 0, len(dataTypes))
+	for _, e := range entities {
+		if t := dataTypes[e.Audit.Entity]; !contains(types, t) {
+			types = append(types, t)
+		}
+	}
+	s.touch(types...)
+
+	return changes, nil
+}
+
+// syncEntity applies the entity when it is new or its fields differ from before.
+func (s Imp) syncEntity(apply func(interface{}, *smodels.CatalogChange, *dmodels.AuditLog) error,
+	entity interface{}, entityType string, id uuid.UUID, name string, action string, before, after interface{}) error {
+	fields := diffFields(before, after)
+	if action != CatalogActionCreate && len(fields) == 0 {
+		return nil
+	}
+
+	auditAction, beforeSnapshot := dmodels.AuditActionUpdate, snapshot(before)
+	if action == CatalogActionCreate {
+		auditAction, beforeSnapshot = dmodels.AuditActionCreate, ""
+	}
+	return apply(entity,
+		&smodels.CatalogChange{Entity: entityType, Name: name, Action: action, Fields: fields},
+		newAuditLog(catalogActor, auditAction, entityType, id, beforeSnapshot, snapshot(after)),
+	)
+}
+
+// diffFields lists the exported fields of two values of the same struct type that differ, as "Field: old -> new".
+func diffFields(before, after interface{}) []string {
+	b, a := reflect.Indirect(reflect.ValueOf(before)), reflect.Indirect(reflect.ValueOf(after))
+	var fields []string
+	for i := 0; i < b.NumField(); i++ {
+		name := b.Type().Field(i).Name
+		if name == "ID" {
+			continue
+		}
+		if !reflect.DeepEqual(b.Field(i).Interface(), a.Field(i).Interface()) {
+			fields = append(fields, fmt.Sprintf("%s: %v -> %v", name, b.Field(i).Interface(), a.Field(i).Interface()))
+		}
+	}
+	return fields
+}
@@ -0,0 +1,99 @@
+package services_test
+
+import (
+	"github.com/everstake/solana-pools/internal/catalog"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/cache"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestSyncCatalog(t *testing.T) {
+	c, err := catalog.Parse([]byte(`
+version: 1
+coins:
+  - {name: mSOL, mint: mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So, gecko_key: msol}
+  - {name: stSOL, mint: 7dHbWXmci3dT8UFYWYZweBLXgycu7Y3iL6trKn1Y7ARj, gecko_key: lido-staked-sol}
+pools:
+  - {name: Marinade, program: marinade, address: 8szGkuLTAux9XMgZ2vtY39jVSowEcpBfFfD8hXSEqdGC, network: mainnet, coin: mSOL}
+  - {name: Solido, program: solido, address: 49Yi1TKkNyYjPAFdR9LBvoHcUjuPX4Df5T5yv39w2XTn, network: mainnet, coin: stSOL}
+liquidity_pools:
+  - {name: Raydium, url: https://raydium.io}
+`))
+	assert.NilError(t, err)
+
+	mSOL := &dmodels.Coin{ID: uuid.NewV4(), Name: "mSOL", GeckoKey: "msol", Address: "mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So", ThumbImage: "thumb", SmallImage: "small", LargeImage: "large"}
+	marinade := &dmodels.Pool{ID: uuid.NewV4(), Name: "Marinade", Active: true, CoinID: mSOL.ID, Address: "8szGkuLTAux9XMgZ2vtY39jVSowEcpBfFfD8hXSEqdGC", Network: "mainnet", Image: "Default"}
+	parrot := &dmodels.Pool{ID: uuid.NewV4(), Name: "Parrot", Active: true, CoinID: mSOL.ID, Network: "mainnet", Image: "Default"}
+	testnet := &dmodels.Pool{ID: uuid.NewV4(), Name: "Testnet", Active: true, CoinID: mSOL.ID, Network: "testnet", Image: "Default"}
+	raydium := &dmodels.LiquidityPool{ID: uuid.NewV4(), Name: "Raydium", Image: "null", URL: "https://raydium.io"}
+
+	want := []*smodels.CatalogChange{
+		{Entity: dmodels.AuditEntityCoin, Name: "stSOL", Action: services.CatalogActionCreate, Fields: []string{
+			"GeckoKey:  -> lido-staked-sol", "Address:  -> 7dHbWXmci3dT8UFYWYZweBLXgycu7Y3iL6trKn1Y7ARj",
+		}},
+		{Entity: dmodels.AuditEntityPool, Name: "Marinade", Action: services.CatalogActionUpdate, Fields: []string{"Program:  -> marinade"}},
+		{Entity: dmodels.AuditEntityPool, Name: "Solido", Action: services.CatalogActionCreate, Fields: []string{
+			"Active: false -> true", "Coin:  -> stSOL", "Address:  -> 49Yi1TKkNyYjPAFdR9LBvoHcUjuPX4Df5T5yv39w2XTn",
+			"Network:  -> mainnet", "Program:  -> solido", "Image:  -> Default",
+		}},
+		{Entity: dmodels.AuditEntityPool, Name: "Parrot", Action: services.CatalogActionDeactivate, Fields: []string{"Active: true -> false"}},
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		var applied []*dmodels.AuditLog
+		var batches int
+		var touched []string
+		m, p, r, tn := *mSOL, *marinade, *parrot, *testnet
+		l := *raydium
+		d := services.Imp{
+			Cache: cache.New(time.Hour, time.Hour),
+			DAO: &dao.PostgresMock{
+				GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+					return []*dmodels.Coin{&m}, nil
+				},
+				GetPoolsFunc: func(cond *postgres.PoolCondition) ([]*dmodels.Pool, error) {
+					return []*dmodels.Pool{&p, &r, &tn}, nil
+				},
+				GetLiquidityPoolsFunc: func(cond *postgres.Condition) ([]*dmodels.LiquidityPool, error) {
+					return []*dmodels.LiquidityPool{&l}, nil
+				},
+				ApplyAuditedBatchFunc: func(entities ...postgres.AuditedEntity) error {
+					batches++
+					for _, e := range entities {
+						applied = append(applied, e.Audit)
+					}
+					return nil
+				},
+				TouchDataVersionsFunc: func(types ...string) error {
+					touched = append(touched, types...)
+					return nil
+				},
+			},
+		}
+
+		changes, err := d.SyncCatalog(c, dryRun)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, changes, want)
+		if dryRun {
+			assert.Equal(t, len(applied), 0)
+			continue
+		}
+		assert.Equal(t, batches, 1)
+		assert.Equal(t, len(applied), len(want))
+		assert.Equal(t, applied[0].Action, dmodels.AuditActionCreate)
+		assert.Equal(t, applied[3].Action, dmodels.AuditActionUpdate)
+		assert.Equal(t, applied[3].Actor, "sync-catalog")
+		assert.Equal(t, r.Active, false)
+		assert.Equal(t, tn.Active, true)
+		assert.Equal(t, m.LargeImage, "large")
+		assert.DeepEqual(t, touched, []string{events.TopicCoins, events.TypePool})
+	}
+}
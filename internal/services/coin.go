@@ -5,6 +5,7 @@ import (
 	"github.com/everstake/solana-pools/internal/dao/postgres"
 	"github.com/everstake/solana-pools/internal/services/smodels"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
 func (s Imp) GetPoolCoins(name string, sort string, desc bool, limit uint64, offset uint64) ([]*smodels.Coin, uint64, error) {
@@ -39,6 +40,11 @@ func (s Imp) GetPoolCoins(name string, sort string, desc bool, limit uint64, off
 		return nil, 0, fmt.Errorf("DAO.GetCoins: %w", err)
 	}
 
+	history, err := s.defiHistory(coins, time.Now())
+	if err != nil {
+		return nil, 0, err
+	}
+
 	scoins := make([]*smodels.Coin, len(coins))
 	for i, coin := range coins {
 		dEfI, err := s.DAO.GetDEFIs(&postgres.DeFiCondition{
@@ -47,6 +53,7 @@ func (s Imp) GetPoolCoins(name string, sort string, desc bool, limit uint64, off
 		if err != nil {
 			return nil, 0, fmt.Errorf("DAO.GetDEFIs: %w", err)
 		}
+		now := time.Now()
 		defi := make([]*smodels.DeFi, len(dEfI))
 		for i2, d := range dEfI {
 			lp, err := s.DAO.GetLiquidityPool(&postgres.Condition{IDs: []uuid.UUID{d.LiquidityPoolID}})
@@ -57,10 +64,11 @@ func (s Imp) GetPoolCoins(name string, sort string, desc bool, limit uint64, off
 			if err != nil {
 				return nil, 0, fmt.Errorf("DAO.GetCoinByID: %w", err)
 			}
-			defi[i2] = (&smodels.DeFi{}).Set(d, (&smodels.Coin{}).Set(coin, nil), (&smodels.LiquidityPool{}).Set(lp))
+			defi[i2] = (&smodels.DeFi{}).Set(d, (&smodels.Coin{}).Set(coin, nil).MarkStale(s.priceStaleAfter(), now), (&smodels.LiquidityPool{}).Set(lp))
+			defi[i2].Trends = defiTrends(d, history[defiPair{liquidityPoolID: d.LiquidityPoolID, saleCoinID: d.SaleCoinID, buyCoinID: d.BuyCoinID}], now)
 		}
 
-		scoins[i] = (&smodels.Coin{}).Set(coin, defi)
+		scoins[i] = (&smodels.Coin{}).Set(coin, defi).MarkStale(s.priceStaleAfter(), now)
 	}
 
 	count, err := s.DAO.GetCoinsCount(&postgres.CoinCondition{
@@ -87,9 +95,10 @@ func (s Imp) GetCoins(name string, limit uint64, offset uint64) ([]*smodels.Coin
 		return nil, 0, fmt.Errorf("DAO.GetCoins: %w", err)
 	}
 
+	now := time.Now()
 	scoins := make([]*smodels.Coin, len(coins))
 	for i, coin := range coins {
-		scoins[i] = (&smodels.Coin{}).Set(coin, nil)
+		scoins[i] = (&smodels.Coin{}).Set(coin, nil).MarkStale(s.priceStaleAfter(), now)
 	}
 
 	count, err := s.DAO.GetCoinsCount(&postgres.CoinCondition{
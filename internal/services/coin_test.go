@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm"
 	"gotest.tools/assert"
 	"testing"
+	"time"
 )
 
 var poolArr = []*dmodels.Pool{
@@ -186,6 +187,9 @@ func TestGetPoolCoins(t *testing.T) {
 						}
 						return coinArr[:1], nil
 					},
+					GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+						return nil, nil
+					},
 					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 						if cond.SaleCoinID[0] != coinArr[0].ID {
 							return nil, fmt.Errorf("cond.SaleCoinID[0] != coinArr[0].ID, cond.SaleCoinID[0] = %s", cond.SaleCoinID[0])
@@ -262,6 +266,9 @@ func TestGetPoolCoins(t *testing.T) {
 						}
 						return nil, nil
 					},
+					GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+						return nil, nil
+					},
 					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 						return nil, nil
 					},
@@ -329,6 +336,9 @@ func TestGetPoolCoins(t *testing.T) {
 						}
 						return nil, gorm.ErrRecordNotFound
 					},
+					GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+						return nil, nil
+					},
 					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 						if cond.SaleCoinID[0] != coinArr[0].ID {
 							return nil, fmt.Errorf("cond.SaleCoinID[0] != coinArr[0].ID, cond.SaleCoinID[0] = %s", cond.SaleCoinID[0])
@@ -405,6 +415,9 @@ func TestGetPoolCoins(t *testing.T) {
 						}
 						return coinArr[:1], nil
 					},
+					GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+						return nil, nil
+					},
 					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 						return nil, gorm.ErrRecordNotFound
 					},
@@ -454,6 +467,9 @@ func TestGetPoolCoins(t *testing.T) {
 						}
 						return coinArr[:1], nil
 					},
+					GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+						return nil, nil
+					},
 					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 						if cond.SaleCoinID[0] != coinArr[0].ID {
 							return nil, fmt.Errorf("cond.SaleCoinID[0] != coinArr[0].ID, cond.SaleCoinID[0] = %s", cond.SaleCoinID[0])
@@ -509,6 +525,9 @@ func TestGetPoolCoins(t *testing.T) {
 						}
 						return coinArr[:1], nil
 					},
+					GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+						return nil, nil
+					},
 					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 						if cond.SaleCoinID[0] != coinArr[0].ID {
 							return nil, fmt.Errorf("cond.SaleCoinID[0] != coinArr[0].ID, cond.SaleCoinID[0] = %s", cond.SaleCoinID[0])
@@ -567,6 +586,9 @@ func TestGetPoolCoins(t *testing.T) {
 						}
 						return coinArr[:1], nil
 					},
+					GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+						return nil, nil
+					},
 					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
 						if cond.SaleCoinID[0] != coinArr[0].ID {
 							return nil, fmt.Errorf("cond.SaleCoinID[0] != coinArr[0].ID, cond.SaleCoinID[0] = %s", cond.SaleCoinID[0])
@@ -726,3 +748,88 @@ func TestGetCoins(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPoolCoinsTrends(t *testing.T) {
+	now := time.Now()
+	current := &dmodels.DEFI{
+		LiquidityPoolID: LPArr[0].ID,
+		SaleCoinID:      coinArr[0].ID,
+		BuyCoinID:       coinArr[0].ID,
+		Liquidity:       100,
+		Volume24H:       25,
+		APY:             decimal.NewFromFloat(0.4),
+	}
+	pair := dmodels.DEFIHistory{LiquidityPoolID: current.LiquidityPoolID, SaleCoinID: current.SaleCoinID, BuyCoinID: current.BuyCoinID}
+	other := pair
+	other.BuyCoinID = coinArr[1].ID
+	history := make([]*dmodels.DEFIHistory, 0, 4)
+	for _, h := range []struct {
+		pair      dmodels.DEFIHistory
+		liquidity float64
+		apy       float64
+		days      int
+	}{
+		{pair, 40, 0.1, -20},
+		{other, 1000, 0.9, -10},
+		{pair, 60, 0.2, -5},
+		{pair, 80, 0.3, -1},
+	} {
+		row := h.pair
+		row.Liquidity, row.APY, row.CreatedAt = h.liquidity, decimal.NewFromFloat(h.apy), now.AddDate(0, 0, h.days)
+		history = append(history, &row)
+	}
+
+	s := services.Imp{
+		DAO: &dao.PostgresMock{
+			GetPoolsFunc: func(condition *postgres.PoolCondition) ([]*dmodels.Pool, error) {
+				return poolArr[:1], nil
+			},
+			GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+				return coinArr[:1], nil
+			},
+			GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
+				return []*dmodels.DEFI{current}, nil
+			},
+			GetDEFIHistoryFunc: func(cond *postgres.DeFiHistoryCondition) ([]*dmodels.DEFIHistory, error) {
+				if len(cond.SaleCoinID) != 1 || cond.SaleCoinID[0] != coinArr[0].ID || len(cond.LiquidityPoolIDs) != 0 || len(cond.BuyCoinID) != 0 {
+					return nil, fmt.Errorf("unexpected condition %v", cond.DeFiCondition)
+				}
+				if d := cond.From.Sub(now.AddDate(0, 0, -30)); d < 0 || d > time.Minute {
+					return nil, fmt.Errorf("cond.From = %s is not 30 days ago", cond.From)
+				}
+				return history, nil
+			},
+			GetLiquidityPoolFunc: func(cond *postgres.Condition) (*dmodels.LiquidityPool, error) {
+				return &LPArr[0], nil
+			},
+			GetCoinByIDFunc: func(id uuid.UUID) (*dmodels.Coin, error) {
+				return coinArr[0], nil
+			},
+			GetCoinsCountFunc: func(cond *postgres.CoinCondition) (int64, error) {
+				return 1, nil
+			},
+		},
+	}
+
+	coins, _, err := s.GetPoolCoins("", "price", true, 10, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, len(coins), 1)
+	assert.Equal(t, len(coins[0].DeFi), 1)
+	assert.Equal(t, coins[0].DeFi[0].Volume24H, float64(25))
+
+	expected := []*smodels.DeFiTrend{
+		{Days: 7, AVGAPY: decimal.NewFromFloat(0.25), AVGLiquidity: 70, APYChange: decimal.NewFromFloat(0.2), LiquidityChange: 40},
+		{Days: 30, AVGAPY: decimal.NewFromFloat(0.2), AVGLiquidity: 60, APYChange: decimal.NewFromFloat(0.3), LiquidityChange: 60},
+	}
+	trends := coins[0].DeFi[0].Trends
+	assert.Equal(t, len(trends), len(expected))
+	for i, trend := range trends {
+		t.Run(fmt.Sprintf("trends[%d]", i), func(t *testing.T) {
+			assert.Equal(t, trend.Days, expected[i].Days)
+			assert.Assert(t, trend.AVGAPY.Equal(expected[i].AVGAPY), trend.AVGAPY.String())
+			assert.Equal(t, trend.AVGLiquidity, expected[i].AVGLiquidity)
+			assert.Assert(t, trend.APYChange.Equal(expected[i].APYChange), trend.APYChange.String())
+			assert.Equal(t, trend.LiquidityChange, expected[i].LiquidityChange)
+		})
+	}
+}
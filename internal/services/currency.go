@@ -0,0 +1,110 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/superoo7/go-gecko/v3/types"
+	"strings"
+	"time"
+)
+
+const baseCurrency = "usd"
+
+var (
+	defaultCurrencies = []string{"usd", "eur", "gbp", "jpy", "cny", "krw"}
+
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	// ErrNoCurrencyRates is returned for a supported currency before its rates were synced for the first time.
+	ErrNoCurrencyRates = errors.New("currency rates are not synced yet")
+)
+
+// ExchangeRateSource quotes the exchange rates of fiat currencies in a common unit, like the CoinGecko client.
+type ExchangeRateSource interface {
+	ExchangeRates() (*types.ExchangeRatesItem, error)
+}
+
+func (s Imp) currencies() []string {
+	if len(s.cfg.Currencies) == 0 {
+		return defaultCurrencies
+	}
+	return s.cfg.Currencies
+}
+
+// UpdateCurrencyRates saves how many units of every configured currency one USD buys. CoinGecko quotes
+// its exchange rates in BTC, so they are divided by the BTC price in USD.
+func (s Imp) UpdateCurrencyRates() error {
+	rates, err := s.ExchangeRates.ExchangeRates()
+	if err != nil {
+		return fmt.Errorf("ExchangeRates.ExchangeRates: %w", err)
+	}
+	usd, ok := (*rates)[baseCurrency]
+	if !ok || usd.Value == 0 {
+		return fmt.Errorf("UpdateCurrencyRates: %w", errors.New("usd rate not found"))
+	}
+
+	now := time.Now()
+	result := make([]*dmodels.CurrencyRate, 0, len(s.currencies()))
+	for _, currency := range s.currencies() {
+		currency = strings.ToLower(currency)
+		if currency == baseCurrency {
+			continue
+		}
+		r, ok := (*rates)[currency]
+		if !ok {
+			continue
+		}
+		result = append(result, &dmodels.CurrencyRate{
+			Currency:  currency,
+			Rate:      r.Value / usd.Value,
+			CreatedAt: now,
+		})
+	}
+
+	if err := s.DAO.CreateCurrencyRates(result...); err != nil {
+		return fmt.Errorf("DAO.CreateCurrencyRates: %w", err)
+	}
+	s.touch(DataCurrencyRates)
+	return nil
+}
+
+// GetCurrencyRate returns how many units of currency one USD bought at the given time.
+// Times before the first saved rate use the current rate.
+func (s Imp) GetCurrencyRate(currency string, at time.Time) (float64, error) {
+	rates, err := s.GetCurrencyRates(currency, at, at)
+	if err != nil {
+		return 0, err
+	}
+	return rates.At(at), nil
+}
+
+// GetCurrencyRates returns the rates converting USD into currency between from and to, read at once.
+func (s Imp) GetCurrencyRates(currency string, from time.Time, to time.Time) (smodels.CurrencyRates, error) {
+	currency = strings.ToLower(currency)
+	if currency == "" || currency == baseCurrency {
+		return smodels.CurrencyRates{Currency: baseCurrency, Current: 1}, nil
+	}
+	supported := false
+	for _, c := range s.currencies() {
+		if strings.ToLower(c) == currency {
+			supported = true
+		}
+	}
+	if !supported {
+		return smodels.CurrencyRates{}, fmt.Errorf("%s: %w", currency, ErrUnsupportedCurrency)
+	}
+
+	current, err := s.DAO.GetCurrencyRateAt(currency, time.Now())
+	if err != nil {
+		return smodels.CurrencyRates{}, fmt.Errorf("DAO.GetCurrencyRateAt: %w", err)
+	}
+	if current == nil {
+		return smodels.CurrencyRates{}, fmt.Errorf("%s: %w", currency, ErrNoCurrencyRates)
+	}
+	rates, err := s.DAO.GetCurrencyRatesBetween(currency, from, to)
+	if err != nil {
+		return smodels.CurrencyRates{}, fmt.Errorf("DAO.GetCurrencyRatesBetween: %w", err)
+	}
+	return smodels.CurrencyRates{Currency: currency, Rates: rates, Current: current.Rate}, nil
+}
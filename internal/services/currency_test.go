@@ -0,0 +1,151 @@
+package services_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/superoo7/go-gecko/v3/types"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestGetCurrencyRate(t *testing.T) {
+	first := time.Now().AddDate(0, 0, -10)
+	rates := []*dmodels.CurrencyRate{
+		{Currency: "eur", Rate: 0.91, CreatedAt: first},
+		{Currency: "eur", Rate: 0.93, CreatedAt: first.AddDate(0, 0, 5)},
+	}
+
+	data := map[string]struct {
+		Currency string
+		At       time.Time
+		Result   float64
+		Err      error
+	}{
+		"usd":                 {Currency: "usd", At: time.Now(), Result: 1},
+		"empty is usd":        {Currency: "", At: time.Now(), Result: 1},
+		"latest":              {Currency: "EUR", At: time.Now(), Result: 0.93},
+		"at time":             {Currency: "eur", At: first.AddDate(0, 0, 2), Result: 0.91},
+		"before first rate":   {Currency: "eur", At: first.AddDate(0, 0, -1), Result: 0.93},
+		"unsupported":         {Currency: "xyz", At: time.Now(), Err: fmt.Errorf("xyz: %w", services.ErrUnsupportedCurrency)},
+		"supported, no rates": {Currency: "gbp", At: time.Now(), Err: fmt.Errorf("gbp: %w", services.ErrNoCurrencyRates)},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			d := services.Imp{
+				DAO: &dao.PostgresMock{
+					GetCurrencyRateAtFunc: func(currency string, at time.Time) (*dmodels.CurrencyRate, error) {
+						var rate *dmodels.CurrencyRate
+						for _, r := range rates {
+							if r.Currency == currency && !r.CreatedAt.After(at) {
+								rate = r
+							}
+						}
+						return rate, nil
+					},
+					GetCurrencyRatesBetweenFunc: func(currency string, from time.Time, to time.Time) ([]*dmodels.CurrencyRate, error) {
+						var result []*dmodels.CurrencyRate
+						var before *dmodels.CurrencyRate
+						for _, r := range rates {
+							switch {
+							case r.Currency != currency:
+							case r.CreatedAt.Before(from):
+								before = r
+							case !r.CreatedAt.After(to):
+								result = append(result, r)
+							}
+						}
+						if before != nil {
+							result = append([]*dmodels.CurrencyRate{before}, result...)
+						}
+						return result, nil
+					},
+				},
+			}
+
+			rate, err := d.GetCurrencyRate(s2.Currency, s2.At)
+			if s2.Err != nil {
+				assert.Error(t, err, s2.Err.Error())
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, rate, s2.Result)
+		})
+	}
+}
+
+type exchangeRatesMock struct {
+	rates types.ExchangeRatesItem
+	err   error
+}
+
+func (m exchangeRatesMock) ExchangeRates() (*types.ExchangeRatesItem, error) {
+	return &m.rates, m.err
+}
+
+func TestUpdateCurrencyRates(t *testing.T) {
+	// CoinGecko quotes the rates in BTC
+	btc := types.ExchangeRatesItem{
+		"btc": {Value: 1},
+		"usd": {Value: 20000},
+		"eur": {Value: 18000},
+		"jpy": {Value: 2800000},
+	}
+
+	data := map[string]struct {
+		Rates  types.ExchangeRatesItem
+		Err    error
+		Result map[string]float64
+		ErrMsg string
+	}{
+		"configured currencies": {Rates: btc, Result: map[string]float64{"eur": 0.9, "jpy": 140}},
+		"no usd rate": {
+			Rates:  types.ExchangeRatesItem{"eur": {Value: 18000}},
+			ErrMsg: "UpdateCurrencyRates: usd rate not found",
+		},
+		"source error": {
+			Err:    errors.New("timeout"),
+			ErrMsg: "ExchangeRates.ExchangeRates: timeout",
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			var saved []*dmodels.CurrencyRate
+			var touched []string
+			d := services.Imp{
+				ExchangeRates: exchangeRatesMock{rates: s2.Rates, err: s2.Err},
+				DAO: &dao.PostgresMock{
+					CreateCurrencyRatesFunc: func(rates ...*dmodels.CurrencyRate) error {
+						saved = rates
+						return nil
+					},
+					TouchDataVersionsFunc: func(types ...string) error {
+						touched = append(touched, types...)
+						return nil
+					},
+				},
+			}
+
+			err := d.UpdateCurrencyRates()
+			if s2.ErrMsg != "" {
+				assert.Error(t, err, s2.ErrMsg)
+				assert.Equal(t, len(saved), 0)
+				assert.Equal(t, len(touched), 0)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, touched, []string{services.DataCurrencyRates})
+			result := make(map[string]float64, len(saved))
+			for _, r := range saved {
+				result[r.Currency] = r.Rate
+				assert.Assert(t, !r.CreatedAt.IsZero())
+			}
+			assert.DeepEqual(t, result, s2.Result)
+		})
+	}
+}
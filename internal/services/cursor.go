@@ -0,0 +1,127 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// cursor pins a listing to the snapshot of its first page and the row it continues from; the listing, sort, epoch
+// aggregation and the hash of the other query parameters must stay the same between pages.
+type cursor struct {
+	Listing    string `json:"l"`
+	Sort       string `json:"s"`
+	Desc       bool   `json:"d,omitempty"`
+	Epoch      uint64 `json:"e"`
+	Query      string `json:"q,omitempty"`
+	Snapshot   uint64 `json:"n,omitempty"`
+	At         int64  `json:"t"`
+	PoolDataID string `json:"p,omitempty"`
+	Value      string `json:"v,omitempty"`
+	ID         string `json:"i,omitempty"`
+	Before     bool   `json:"b,omitempty"`
+}
+
+// openCursor returns the cursor of the requested page of the listing c, starting a new snapshot without one.
+func openCursor(req smodels.PageRequest, c cursor) (cursor, error) {
+	if req.Cursor == "" {
+		c.Snapshot, c.At = req.SnapshotEpoch, time.Now().UnixNano()
+		return c, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(req.Cursor)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	var decoded cursor
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded.ID == "" {
+		return c, ErrInvalidCursor
+	}
+	if decoded.Listing != c.Listing || decoded.Sort != c.Sort || decoded.Desc != c.Desc || decoded.Epoch != c.Epoch ||
+		decoded.Query != c.Query {
+		return c, ErrInvalidCursor
+	}
+	return decoded, nil
+}
+
+// queryHash identifies the filtering parameters of a listing, short enough to ride along in every cursor.
+func queryHash(params ...interface{}) string {
+	b, _ := json.Marshal(params)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// cursorError turns the DAO rejecting the keyset of a tampered cursor into ErrInvalidCursor.
+func cursorError(method string, err error) error {
+	if errors.Is(err, postgres.ErrorInvalidKeyset) {
+		return ErrInvalidCursor
+	}
+	return fmt.Errorf("%s: %w", method, err)
+}
+
+// queryLimit reads one row over the page size to tell whether a next page follows; zero reads all rows.
+func queryLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return 0
+	}
+	return limit + 1
+}
+
+func (c cursor) snapshot() *postgres.Snapshot {
+	return &postgres.Snapshot{Epoch: c.Snapshot, At: time.Unix(0, c.At)}
+}
+
+func (c cursor) keyset() *postgres.Keyset {
+	if c.ID == "" {
+		return nil
+	}
+	return &postgres.Keyset{Value: c.Value, ID: c.ID, Before: c.Before}
+}
+
+func (c cursor) String() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// rowKey is the sort value and validator id of a listed row.
+type rowKey struct {
+	Value string
+	ID    string
+}
+
+// paginate takes the keys of the rows read for cursor c, in the order of the query and at most one over the
+// limit, and returns how many of the rows belong to the page and its cursors. Rows read before the cursor come in
+// reverse, the caller puts them back in order. A zero limit puts every row on the page.
+func paginate(c cursor, keys []rowKey, limit uint64) (int, *smodels.Page) {
+	more := limit > 0 && uint64(len(keys)) > limit
+	if more {
+		keys = keys[:limit]
+	}
+	page := &smodels.Page{}
+	if len(keys) == 0 {
+		return 0, page
+	}
+
+	first, last := keys[0], keys[len(keys)-1]
+	if c.Before {
+		first, last = last, first
+	}
+	if more || c.Before {
+		next := c
+		next.Value, next.ID, next.Before = last.Value, last.ID, false
+		page.Next = next.String()
+	}
+	if more && c.Before || !c.Before && c.ID != "" {
+		prev := c
+		prev.Value, prev.ID, prev.Before = first.Value, first.ID, true
+		page.Prev = prev.String()
+	}
+	return len(keys), page
+}
@@ -0,0 +1,147 @@
+package services_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+// keysetValidators lists the validators by apy and id descending like the DAO does for snapshot listings.
+func keysetValidators(all []*dmodels.ValidatorView, snapshots map[time.Time]bool) func(*postgres.ValidatorCondition, uint64) ([]*dmodels.ValidatorView, error) {
+	return func(cond *postgres.ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error) {
+		if cond.Snapshot == nil {
+			return nil, errors.New("no snapshot")
+		}
+		snapshots[cond.Snapshot.At] = true
+		if k := cond.Keyset; k != nil {
+			if _, err := decimal.NewFromString(k.Value); err != nil {
+				return nil, fmt.Errorf("%w: %s", postgres.ErrorInvalidKeyset, k.Value)
+			}
+		}
+
+		less := func(v *dmodels.ValidatorView, apy decimal.Decimal, id string) bool {
+			return v.APY.LessThan(apy) || v.APY.Equal(apy) && v.ID < id
+		}
+		var arr []*dmodels.ValidatorView
+		if k := cond.Keyset; k != nil && k.Before {
+			apy := decimal.RequireFromString(k.Value)
+			for i := len(all) - 1; i >= 0; i-- {
+				if less(&dmodels.ValidatorView{APY: apy, ID: k.ID}, all[i].APY, all[i].ID) {
+					arr = append(arr, all[i])
+				}
+			}
+		} else {
+			for _, v := range all {
+				if k == nil || less(v, decimal.RequireFromString(k.Value), k.ID) {
+					arr = append(arr, v)
+				}
+			}
+		}
+		if cond.Limit > 0 && uint64(len(arr)) > cond.Limit {
+			arr = arr[:cond.Limit]
+		}
+		return arr, nil
+	}
+}
+
+func TestGetValidatorsPage(t *testing.T) {
+	all := []*dmodels.ValidatorView{
+		{ID: "e", APY: decimal.NewFromFloat(7.5)},
+		{ID: "d", APY: decimal.NewFromFloat(7.5)},
+		{ID: "c", APY: decimal.NewFromFloat(6.25)},
+		{ID: "b", APY: decimal.NewFromFloat(6)},
+		{ID: "a", APY: decimal.NewFromFloat(5)},
+	}
+	snapshots := make(map[time.Time]bool)
+	s := services.Imp{DAO: &dao.PostgresMock{
+		GetValidatorsFunc: keysetValidators(all, snapshots),
+		GetValidatorCountFunc: func(cond *postgres.ValidatorCondition, epoch uint64) (int64, error) {
+			return int64(len(all)), nil
+		},
+	}}
+	ids := func(arr []*smodels.Validator) (s string) {
+		for _, v := range arr {
+			s += v.VotePK
+		}
+		return s
+	}
+
+	var pages []string
+	var page *smodels.Page
+	req := smodels.PageRequest{Limit: 2}
+	for {
+		arr, p, err := s.GetValidatorsPage("", "apy", true, 10, nil, smodels.ValidatorFilter{}, req)
+		assert.NilError(t, err)
+		assert.Equal(t, p.Total, uint64(5))
+		assert.Equal(t, p.Prev == "", len(pages) == 0)
+		pages, page = append(pages, ids(arr)), p
+		if p.Next == "" {
+			break
+		}
+		req.Cursor = p.Next
+	}
+	assert.DeepEqual(t, pages, []string{"ed", "cb", "a"})
+	assert.Equal(t, len(snapshots), 1, "every page is read from the first snapshot")
+
+	req.Cursor = page.Prev
+	arr, page, err := s.GetValidatorsPage("", "apy", true, 10, nil, smodels.ValidatorFilter{}, req)
+	assert.NilError(t, err)
+	assert.Equal(t, ids(arr), "cb")
+	req.Cursor = page.Prev
+	arr, page, err = s.GetValidatorsPage("", "apy", true, 10, nil, smodels.ValidatorFilter{}, req)
+	assert.NilError(t, err)
+	assert.Equal(t, ids(arr), "ed")
+	assert.Equal(t, page.Prev, "")
+	assert.Assert(t, page.Next != "")
+
+	arr, page, err = s.GetValidatorsPage("", "apy", true, 10, nil, smodels.ValidatorFilter{}, smodels.PageRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, ids(arr), "edcba", "a zero limit lists all")
+	assert.Equal(t, page.Next, "")
+
+	delinquent := false
+	next := func(c map[string]interface{}) string {
+		b, _ := base64.RawURLEncoding.DecodeString(page.Next)
+		var m map[string]interface{}
+		assert.NilError(t, json.Unmarshal(b, &m))
+		for k, v := range c {
+			m[k] = v
+		}
+		b, _ = json.Marshal(m)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	_, page, err = s.GetValidatorsPage("", "apy", true, 10, nil, smodels.ValidatorFilter{}, smodels.PageRequest{Limit: 2})
+	assert.NilError(t, err)
+
+	data := map[string]struct {
+		Cursor string
+		Sort   string
+		Name   string
+		Epochs []uint64
+		Filter smodels.ValidatorFilter
+	}{
+		"garbage":        {Cursor: "not a cursor", Sort: "apy"},
+		"empty json":     {Cursor: "e30", Sort: "apy"},
+		"other sort":     {Cursor: page.Next, Sort: "fee"},
+		"other name":     {Cursor: page.Next, Sort: "apy", Name: "ever"},
+		"other epochs":   {Cursor: page.Next, Sort: "apy", Epochs: []uint64{300}},
+		"other filter":   {Cursor: page.Next, Sort: "apy", Filter: smodels.ValidatorFilter{Delinquent: &delinquent}},
+		"tampered value": {Cursor: next(map[string]interface{}{"v": "1; drop table validators"}), Sort: "apy"},
+	}
+	for name, d := range data {
+		_, _, err := s.GetValidatorsPage(d.Name, d.Sort, true, 10, d.Epochs, d.Filter, smodels.PageRequest{Cursor: d.Cursor, Limit: 2})
+		assert.Assert(t, errors.Is(err, services.ErrInvalidCursor), name)
+	}
+	_, _, err = s.GetPoolValidatorsPage("pool1", "", "apy", true, 10, smodels.ValidatorFilter{}, smodels.PageRequest{Cursor: page.Next, Limit: 2})
+	assert.Assert(t, errors.Is(err, services.ErrInvalidCursor), "cursor of another listing")
+}
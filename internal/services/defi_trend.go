@@ -0,0 +1,83 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+var defiTrendDays = []uint64{7, 30}
+
+// defiTrends summarizes the pair history (oldest first) over each window ending at now.
+// Changes are measured from the oldest snapshot within the window to the current row.
+func defiTrends(current *dmodels.DEFI, history []*dmodels.DEFIHistory, now time.Time) []*smodels.DeFiTrend {
+	if len(history) == 0 {
+		return nil
+	}
+
+	trends := make([]*smodels.DeFiTrend, 0, len(defiTrendDays))
+	for _, days := range defiTrendDays {
+		from := now.AddDate(0, 0, -int(days))
+		var first *dmodels.DEFIHistory
+		var count int64
+		sumAPY := decimal.Zero
+		var sumLiquidity float64
+		for _, h := range history {
+			if h.CreatedAt.Before(from) {
+				continue
+			}
+			if first == nil {
+				first = h
+			}
+			count++
+			sumAPY = sumAPY.Add(h.APY)
+			sumLiquidity += h.Liquidity
+		}
+		if first == nil {
+			continue
+		}
+		trends = append(trends, &smodels.DeFiTrend{
+			Days:            days,
+			AVGAPY:          sumAPY.Div(decimal.NewFromInt(count)),
+			AVGLiquidity:    sumLiquidity / float64(count),
+			APYChange:       current.APY.Sub(first.APY),
+			LiquidityChange: current.Liquidity - first.Liquidity,
+		})
+	}
+	return trends
+}
+
+// defiPair identifies the history of one pair of a liquidity pool.
+type defiPair struct {
+	liquidityPoolID uuid.UUID
+	saleCoinID      uuid.UUID
+	buyCoinID       uuid.UUID
+}
+
+// defiHistory loads the trend history of every pair selling the coins in one query, grouped by pair.
+func (s Imp) defiHistory(coins []*dmodels.Coin, now time.Time) (map[defiPair][]*dmodels.DEFIHistory, error) {
+	pairs := make(map[defiPair][]*dmodels.DEFIHistory)
+	if len(coins) == 0 {
+		return pairs, nil
+	}
+	ids := make([]uuid.UUID, len(coins))
+	for i, c := range coins {
+		ids[i] = c.ID
+	}
+	history, err := s.DAO.GetDEFIHistory(&postgres.DeFiHistoryCondition{
+		DeFiCondition: postgres.DeFiCondition{SaleCoinID: ids},
+		From:          now.AddDate(0, 0, -int(defiTrendDays[len(defiTrendDays)-1])),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetDEFIHistory: %w", err)
+	}
+	for _, h := range history {
+		key := defiPair{liquidityPoolID: h.LiquidityPoolID, saleCoinID: h.SaleCoinID, buyCoinID: h.BuyCoinID}
+		pairs[key] = append(pairs[key], h)
+	}
+	return pairs, nil
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"go.uber.org/zap"
+	"time"
+)
+
+// Versioned data without a topic of its own; the other data is versioned by its topic type.
+const (
+	DataGovernance    = "governance"
+	DataCurrencyRates = "currency-rates"
+	DataPegs          = "pegs"
+	DataPrices        = "prices"
+)
+
+// Subscribe returns the events published by the update jobs; call the returned function to stop receiving them.
+func (s Imp) Subscribe(buffer int, filters ...string) (<-chan events.Event, func()) {
+	return s.Events.Subscribe(buffer, filters...)
+}
+
+// EventsSince returns the recent events published after id and whether none were dropped in between.
+func (s Imp) EventsSince(id uint64) ([]events.Event, bool) {
+	return s.Events.Since(id)
+}
+
+// DataVersion returns the version of the data of the types and when it last changed, both zero before any change
+// was recorded. It is read from the database, so it survives restarts and is the same on every instance.
+func (s Imp) DataVersion(types ...string) (uint64, time.Time, error) {
+	changed, err := s.DAO.GetDataVersion(types)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("DAO.GetDataVersion: %w", err)
+	}
+	if changed.IsZero() {
+		return 0, changed, nil
+	}
+	return uint64(changed.UnixNano()), changed, nil
+}
+
+// touch records a change of the data of the types. The change is already saved, so a failure is only logged and
+// leaves clients with the previous version until the next change.
+func (s Imp) touch(types ...string) {
+	if err := s.DAO.TouchDataVersions(types...); err != nil {
+		s.log.Warn("DAO.TouchDataVersions", zap.Strings("types", types), zap.Error(err))
+	}
+}
+
+// delinquencyAlerts compares the validators about to be saved with their stored delinquent flags and returns an alert
+// for every validator whose delinquency changed; validators seen for the first time are only reported when delinquent.
+func delinquencyAlerts(was map[string]bool, validators []*dmodels.Validator, now time.Time) []*smodels.DelinquencyAlert {
+	var alerts []*smodels.DelinquencyAlert
+	for _, v := range validators {
+		delinquent, ok := was[v.ID]
+		if (ok && delinquent != v.Delinquent) || (!ok && v.Delinquent) {
+			alerts = append(alerts, &smodels.DelinquencyAlert{
+				VotePK:     v.ID,
+				Name:       v.Name,
+				Delinquent: v.Delinquent,
+				CreatedAt:  now,
+			})
+		}
+	}
+	return alerts
+}
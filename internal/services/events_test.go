@@ -0,0 +1,53 @@
+package services_test
+
+import (
+	"errors"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestDataVersion(t *testing.T) {
+	changed := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	data := map[string]struct {
+		Changed time.Time
+		Err     error
+		Version uint64
+		ErrMsg  string
+	}{
+		"changed": {
+			Changed: changed,
+			Version: uint64(changed.UnixNano()),
+		},
+		"never changed": {},
+		"dao error": {
+			Err:    errors.New("connection refused"),
+			ErrMsg: "DAO.GetDataVersion: connection refused",
+		},
+	}
+	for name, d := range data {
+		t.Run(name, func(t *testing.T) {
+			var types []string
+			s := services.Imp{DAO: &dao.PostgresMock{
+				GetDataVersionFunc: func(ts []string) (time.Time, error) {
+					types = ts
+					return d.Changed, d.Err
+				},
+			}}
+
+			version, at, err := s.DataVersion(events.TypePool, events.TypeValidator)
+			assert.DeepEqual(t, types, []string{events.TypePool, events.TypeValidator})
+			if d.ErrMsg != "" {
+				assert.Error(t, err, d.ErrMsg)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, version, d.Version)
+			assert.Equal(t, at, d.Changed)
+		})
+	}
+}
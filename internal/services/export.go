@@ -0,0 +1,87 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/export"
+	"io"
+)
+
+// Datasets that can be exported.
+const (
+	ExportPoolData          = "pool-data"
+	ExportValidatorData     = "validator-data"
+	ExportPoolValidatorData = "pool-validator-data"
+)
+
+var ErrUnknownDataset = errors.New("unknown dataset")
+
+// Export writes the rows of dataset matching filter to w in format, one at a time as they are read from the database.
+// Nothing is written when the dataset is unknown.
+func (s Imp) Export(dataset string, format export.Format, filter smodels.ExportFilter, w io.Writer) error {
+	cond := &postgres.ExportCondition{
+		Pools:        filter.Pools,
+		ValidatorIDs: filter.Validators,
+		FromEpoch:    filter.FromEpoch,
+		ToEpoch:      filter.ToEpoch,
+		From:         filter.From,
+		To:           filter.To,
+	}
+
+	var (
+		row  interface{}
+		read func(write func() error) error
+	)
+	switch dataset {
+	case ExportPoolData:
+		r := &smodels.PoolDataExport{}
+		row, read = r, func(write func() error) error {
+			if err := s.DAO.ExportPoolData(cond, func(d *dmodels.PoolDataExport) error {
+				r.Set(d)
+				return write()
+			}); err != nil {
+				return fmt.Errorf("DAO.ExportPoolData: %w", err)
+			}
+			return nil
+		}
+	case ExportValidatorData:
+		r := &smodels.ValidatorDataExport{}
+		row, read = r, func(write func() error) error {
+			if err := s.DAO.ExportValidatorData(cond, func(d *dmodels.ValidatorDataExport) error {
+				r.Set(d)
+				return write()
+			}); err != nil {
+				return fmt.Errorf("DAO.ExportValidatorData: %w", err)
+			}
+			return nil
+		}
+	case ExportPoolValidatorData:
+		r := &smodels.PoolValidatorDataExport{}
+		row, read = r, func(write func() error) error {
+			if err := s.DAO.ExportPoolValidatorData(cond, func(d *dmodels.PoolValidatorDataExport) error {
+				r.Set(d)
+				return write()
+			}); err != nil {
+				return fmt.Errorf("DAO.ExportPoolValidatorData: %w", err)
+			}
+			return nil
+		}
+	default:
+		return fmt.Errorf("Export(%s): %w", dataset, ErrUnknownDataset)
+	}
+
+	ew, err := export.NewWriter(format, w, row)
+	if err != nil {
+		return fmt.Errorf("export.NewWriter: %w", err)
+	}
+	if err := read(func() error { return ew.Write(row) }); err != nil {
+		return err
+	}
+	if err := ew.Close(); err != nil {
+		return fmt.Errorf("Writer.Close: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package services_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/export"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestExport(t *testing.T) {
+	filter := smodels.ExportFilter{Pools: []string{"Eversol"}, FromEpoch: 280, To: time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)}
+	cond := &postgres.ExportCondition{Pools: []string{"Eversol"}, FromEpoch: 280, To: time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC)}
+	mock := &dao.PostgresMock{
+		ExportPoolDataFunc: func(c *postgres.ExportCondition, fn func(*dmodels.PoolDataExport) error) error {
+			assert.DeepEqual(t, c, cond)
+			for _, d := range []*dmodels.PoolDataExport{
+				{Pool: "Eversol", Epoch: 280, ActiveStake: 1500000000000, APY: decimal.NewFromFloat(0.0712), DepossitFee: decimal.NewFromFloat(0.1), CreatedAt: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)},
+				{Pool: "Eversol", Epoch: 281, ActiveStake: 1600000000000, APY: decimal.NewFromFloat(0.0708), CreatedAt: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)},
+			} {
+				if err := fn(d); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		ExportValidatorDataFunc: func(c *postgres.ExportCondition, fn func(*dmodels.ValidatorDataExport) error) error {
+			return errors.New("connection refused")
+		},
+	}
+
+	data := map[string]struct {
+		Dataset string
+		Result  string
+		Err     error
+	}{
+		"pool data": {
+			Dataset: services.ExportPoolData,
+			Result: "pool,epoch,active_stake_lamports,tokens_supply_lamports,total_lamports,apy,unstake_liquidity_lamports,deposit_fee,withdrawal_fee,rewards_fee,created_at\n" +
+				"Eversol,280,1500000000000,0,0,0.0712,0,0.1,0,0,2022-03-01T00:00:00Z\n" +
+				"Eversol,281,1600000000000,0,0,0.0708,0,0,0,0,2022-03-01T12:00:00Z\n",
+		},
+		"unknown dataset": {
+			Dataset: "coins",
+			Err:     fmt.Errorf("Export(coins): %w", services.ErrUnknownDataset),
+		},
+		"dao error": {
+			Dataset: services.ExportValidatorData,
+			Err:     fmt.Errorf("DAO.ExportValidatorData: %w", errors.New("connection refused")),
+		},
+	}
+	for name, d := range data {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := services.Imp{DAO: mock}.Export(d.Dataset, export.CSV, filter, &buf)
+			if d.Err != nil {
+				assert.Error(t, err, d.Err.Error())
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, buf.String(), d.Result)
+		})
+	}
+}
@@ -3,27 +3,53 @@ package services
 import (
 	"errors"
 	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/price"
 	"github.com/shopspring/decimal"
+	"time"
 )
 
+// UpdatePrice caches the USD price of SOL and saves it to the price history of the SOL coin, when it is stored.
 func (s Imp) UpdatePrice() error {
-	coin, err := s.coinGecko.CoinsID("solana",
-		false,
-		false,
-		true,
-		false,
-		false,
-		false)
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return fmt.Errorf("UpdatePrice: DAO.GetCoins: %w", err)
+	}
+	providers, err := s.priceProviders(coins)
 	if err != nil {
 		return fmt.Errorf("UpdatePrice: %w", err)
 	}
 
-	usd, ok := coin.MarketData.CurrentPrice["usd"]
+	now := time.Now()
+	quotes, errs := price.Collect(providers, []price.Asset{{ID: "solana", GeckoKey: "solana", Mint: dex.SOLMint}})
+
+	usd, ok := price.Median(quotes["solana"], s.priceStaleAfter(), now)
 	if !ok {
+		if err := providerErrors(errs); err != nil {
+			return fmt.Errorf("UpdatePrice: %w", err)
+		}
 		return fmt.Errorf("UpdatePrice: %w", errors.New("usd price not found"))
 	}
 
-	s.Cache.SetPrice(decimal.NewFromFloat(usd))
+	s.Cache.SetPrice(decimal.NewFromFloat(usd.USD))
+
+	for _, c := range coins {
+		if dex.NormalizeMint(c.Address) != dex.SOLMint {
+			continue
+		}
+		if err := s.DAO.CreatePriceHistory(&dmodels.PriceHistory{
+			AssetType: dmodels.PriceAssetCoin,
+			AssetID:   c.ID,
+			USD:       usd.USD,
+			Sources:   usd.Source,
+			CreatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("UpdatePrice: DAO.CreatePriceHistory: %w", err)
+		}
+		break
+	}
+	s.touch(DataPrices)
 
 	return nil
 }
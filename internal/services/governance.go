@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/everstake/solana-pools/internal/dao/postgres"
 	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
 func (s Imp) GetGovernance(name string, sort string, desc bool, limit uint64, offset uint64) ([]*smodels.Governance, uint64, error) {
@@ -21,9 +23,10 @@ func (s Imp) GetGovernance(name string, sort string, desc bool, limit uint64, of
 		return nil, 0, fmt.Errorf("DAO.GetCoins: %w", err)
 	}
 
+	now := time.Now()
 	sgov := make([]*smodels.Governance, len(gov))
 	for i, g := range gov {
-		sgov[i] = (&smodels.Governance{}).Set(g)
+		sgov[i] = (&smodels.Governance{}).Set(g).MarkStale(s.priceStaleAfter(), now)
 	}
 
 	count, err := s.DAO.GetGovernanceCount(&postgres.GovernanceCondition{
@@ -37,3 +40,56 @@ func (s Imp) GetGovernance(name string, sort string, desc bool, limit uint64, of
 
 	return sgov, uint64(count), nil
 }
+
+// GetGovernanceHistory returns the supply and USD price snapshots of the governance token.
+func (s Imp) GetGovernanceHistory(name string, aggregate string) ([]*smodels.GovernanceSupply, error) {
+	gov, err := s.DAO.GetGovernance(&postgres.GovernanceCondition{Condition: &postgres.Condition{Names: []string{name}}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetGovernance: %w", err)
+	}
+	if len(gov) == 0 {
+		return nil, fmt.Errorf("DAO.GetGovernance(%s): %w", name, postgres.ErrorRecordNotFounded)
+	}
+
+	history, err := s.DAO.GetGovernanceSupplyHistory(gov[0].ID, postgres.SearchAggregate(aggregate))
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetGovernanceSupplyHistory: %w", err)
+	}
+
+	supply := make([]*smodels.GovernanceSupply, len(history))
+	for i, h := range history {
+		supply[i] = (&smodels.GovernanceSupply{}).Set(h)
+	}
+
+	return supply, nil
+}
+
+// GetGovernancesHistory returns the supply history of every governance token found by name, reading all of them at once.
+func (s Imp) GetGovernancesHistory(names []string, aggregate string) (map[string][]*smodels.GovernanceSupply, error) {
+	supply := make(map[string][]*smodels.GovernanceSupply, len(names))
+	if len(names) == 0 {
+		return supply, nil
+	}
+	gov, err := s.DAO.GetGovernance(&postgres.GovernanceCondition{Condition: &postgres.Condition{Names: names}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetGovernance: %w", err)
+	}
+	if len(gov) == 0 {
+		return supply, nil
+	}
+	ids := make([]uuid.UUID, len(gov))
+	govNames := make(map[uuid.UUID]string, len(gov))
+	for i, g := range gov {
+		ids[i], govNames[g.ID] = g.ID, g.Name
+	}
+
+	history, err := s.DAO.GetGovernanceSuppliesHistory(ids, postgres.SearchAggregate(aggregate))
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetGovernanceSuppliesHistory: %w", err)
+	}
+	for _, h := range history {
+		name := govNames[h.GovernanceID]
+		supply[name] = append(supply[name], (&smodels.GovernanceSupply{}).Set(h))
+	}
+	return supply, nil
+}
@@ -10,6 +10,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"gotest.tools/assert"
 	"testing"
+	"time"
 )
 
 var GArr = []*dmodels.Governance{
@@ -77,6 +78,9 @@ func TestGetGovernance(t *testing.T) {
 					MaximumTokenSupply: 1000000,
 					CirculatingSupply:  1000000,
 					USD:                85,
+					MarketCap:          85000000,
+					FDV:                85000000,
+					FDVBasis:           smodels.FDVBasisMaxSupply,
 				},
 			},
 			Err: nil,
@@ -206,3 +210,55 @@ func TestGetGovernance(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGovernanceHistory(t *testing.T) {
+	now := time.Now()
+	data := map[string]struct {
+		Name   string
+		Result []*smodels.GovernanceSupply
+		Err    error
+	}{
+		"found": {
+			Name: "gov1",
+			Result: []*smodels.GovernanceSupply{
+				{MaximumTokenSupply: 1000000, TotalSupply: 900000, CirculatingSupply: 400000, OnChainSupply: 1000000, Holders: 10, USD: 80, MarketCap: 32000000, FDV: 80000000, FDVBasis: smodels.FDVBasisMaxSupply, CreatedAt: now.AddDate(0, 0, -1)},
+				{TotalSupply: 1000000, CirculatingSupply: 500000, OnChainSupply: 1000000, Holders: 12, USD: 85, MarketCap: 42500000, FDV: 85000000, FDVBasis: smodels.FDVBasisTotalSupply, CreatedAt: now},
+			},
+		},
+		"not found": {
+			Name: "gov3",
+			Err:  fmt.Errorf("DAO.GetGovernance(gov3): %w", postgres.ErrorRecordNotFounded),
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			d := services.Imp{
+				DAO: &dao.PostgresMock{
+					GetGovernanceFunc: func(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error) {
+						if cond.Names[0] != "gov1" {
+							return nil, nil
+						}
+						return GArr[:1], nil
+					},
+					GetGovernanceSupplyHistoryFunc: func(governanceID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.GovernanceSupply, error) {
+						assert.Equal(t, governanceID, GArr[0].ID)
+						assert.Equal(t, aggregate, postgres.Month)
+						return []*dmodels.GovernanceSupply{
+							{GovernanceID: governanceID, MaximumTokenSupply: 1000000, TotalSupply: 900000, CirculatingSupply: 400000, OnChainSupply: 1000000, Holders: 10, USD: 80, CreatedAt: now.AddDate(0, 0, -1)},
+							{GovernanceID: governanceID, TotalSupply: 1000000, CirculatingSupply: 500000, OnChainSupply: 1000000, Holders: 12, USD: 85, CreatedAt: now},
+						}, nil
+					},
+				},
+			}
+
+			history, err := d.GetGovernanceHistory(s2.Name, "month")
+			if s2.Err != nil {
+				assert.Equal(t, err.Error(), s2.Err.Error())
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, history, s2.Result)
+		})
+	}
+}
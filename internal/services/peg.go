@@ -0,0 +1,137 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/dex"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"time"
+)
+
+const defaultPegAlertThreshold = 0.02
+
+func (s Imp) pegAlertThreshold() decimal.Decimal {
+	if s.cfg.PegAlertThreshold > 0 {
+		return decimal.NewFromFloat(s.cfg.PegAlertThreshold)
+	}
+	return decimal.NewFromFloat(defaultPegAlertThreshold)
+}
+
+// updatePegs compares every pool token's on-chain value with its DEX markets saved by the DeFi updaters.
+// Markets quoted in other coins are converted to SOL through the coins' USD prices.
+func updatePegs(s *Imp) error {
+	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Network: postgres.MainNet}})
+	if err != nil {
+		return fmt.Errorf("DAO.GetPools: %w", err)
+	}
+
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	coinsByID := make(map[uuid.UUID]*dmodels.Coin, len(coins))
+	var solUSD float64
+	for _, c := range coins {
+		coinsByID[c.ID] = c
+		if dex.NormalizeMint(c.Address) == dex.SOLMint {
+			solUSD = c.USD
+		}
+	}
+
+	threshold := s.pegAlertThreshold()
+	pegs := make([]*dmodels.PoolPeg, 0, len(pools))
+	for _, pool := range pools {
+		data, err := s.DAO.GetLastPoolData(pool.ID)
+		if err != nil {
+			return fmt.Errorf("DAO.GetLastPoolData: %w", err)
+		}
+		if data == nil || data.TotalTokensSupply == 0 {
+			continue
+		}
+
+		defis, err := s.DAO.GetDEFIs(&postgres.DeFiCondition{SaleCoinID: []uuid.UUID{pool.CoinID}})
+		if err != nil {
+			return fmt.Errorf("DAO.GetDEFIs: %w", err)
+		}
+
+		prices := make([]decimal.Decimal, 0, len(defis))
+		weights := make([]uint64, 0, len(defis))
+		var liquidity float64
+		for _, d := range defis {
+			quote, ok := coinsByID[d.BuyCoinID]
+			if !ok || d.Price <= 0 || d.Liquidity <= 0 {
+				continue
+			}
+			price := decimal.NewFromFloat(d.Price)
+			if dex.NormalizeMint(quote.Address) != dex.SOLMint {
+				if solUSD == 0 || quote.USD == 0 {
+					continue
+				}
+				price = price.Mul(decimal.NewFromFloat(quote.USD)).Div(decimal.NewFromFloat(solUSD))
+			}
+			prices = append(prices, price)
+			weights = append(weights, uint64(d.Liquidity))
+			liquidity += d.Liquidity
+		}
+
+		dexPrice := weightedAverage(prices, weights)
+		if dexPrice.IsZero() {
+			continue
+		}
+		fairValue := exchangeRate(data.TotalLamports, data.TotalTokensSupply)
+
+		peg := &dmodels.PoolPeg{
+			PoolID:    pool.ID,
+			Epoch:     data.Epoch,
+			FairValue: fairValue.Truncate(12),
+			DEXPrice:  dexPrice.Truncate(12),
+			Deviation: dexPrice.Div(fairValue).Sub(decimal.NewFromInt(1)).Truncate(9),
+			Liquidity: liquidity,
+			CreatedAt: time.Now(),
+		}
+		if peg.Deviation.LessThanOrEqual(threshold.Neg()) {
+			s.log.Warn(
+				"Pool token trades below peg",
+				zap.String("pool_name", pool.Name),
+				zap.String("fair_value", peg.FairValue.String()),
+				zap.String("dex_price", peg.DEXPrice.String()),
+				zap.String("deviation", peg.Deviation.String()),
+				zap.String("threshold", threshold.String()),
+			)
+		}
+		pegs = append(pegs, peg)
+	}
+
+	if err := s.DAO.CreatePoolPeg(pegs...); err != nil {
+		return fmt.Errorf("DAO.CreatePoolPeg: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Imp) GetPoolPegHistory(name string, aggregate string) ([]*smodels.Peg, error) {
+	pool, err := s.DAO.GetPool(name)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPool: %w", err)
+	}
+	if pool == nil {
+		return nil, fmt.Errorf("DAO.GetPool(%s): %w", name, postgres.ErrorRecordNotFounded)
+	}
+
+	dPegs, err := s.DAO.GetPoolPegs(pool.ID, postgres.SearchAggregate(aggregate))
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPoolPegs: %w", err)
+	}
+
+	threshold := s.pegAlertThreshold()
+	pegs := make([]*smodels.Peg, len(dPegs))
+	for i, p := range dPegs {
+		pegs[i] = (&smodels.Peg{}).Set(p, threshold)
+	}
+
+	return pegs, nil
+}
@@ -0,0 +1,128 @@
+package services_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+var dPegs = []*dmodels.PoolPeg{
+	{
+		PoolID:    dPool.ID,
+		Epoch:     5799,
+		FairValue: decimal.NewFromFloat(1.05),
+		DEXPrice:  decimal.NewFromFloat(1.04),
+		Deviation: decimal.NewFromFloat(-0.009523809),
+		Liquidity: 1500000,
+		CreatedAt: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		PoolID:    dPool.ID,
+		Epoch:     5800,
+		FairValue: decimal.NewFromFloat(1.05),
+		DEXPrice:  decimal.NewFromFloat(1.02),
+		Deviation: decimal.NewFromFloat(-0.028571428),
+		Liquidity: 900000,
+		CreatedAt: time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestGetPoolPegHistory(t *testing.T) {
+	data := map[string]struct {
+		DAO    services.Imp
+		Name   string
+		Result []*smodels.Peg
+		Err    error
+	}{
+		"first": {
+			Name: "pool1",
+			Result: []*smodels.Peg{
+				{
+					Epoch:     5799,
+					FairValue: decimal.NewFromFloat(1.05),
+					DEXPrice:  decimal.NewFromFloat(1.04),
+					Deviation: decimal.NewFromFloat(-0.009523809),
+					Liquidity: 1500000,
+					Depegged:  false,
+					CreatedAt: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Epoch:     5800,
+					FairValue: decimal.NewFromFloat(1.05),
+					DEXPrice:  decimal.NewFromFloat(1.02),
+					Deviation: decimal.NewFromFloat(-0.028571428),
+					Liquidity: 900000,
+					Depegged:  true,
+					CreatedAt: time.Date(2022, 3, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			DAO: services.Imp{
+				DAO: &dao.PostgresMock{
+					GetPoolFunc: func(name string) (*dmodels.Pool, error) {
+						return &dPool, nil
+					},
+					GetPoolPegsFunc: func(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolPeg, error) {
+						if poolID != dPool.ID {
+							return nil, fmt.Errorf("poolID != %s, poolID = %s", dPool.ID, poolID)
+						}
+						if aggregate != postgres.Month {
+							return nil, fmt.Errorf("aggregate != %d, aggregate = %d", postgres.Month, aggregate)
+						}
+						return dPegs, nil
+					},
+				},
+			},
+		},
+		"second": {
+			Name: "pool2",
+			Err:  fmt.Errorf("DAO.GetPool(pool2): %w", postgres.ErrorRecordNotFounded),
+			DAO: services.Imp{
+				DAO: &dao.PostgresMock{
+					GetPoolFunc: func(name string) (*dmodels.Pool, error) {
+						return nil, nil
+					},
+				},
+			},
+		},
+		"third": {
+			Name: "pool1",
+			Err:  fmt.Errorf("DAO.GetPoolPegs: %w", errors.New("some error")),
+			DAO: services.Imp{
+				DAO: &dao.PostgresMock{
+					GetPoolFunc: func(name string) (*dmodels.Pool, error) {
+						return &dPool, nil
+					},
+					GetPoolPegsFunc: func(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolPeg, error) {
+						return nil, errors.New("some error")
+					},
+				},
+			},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			pegs, err := s2.DAO.GetPoolPegHistory(s2.Name, "month")
+			if err != nil {
+				assert.Equal(t, err.Error(), s2.Err.Error())
+				return
+			}
+			assert.NilError(t, s2.Err)
+			assert.Equal(t, len(pegs), len(s2.Result))
+			for i, peg := range pegs {
+				t.Run(fmt.Sprintf("pegs[%d]", i), func(t *testing.T) {
+					assert.DeepEqual(t, peg, s2.Result[i])
+				})
+			}
+		})
+	}
+}
@@ -62,6 +62,19 @@ func (s *Imp) GetPool(name string, epoch uint64) (*smodels.PoolDetails, error) {
 		return nil, fmt.Errorf("DAO.GetCoinByID: %w", err)
 	}
 	Pool := (&smodels.Pool{}).Set(dLastPoolData, coin, dPool, validatorsD)
+	Pool.StakeWeighted = stakeWeighted(dValidators, validatorsD)
+	Pool.Yield, err = s.poolYield(dPool.ID, dLastPoolData.Epoch, Pool.StakeWeighted.APY)
+	if err != nil {
+		return nil, fmt.Errorf("imp.poolYield: %w", err)
+	}
+
+	dPeg, err := s.DAO.GetLastPoolPeg(dPool.ID)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetLastPoolPeg: %w", err)
+	}
+	if dPeg != nil {
+		Pool.Peg = (&smodels.Peg{}).Set(dPeg, s.pegAlertThreshold())
+	}
 
 	pd = &smodels.PoolDetails{
 		Pool: *Pool,
@@ -95,6 +108,8 @@ func (s *Imp) GetPools(name string, sort string, desc bool, epoch uint64, limit
 		return nil, 0, nil
 	}
 	pools := make([]*smodels.PoolDetails, len(dPools))
+	epochs := make(map[uuid.UUID]uint64, len(dPools))
+	validatorAPYs := make(map[uuid.UUID]decimal.Decimal, len(dPools))
 	for i, v1 := range dPools {
 		pools[i] = &smodels.PoolDetails{
 			Pool: smodels.Pool{
@@ -123,12 +138,26 @@ func (s *Imp) GetPools(name string, sort string, desc bool, epoch uint64, limit
 			return nil, 0, fmt.Errorf("DAO.GetValidator: %w", err)
 		}
 
+		dValidators, err := s.DAO.GetPoolValidatorData(&postgres.PoolValidatorDataCondition{PoolDataIDs: []uuid.UUID{dLastPoolData.ID}}, epoch)
+		if err != nil {
+			return nil, 0, fmt.Errorf("DAO.GetPoolValidatorData: %w", err)
+		}
+
 		coin, err := s.DAO.GetCoinByID(v1.CoinID)
 		if err != nil {
 			return nil, 0, fmt.Errorf("DAO.GetCoinByID: %w", err)
 		}
 
 		pools[i].Set(dLastPoolData, coin, v1, validatorsD)
+		pools[i].StakeWeighted = stakeWeighted(dValidators, validatorsD)
+		epochs[v1.ID], validatorAPYs[v1.ID] = dLastPoolData.Epoch, pools[i].StakeWeighted.APY
+	}
+	yields, err := s.poolsYield(epochs, validatorAPYs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("imp.poolsYield: %w", err)
+	}
+	for i, v1 := range dPools {
+		pools[i].Yield = yields[v1.ID]
 	}
 
 	count, err := s.DAO.GetPoolCount(&postgres.Condition{
@@ -166,6 +195,8 @@ func (s *Imp) GetPoolsCurrentStatistic(epoch uint64) (*smodels.Statistic, error)
 
 	once := sync.Once{}
 	pools := make([]*smodels.PoolDetails, len(dPools))
+	epochs := make(map[uuid.UUID]uint64, len(dPools))
+	validatorAPYs := make(map[uuid.UUID]decimal.Decimal, len(dPools))
 
 	var ActiveStakeSum, UnstakeSum, SupplySum uint64
 	for i, v1 := range dPools {
@@ -209,6 +240,8 @@ func (s *Imp) GetPoolsCurrentStatistic(epoch uint64) (*smodels.Statistic, error)
 		}
 
 		pools[i].Set(dLastPoolData, coin, v1, validatorsD)
+		pools[i].StakeWeighted = stakeWeighted(dValidators, validatorsD)
+		epochs[v1.ID], validatorAPYs[v1.ID] = dLastPoolData.Epoch, pools[i].StakeWeighted.APY
 
 		once.Do(func() {
 			stat.MINScore = pools[i].AVGScore
@@ -233,6 +266,17 @@ func (s *Imp) GetPoolsCurrentStatistic(epoch uint64) (*smodels.Statistic, error)
 		}
 	}
 
+	yields, err := s.poolsYield(epochs, validatorAPYs)
+	if err != nil {
+		return nil, fmt.Errorf("imp.poolsYield: %w", err)
+	}
+	for i, v1 := range dPools {
+		pools[i].Yield = yields[v1.ID]
+		if len(pools[i].Yield.Realized) != 0 && pools[i].Yield.Realized[0].APY.GreaterThan(stat.MAXRealizedAPY) {
+			stat.MAXRealizedAPY = pools[i].Yield.Realized[0].APY
+		}
+	}
+
 	if len(dPools) > 0 {
 		stat.AVGSkippedSlots = stat.AVGSkippedSlots.Div(decimal.NewFromInt(int64(len(dPools))))
 		stat.AVGScore /= int64(len(dPools))
@@ -279,9 +323,140 @@ func (s *Imp) GetPoolStatistic(name string, aggregate string) ([]*smodels.Pool,
 		}
 	}
 
+	if err := s.setHistoricalPrices(data, coin); err != nil {
+		return nil, err
+	}
+
 	return data, nil
 }
 
+// GetPoolsStatistic returns the statistic of every pool found by name, like GetPoolStatistic but reading all the pools
+// at once; pools that don't exist are missing from the result.
+func (s *Imp) GetPoolsStatistic(names []string, aggregate string) (map[string][]*smodels.Pool, error) {
+	stats := make(map[string][]*smodels.Pool, len(names))
+	if len(names) == 0 {
+		return stats, nil
+	}
+	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Names: names}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPools: %w", err)
+	}
+	if len(pools) == 0 {
+		return stats, nil
+	}
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	coinsByID := make(map[uuid.UUID]*dmodels.Coin, len(coins))
+	for _, c := range coins {
+		coinsByID[c.ID] = c
+	}
+
+	poolsByID := make(map[uuid.UUID]*dmodels.Pool, len(pools))
+	poolIDs := make([]uuid.UUID, len(pools))
+	for i, p := range pools {
+		poolsByID[p.ID], poolIDs[i] = p, p.ID
+	}
+	data, err := s.DAO.GetPoolsStatistic(poolIDs, postgres.SearchAggregate(aggregate))
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPoolsStatistic: %w", err)
+	}
+	if len(data) == 0 {
+		return stats, nil
+	}
+	dataIDs := make([]uuid.UUID, len(data))
+	for i, d := range data {
+		dataIDs[i] = d.ID
+	}
+	counts, err := s.DAO.GetValidatorDataCounts(dataIDs)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetValidatorDataCounts: %w", err)
+	}
+
+	for _, d := range data {
+		pool := poolsByID[d.PoolID]
+		p := (&smodels.Pool{}).Set(d, coinsByID[pool.CoinID], pool, nil)
+		p.ValidatorCount = counts[d.ID]
+		stats[pool.Name] = append(stats[pool.Name], p)
+	}
+
+	sol := findSOLCoin(coins)
+	assetIDs := make([]uuid.UUID, 0, len(pools)+1)
+	if sol != nil {
+		assetIDs = append(assetIDs, sol.ID)
+	}
+	for _, p := range pools {
+		assetIDs = append(assetIDs, p.CoinID)
+	}
+	points := make([]*smodels.Pool, 0, len(data))
+	for _, pool := range pools {
+		points = append(points, stats[pool.Name]...)
+	}
+	history, err := s.DAO.GetPricesAt(assetIDs, statisticPoints(points))
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPricesAt: %w", err)
+	}
+	for _, pool := range pools {
+		setPrices(history, stats[pool.Name], sol, coinsByID[pool.CoinID])
+	}
+	return stats, nil
+}
+
+// setHistoricalPrices sets the SOL and pool token prices saved last before every statistic point.
+func (s *Imp) setHistoricalPrices(data []*smodels.Pool, coin *dmodels.Coin) error {
+	if len(data) == 0 {
+		return nil
+	}
+	solCoin, err := s.solCoin()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uuid.UUID, 0, 2)
+	if solCoin != nil {
+		ids = append(ids, solCoin.ID)
+	}
+	if coin != nil {
+		ids = append(ids, coin.ID)
+	}
+	history, err := s.DAO.GetPricesAt(ids, statisticPoints(data))
+	if err != nil {
+		return fmt.Errorf("DAO.GetPricesAt: %w", err)
+	}
+	setPrices(history, data, solCoin, coin)
+	return nil
+}
+
+// statisticPoints returns the distinct times of the statistic points, which are priced at once.
+func statisticPoints(data []*smodels.Pool) []time.Time {
+	seen := make(map[time.Time]bool, len(data))
+	points := make([]time.Time, 0, len(data))
+	for _, d := range data {
+		if !seen[d.CreatedAt] {
+			seen[d.CreatedAt] = true
+			points = append(points, d.CreatedAt)
+		}
+	}
+	return points
+}
+
+// setPrices sets the SOL and token prices of the statistic points from the price history ordered by time.
+func setPrices(history []*dmodels.PriceHistory, data []*smodels.Pool, solCoin *dmodels.Coin, coin *dmodels.Coin) {
+	for _, d := range data {
+		if solCoin != nil {
+			if p := priceAt(history, solCoin.ID, d.CreatedAt); p != nil {
+				d.SOLUSD = decimal.NewFromFloat(p.USD)
+			}
+		}
+		if coin != nil {
+			if p := priceAt(history, coin.ID, d.CreatedAt); p != nil {
+				d.TokenUSD = decimal.NewFromFloat(p.USD)
+			}
+		}
+	}
+}
+
 func (s *Imp) GetNetworkAPY() (float64, error) {
 	d, err := s.Cache.GetAPY()
 	if err != nil {
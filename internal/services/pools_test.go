@@ -13,6 +13,7 @@ import (
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 	"gotest.tools/assert"
+	"math"
 	"testing"
 	"time"
 )
@@ -934,6 +935,7 @@ func TestGetPoolsCurrentStatistic(t *testing.T) {
 }
 
 func TestGetPoolStatistic(t *testing.T) {
+	solCoin := &dmodels.Coin{ID: uuid.NewV4(), Name: "SOL", Address: "11111111111111111111111111111111"}
 	data := map[string]struct {
 		DAO  services.Imp
 		Data struct {
@@ -967,6 +969,8 @@ func TestGetPoolStatistic(t *testing.T) {
 					WithdrawalFee:    decimal.Decimal{},
 					RewardsFee:       decimal.Decimal{},
 					ValidatorCount:   1,
+					SOLUSD:           decimal.NewFromInt(150),
+					TokenUSD:         decimal.NewFromInt(156),
 					CreatedAt:        time.Time{},
 				},
 			},
@@ -979,6 +983,21 @@ func TestGetPoolStatistic(t *testing.T) {
 						}
 						return &dPool, nil
 					},
+					GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+						return append([]*dmodels.Coin{solCoin}, coinArr...), nil
+					},
+					GetPricesAtFunc: func(assetIDs []uuid.UUID, points []time.Time) ([]*dmodels.PriceHistory, error) {
+						if len(points) != 1 || !points[0].Equal(dPoolData.CreatedAt) {
+							return nil, fmt.Errorf("points != [%s], points = %v", dPoolData.CreatedAt, points)
+						}
+						if len(assetIDs) != 2 || assetIDs[0] != solCoin.ID || assetIDs[1] != coinArr[0].ID {
+							return nil, fmt.Errorf("unexpected assetIDs %v", assetIDs)
+						}
+						return []*dmodels.PriceHistory{
+							{AssetID: solCoin.ID, USD: 150, CreatedAt: dPoolData.CreatedAt.Add(-time.Hour)},
+							{AssetID: coinArr[0].ID, USD: 156, CreatedAt: dPoolData.CreatedAt},
+						}, nil
+					},
 					GetPoolStatisticFunc: func(poolID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PoolData, error) {
 						if poolID != dPool.ID {
 							return nil, fmt.Errorf("poolID != %s, poolID = %s", dPool.ID, poolID)
@@ -1181,3 +1200,193 @@ func TestGetUSD(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPoolStakeWeighted(t *testing.T) {
+	pvd := []*dmodels.PoolValidatorData{
+		{PoolDataID: dPoolData.ID, ValidatorID: "id1", ActiveStake: 300},
+		{PoolDataID: dPoolData.ID, ValidatorID: "id2", ActiveStake: 100},
+	}
+	validators := map[string]*dmodels.ValidatorView{
+		"id1": {ID: "id1", APY: decimal.NewFromFloat(0.08), Score: 10, SkippedSlots: decimal.NewFromFloat(0.02)},
+		"id2": {ID: "id2", APY: decimal.NewFromFloat(0.04), Score: 2, SkippedSlots: decimal.NewFromFloat(0.10)},
+	}
+	s := services.Imp{
+		Cache: cache.New(time.Minute, time.Minute),
+		DAO: &dao.PostgresMock{
+			GetPoolFunc: func(name string) (*dmodels.Pool, error) {
+				return &dPool, nil
+			},
+			GetLastPoolDataFunc: func(poolID uuid.UUID) (*dmodels.PoolData, error) {
+				return &dPoolData, nil
+			},
+			GetPoolValidatorDataFunc: func(condition *postgres.PoolValidatorDataCondition, epoch uint64) ([]*dmodels.PoolValidatorData, error) {
+				return pvd, nil
+			},
+			GetValidatorFunc: func(validatorID string, epoch uint64) (*dmodels.ValidatorView, error) {
+				return validators[validatorID], nil
+			},
+			GetCoinByIDFunc: func(id uuid.UUID) (*dmodels.Coin, error) {
+				return coinArr[0], nil
+			},
+			GetPoolsExchangeRateWindowsFunc: func(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error) {
+				return nil, nil
+			},
+			GetLastPoolPegFunc: func(poolID uuid.UUID) (*dmodels.PoolPeg, error) {
+				return nil, nil
+			},
+		},
+	}
+
+	pool, err := s.GetPool("pool1", 1)
+	assert.NilError(t, err)
+	assert.Equal(t, pool.AVGScore, int64(6))
+	assert.Assert(t, pool.StakeWeighted.APY.Equal(decimal.NewFromFloat(0.07)), pool.StakeWeighted.APY.String())
+	assert.Assert(t, pool.StakeWeighted.Score.Equal(decimal.NewFromInt(8)), pool.StakeWeighted.Score.String())
+	assert.Assert(t, pool.StakeWeighted.SkippedSlots.Equal(decimal.NewFromFloat(0.04)), pool.StakeWeighted.SkippedSlots.String())
+}
+
+func TestGetPoolYield(t *testing.T) {
+	// one pool token grows by 0.04% every epoch of 2 days (400ms slots)
+	last := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	history := func(from uint64) []*dmodels.PoolExchangeRate {
+		var rates []*dmodels.PoolExchangeRate
+		for e := from; e <= dPoolData.Epoch; e++ {
+			rates = append(rates, &dmodels.PoolExchangeRate{
+				PoolID:    dPool.ID,
+				Epoch:     e,
+				Rate:      decimal.NewFromFloat(math.Pow(1.0004, float64(e-from))),
+				UpdatedAt: last.Add(-time.Duration(dPoolData.Epoch-e) * time.Hour * 48),
+			})
+		}
+		return rates
+	}
+	apy := decimal.NewFromFloat(math.Pow(1.0004, 182.625) - 1)
+
+	data := map[string]struct {
+		Rates  []*dmodels.PoolExchangeRate
+		Result []*smodels.RealizedAPY
+	}{
+		"full history": {
+			Rates: history(dPoolData.Epoch - 100),
+			Result: []*smodels.RealizedAPY{
+				{Epochs: 1, APY: apy},
+				{Epochs: 10, APY: apy},
+				{Epochs: 30, APY: apy},
+				{Days: 90, APY: apy},
+			},
+		},
+		"short history": {
+			Rates: history(dPoolData.Epoch - 20),
+			Result: []*smodels.RealizedAPY{
+				{Epochs: 1, APY: apy},
+				{Epochs: 10, APY: apy},
+			},
+		},
+		"no history": {
+			Rates:  history(dPoolData.Epoch),
+			Result: []*smodels.RealizedAPY{},
+		},
+	}
+
+	for name, d := range data {
+		rates := d.Rates
+		s := services.Imp{
+			Cache: cache.New(time.Minute, time.Minute),
+			DAO: &dao.PostgresMock{
+				GetPoolFunc: func(name string) (*dmodels.Pool, error) {
+					return &dPool, nil
+				},
+				GetLastPoolDataFunc: func(poolID uuid.UUID) (*dmodels.PoolData, error) {
+					return &dPoolData, nil
+				},
+				GetPoolValidatorDataFunc: func(condition *postgres.PoolValidatorDataCondition, epoch uint64) ([]*dmodels.PoolValidatorData, error) {
+					return []*dmodels.PoolValidatorData{{PoolDataID: dPoolData.ID, ValidatorID: "id1", ActiveStake: 100}}, nil
+				},
+				GetValidatorFunc: func(validatorID string, epoch uint64) (*dmodels.ValidatorView, error) {
+					return &dmodels.ValidatorView{ID: validatorID, APY: decimal.NewFromFloat(0.08)}, nil
+				},
+				GetCoinByIDFunc: func(id uuid.UUID) (*dmodels.Coin, error) {
+					return coinArr[0], nil
+				},
+				GetSlotTimeFunc: func(cond *postgres.SlotTimeCondition) ([]*dmodels.SlotTime, error) {
+					return []*dmodels.SlotTime{{SlotTime: 400}, {SlotTime: 400}, {SlotTime: 400}}, nil
+				},
+				// the whole history, of which the query returns the rates of the windows
+				GetPoolsExchangeRateWindowsFunc: func(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error) {
+					assert.DeepEqual(t, epochs, map[uuid.UUID]uint64{dPool.ID: dPoolData.Epoch})
+					assert.DeepEqual(t, epochWindows, []uint64{1, 10, 30})
+					assert.DeepEqual(t, dayWindows, []uint64{90})
+					return rates, nil
+				},
+				GetLastPoolPegFunc: func(poolID uuid.UUID) (*dmodels.PoolPeg, error) {
+					return nil, nil
+				},
+			},
+		}
+		t.Run(name, func(t *testing.T) {
+			pool, err := s.GetPool("pool1", 1)
+			assert.NilError(t, err)
+			assert.Assert(t, pool.Yield.ValidatorAPY.Equal(decimal.NewFromFloat(0.08)), pool.Yield.ValidatorAPY.String())
+			assert.Equal(t, len(pool.Yield.Realized), len(d.Result))
+			for i, r := range pool.Yield.Realized {
+				t.Run(fmt.Sprintf("realized[%d]", i), func(t *testing.T) {
+					assert.Equal(t, r.Epochs, d.Result[i].Epochs)
+					assert.Equal(t, r.Days, d.Result[i].Days)
+					assert.Assert(t, r.APY.Sub(d.Result[i].APY).Abs().LessThan(decimal.NewFromFloat(0.000001)), r.APY.String())
+					assert.Assert(t, r.FeeDrag.Equal(pool.Yield.ValidatorAPY.Sub(r.APY)), r.FeeDrag.String())
+				})
+			}
+		})
+	}
+}
+
+func TestGetPoolsYield(t *testing.T) {
+	other := dPool
+	other.ID, other.Name = uuid.NewV4(), "pool2"
+	last := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	rates := []*dmodels.PoolExchangeRate{
+		{PoolID: dPool.ID, Epoch: dPoolData.Epoch - 1, Rate: decimal.NewFromInt(1), UpdatedAt: last.Add(-48 * time.Hour)},
+		{PoolID: dPool.ID, Epoch: dPoolData.Epoch, Rate: decimal.NewFromFloat(1.0004), UpdatedAt: last},
+	}
+
+	var windows []map[uuid.UUID]uint64
+	s := services.Imp{
+		Cache: cache.New(time.Minute, time.Minute),
+		DAO: &dao.PostgresMock{
+			GetPoolsFunc: func(condition *postgres.PoolCondition) ([]*dmodels.Pool, error) {
+				return []*dmodels.Pool{&dPool, &other}, nil
+			},
+			GetLastPoolDataFunc: func(poolID uuid.UUID) (*dmodels.PoolData, error) {
+				return &dPoolData, nil
+			},
+			GetValidatorsFunc: func(condition *postgres.ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error) {
+				return nil, nil
+			},
+			GetPoolValidatorDataFunc: func(condition *postgres.PoolValidatorDataCondition, epoch uint64) ([]*dmodels.PoolValidatorData, error) {
+				return nil, nil
+			},
+			GetCoinByIDFunc: func(id uuid.UUID) (*dmodels.Coin, error) {
+				return coinArr[0], nil
+			},
+			GetPoolCountFunc: func(condition *postgres.Condition) (int64, error) {
+				return 2, nil
+			},
+			GetSlotTimeFunc: func(cond *postgres.SlotTimeCondition) ([]*dmodels.SlotTime, error) {
+				return []*dmodels.SlotTime{{SlotTime: 400}}, nil
+			},
+			GetPoolsExchangeRateWindowsFunc: func(epochs map[uuid.UUID]uint64, epochWindows []uint64, dayWindows []uint64) ([]*dmodels.PoolExchangeRate, error) {
+				windows = append(windows, epochs)
+				return rates, nil
+			},
+		},
+	}
+
+	pools, _, err := s.GetPools("", "apy", true, 1, 10, 0)
+	assert.NilError(t, err)
+	// the rates of all the pools are read at once
+	assert.DeepEqual(t, windows, []map[uuid.UUID]uint64{{dPool.ID: dPoolData.Epoch, other.ID: dPoolData.Epoch}})
+	assert.Equal(t, len(pools), 2)
+	assert.Equal(t, len(pools[0].Yield.Realized), 1)
+	assert.Equal(t, pools[0].Yield.Realized[0].Epochs, uint64(1))
+	assert.Equal(t, len(pools[1].Yield.Realized), 0)
+}
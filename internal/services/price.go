@@ -0,0 +1,99 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/price"
+	uuid "github.com/satori/go.uuid"
+	"sort"
+	"strings"
+	"time"
+)
+
+const defaultPriceStaleAfter = time.Hour
+
+// dexPriceSources are the liquidity pools whose pairs are used to price pool coins.
+var dexPriceSources = []string{"Raydium", "Orca"}
+
+func (s Imp) priceStaleAfter() time.Duration {
+	if s.cfg.PriceStaleAfter <= 0 {
+		return defaultPriceStaleAfter
+	}
+	return s.cfg.PriceStaleAfter
+}
+
+// priceProviders returns the configured providers and a DEX provider built from the pairs stored by
+// the last successful Raydium and Orca updates, quoted in the current USD prices of coins.
+func (s Imp) priceProviders(coins []*dmodels.Coin) ([]price.Provider, error) {
+	lps, err := s.DAO.GetLiquidityPools(&postgres.Condition{Names: dexPriceSources})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetLiquidityPools: %w", err)
+	}
+	syncedAt := make(map[uuid.UUID]time.Time, len(lps))
+	ids := make([]uuid.UUID, 0, len(lps))
+	for _, lp := range lps {
+		if lp.Status != dmodels.LiquidityPoolStatusOK || lp.SyncedAt == nil {
+			continue
+		}
+		syncedAt[lp.ID] = *lp.SyncedAt
+		ids = append(ids, lp.ID)
+	}
+
+	providers := append([]price.Provider{}, s.PriceProviders...)
+	if len(ids) == 0 {
+		return providers, nil
+	}
+
+	defis, err := s.DAO.GetDEFIs(&postgres.DeFiCondition{LiquidityPoolIDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetDEFIs: %w", err)
+	}
+	coinsByID := make(map[uuid.UUID]*dmodels.Coin, len(coins))
+	for _, c := range coins {
+		coinsByID[c.ID] = c
+	}
+	markets := make([]price.Market, 0, len(defis))
+	for _, d := range defis {
+		base, ok := coinsByID[d.SaleCoinID]
+		if !ok {
+			continue
+		}
+		quote, ok := coinsByID[d.BuyCoinID]
+		if !ok {
+			continue
+		}
+		markets = append(markets, price.Market{
+			Base:      dex.NormalizeMint(base.Address),
+			Price:     d.Price,
+			QuoteUSD:  quote.USD,
+			Liquidity: d.Liquidity,
+			UpdatedAt: syncedAt[d.LiquidityPoolID],
+		})
+	}
+
+	return append(providers, price.NewDEX(markets)), nil
+}
+
+// storedPriceProviders is priceProviders quoted in the prices of the stored coins, for updates of other assets.
+func (s Imp) storedPriceProviders() ([]price.Provider, error) {
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	return s.priceProviders(coins)
+}
+
+// providerErrors joins the errors of the failed providers into one error, or returns nil.
+func providerErrors(errs map[string]error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(errs))
+	for name, err := range errs {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", name, err))
+	}
+	sort.Strings(msgs)
+	return fmt.Errorf("price providers failed: %s", strings.Join(msgs, "; "))
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/everstake/solana-pools/pkg/dex"
+	uuid "github.com/satori/go.uuid"
+	"sort"
+	"time"
+)
+
+// GetPriceHistory returns the USD prices of the coin, or of the governance token when no coin has the name.
+func (s Imp) GetPriceHistory(name string, aggregate string) ([]*smodels.Price, error) {
+	coins, err := s.DAO.GetCoins(&postgres.CoinCondition{Names: []string{name}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+
+	var assetID uuid.UUID
+	if len(coins) != 0 {
+		assetID = coins[0].ID
+	} else {
+		gov, err := s.DAO.GetGovernance(&postgres.GovernanceCondition{Condition: &postgres.Condition{Names: []string{name}}})
+		if err != nil {
+			return nil, fmt.Errorf("DAO.GetGovernance: %w", err)
+		}
+		if len(gov) == 0 {
+			return nil, fmt.Errorf("DAO.GetGovernance(%s): %w", name, postgres.ErrorRecordNotFounded)
+		}
+		assetID = gov[0].ID
+	}
+
+	history, err := s.DAO.GetPriceHistory(assetID, postgres.SearchAggregate(aggregate))
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPriceHistory: %w", err)
+	}
+
+	prices := make([]*smodels.Price, len(history))
+	for i, p := range history {
+		prices[i] = (&smodels.Price{}).Set(p)
+	}
+
+	return prices, nil
+}
+
+// solCoin returns the coin of native SOL, or nil when it is not stored.
+func (s Imp) solCoin() (*dmodels.Coin, error) {
+	coins, err := s.DAO.GetCoins(nil)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetCoins: %w", err)
+	}
+	return findSOLCoin(coins), nil
+}
+
+func findSOLCoin(coins []*dmodels.Coin) *dmodels.Coin {
+	for _, c := range coins {
+		if dex.NormalizeMint(c.Address) == dex.SOLMint {
+			return c
+		}
+	}
+	return nil
+}
+
+// priceAt returns the last price of the asset saved at or before t in the history ordered by time.
+func priceAt(history []*dmodels.PriceHistory, assetID uuid.UUID, t time.Time) *dmodels.PriceHistory {
+	i := sort.Search(len(history), func(i int) bool { return history[i].CreatedAt.After(t) })
+	for i--; i >= 0; i-- {
+		if history[i].AssetID == assetID {
+			return history[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package services_test
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestGetPriceHistory(t *testing.T) {
+	now := time.Now()
+	coin := &dmodels.Coin{ID: uuid.NewV4(), Name: "mSOL"}
+	gov := &dmodels.Governance{ID: uuid.NewV4(), Name: "MNDE"}
+	history := map[uuid.UUID][]*dmodels.PriceHistory{
+		coin.ID: {
+			{AssetType: dmodels.PriceAssetCoin, AssetID: coin.ID, USD: 101, Sources: "coingecko,dex", CreatedAt: now.AddDate(0, 0, -1)},
+			{AssetType: dmodels.PriceAssetCoin, AssetID: coin.ID, USD: 104, Sources: "dex", CreatedAt: now},
+		},
+		gov.ID: {
+			{AssetType: dmodels.PriceAssetGovernance, AssetID: gov.ID, USD: 0.12, Sources: "coingecko", CreatedAt: now},
+		},
+	}
+
+	data := map[string]struct {
+		Name   string
+		Result []*smodels.Price
+		Err    error
+	}{
+		"coin": {
+			Name: "mSOL",
+			Result: []*smodels.Price{
+				{USD: 101, Sources: "coingecko,dex", CreatedAt: now.AddDate(0, 0, -1)},
+				{USD: 104, Sources: "dex", CreatedAt: now},
+			},
+		},
+		"governance": {
+			Name:   "MNDE",
+			Result: []*smodels.Price{{USD: 0.12, Sources: "coingecko", CreatedAt: now}},
+		},
+		"not found": {
+			Name: "unknown",
+			Err:  fmt.Errorf("DAO.GetGovernance(%s): %w", "unknown", postgres.ErrorRecordNotFounded),
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			d := services.Imp{
+				DAO: &dao.PostgresMock{
+					GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+						if cond.Names[0] == coin.Name {
+							return []*dmodels.Coin{coin}, nil
+						}
+						return nil, nil
+					},
+					GetGovernanceFunc: func(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error) {
+						if cond.Condition.Names[0] == gov.Name {
+							return []*dmodels.Governance{gov}, nil
+						}
+						return nil, nil
+					},
+					GetPriceHistoryFunc: func(assetID uuid.UUID, aggregate postgres.Aggregate) ([]*dmodels.PriceHistory, error) {
+						if aggregate != postgres.Month {
+							return nil, fmt.Errorf("aggregate != %d, aggregate = %d", postgres.Month, aggregate)
+						}
+						return history[assetID], nil
+					},
+				},
+			}
+
+			prices, err := d.GetPriceHistory(s2.Name, "month")
+			if s2.Err != nil {
+				assert.Error(t, err, s2.Err.Error())
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, prices, s2.Result)
+		})
+	}
+}
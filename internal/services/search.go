@@ -0,0 +1,45 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"strings"
+)
+
+// SearchTypes are the types of entities that can be searched.
+var SearchTypes = []string{
+	dmodels.SearchPool,
+	dmodels.SearchValidator,
+	dmodels.SearchCoin,
+	dmodels.SearchGovernance,
+	dmodels.SearchLiquidityPool,
+}
+
+var ErrUnknownSearchType = errors.New("unknown search type")
+
+// Search finds the pools, validators, coins, governance tokens and liquidity pools of types, all without types,
+// by name or address, best matches first.
+func (s Imp) Search(query string, types []string, limit uint64) ([]*smodels.SearchResult, error) {
+	for _, t := range types {
+		if !contains(SearchTypes, t) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownSearchType, t)
+		}
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return make([]*smodels.SearchResult, 0), nil
+	}
+
+	found, err := s.DAO.Search(&postgres.SearchCondition{Query: query, Types: types, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.Search: %w", err)
+	}
+	results := make([]*smodels.SearchResult, len(found))
+	for i, r := range found {
+		results[i] = (&smodels.SearchResult{}).Set(r)
+	}
+	return results, nil
+}
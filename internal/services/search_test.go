@@ -0,0 +1,67 @@
+package services_test
+
+import (
+	"errors"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	data := map[string]struct {
+		Query  string
+		Types  []string
+		Cond   *postgres.SearchCondition
+		Result []*smodels.SearchResult
+		Err    error
+	}{
+		"all types": {
+			Query: " 9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF ",
+			Cond:  &postgres.SearchCondition{Query: "9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF", Limit: 20},
+			Result: []*smodels.SearchResult{
+				{Type: "validator", Key: "9QU2QSxhb24FUX3Tu2FpczXjpK3VYrvRudywSZaM29mF", Name: "Everstake", Score: 1},
+			},
+		},
+		"types": {
+			Query:  "ever",
+			Types:  []string{"pool", "liquidity_pool"},
+			Cond:   &postgres.SearchCondition{Query: "ever", Types: []string{"pool", "liquidity_pool"}, Limit: 20},
+			Result: []*smodels.SearchResult{},
+		},
+		"blank": {
+			Query:  "  ",
+			Result: []*smodels.SearchResult{},
+		},
+		"unknown type": {
+			Query: "ever",
+			Types: []string{"pools"},
+			Err:   services.ErrUnknownSearchType,
+		},
+	}
+
+	for name, d := range data {
+		t.Run(name, func(t *testing.T) {
+			s := services.Imp{DAO: &dao.PostgresMock{
+				SearchFunc: func(cond *postgres.SearchCondition) ([]*dmodels.SearchResult, error) {
+					assert.DeepEqual(t, cond, d.Cond)
+					results := make([]*dmodels.SearchResult, len(d.Result))
+					for i, r := range d.Result {
+						results[i] = &dmodels.SearchResult{Type: r.Type, Key: r.Key, Name: r.Name, Score: r.Score}
+					}
+					return results, nil
+				},
+			}}
+			results, err := s.Search(d.Query, d.Types, 20)
+			if d.Err != nil {
+				assert.Assert(t, errors.Is(err, d.Err))
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, results, d.Result)
+		})
+	}
+}
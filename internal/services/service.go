@@ -2,23 +2,33 @@ package services
 
 import (
 	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/internal/catalog"
 	"github.com/everstake/solana-pools/internal/dao"
 	"github.com/everstake/solana-pools/internal/dao/cache"
-	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/events"
 	"github.com/everstake/solana-pools/internal/services/smodels"
 	"github.com/everstake/solana-pools/pkg/atrix"
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/export"
 	"github.com/everstake/solana-pools/pkg/orca"
+	"github.com/everstake/solana-pools/pkg/price"
+	"github.com/everstake/solana-pools/pkg/ratelimit"
 	"github.com/everstake/solana-pools/pkg/raydium"
 	"github.com/everstake/solana-pools/pkg/saber"
+	"github.com/everstake/solana-pools/pkg/solend"
 	"github.com/everstake/solana-pools/pkg/validatorsapp"
 	"github.com/portto/solana-go-sdk/client"
+	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
 	coingecko "github.com/superoo7/go-gecko/v3"
 	"go.uber.org/zap"
+	"io"
 	"net/http"
 	"time"
 )
 
+//go:generate moq -out service_mock.go . Service
+
 type (
 	PoolConditional struct {
 		Name string
@@ -28,6 +38,9 @@ type (
 		GetActiveStake() uint64
 		GetPoolsCurrentStatistic(epoch uint64) (*smodels.Statistic, error)
 		GetPoolStatistic(name string, aggregate string) ([]*smodels.Pool, error)
+		GetPoolsStatistic(names []string, aggregate string) (map[string][]*smodels.Pool, error)
+		GetPoolPegHistory(name string, aggregate string) ([]*smodels.Peg, error)
+		GetPriceHistory(name string, aggregate string) ([]*smodels.Price, error)
 		GetPrice() (decimal.Decimal, error)
 		GetAPY() (decimal.Decimal, error)
 		GetValidators() (int64, error)
@@ -36,33 +49,75 @@ type (
 		GetEpoch() (*smodels.EpochInfo, error)
 		GetPoolCoins(name string, sort string, desc bool, limit uint64, offset uint64) ([]*smodels.Coin, uint64, error)
 		GetGovernance(name string, sort string, desc bool, limit uint64, offset uint64) ([]*smodels.Governance, uint64, error)
+		GetGovernanceHistory(name string, aggregate string) ([]*smodels.GovernanceSupply, error)
+		GetGovernancesHistory(names []string, aggregate string) (map[string][]*smodels.GovernanceSupply, error)
 		GetCoins(name string, limit uint64, offset uint64) ([]*smodels.Coin, uint64, error)
-		GetAllValidators(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, limit uint64, offset uint64) ([]*smodels.Validator, uint64, error)
-		GetPoolValidators(name string, validatorName string, sort string, desc bool, epoch uint64, limit uint64, offset uint64) ([]*smodels.PoolValidatorData, uint64, error)
+		GetAllValidators(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) ([]*smodels.Validator, uint64, error)
+		GetPoolValidators(name string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) ([]*smodels.PoolValidatorData, uint64, error)
+		GetPoolsValidators(names []string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) (map[string][]*smodels.PoolValidatorData, map[string]uint64, error)
+		GetValidatorsPage(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, filter smodels.ValidatorFilter, req smodels.PageRequest) ([]*smodels.Validator, *smodels.Page, error)
+		GetPoolValidatorsPage(name string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, req smodels.PageRequest) ([]*smodels.PoolValidatorData, *smodels.Page, error)
+		GetValidator(votePK string, epoch uint64, historyLimit uint64) (*smodels.ValidatorDetails, error)
+		GetValidatorsPools(votePKs []string, epoch uint64) (map[string][]*smodels.ValidatorPool, error)
 		GetLiquidityPools(name string, limit uint64, offset uint64) ([]*smodels.LiquidityPool, uint64, error)
 		GetAvgSlotTimeMS() (float64, error)
+		GetCurrencyRate(currency string, at time.Time) (float64, error)
+		GetCurrencyRates(currency string, from time.Time, to time.Time) (smodels.CurrencyRates, error)
+		GetAuditLogs(entity string, limit uint64, offset uint64) ([]*smodels.AuditLog, uint64, error)
+
+		AdminGetPools() ([]*smodels.AdminPool, error)
+		AdminSavePool(actor string, pool *smodels.AdminPool) (*smodels.AdminPool, error)
+		AdminDeletePool(actor string, id uuid.UUID) error
+		AdminGetCoins() ([]*smodels.AdminCoin, error)
+		AdminSaveCoin(actor string, coin *smodels.AdminCoin) (*smodels.AdminCoin, error)
+		AdminDeleteCoin(actor string, id uuid.UUID) error
+		AdminGetLiquidityPools() ([]*smodels.AdminLiquidityPool, error)
+		AdminSaveLiquidityPool(actor string, pool *smodels.AdminLiquidityPool) (*smodels.AdminLiquidityPool, error)
+		AdminDeleteLiquidityPool(actor string, id uuid.UUID) error
+		AdminGetGovernance() ([]*smodels.AdminGovernance, error)
+		AdminSaveGovernance(actor string, governance *smodels.AdminGovernance) (*smodels.AdminGovernance, error)
+		AdminDeleteGovernance(actor string, id uuid.UUID) error
+		SyncCatalog(c *catalog.Catalog, dryRun bool) ([]*smodels.CatalogChange, error)
+		AdminGetAPIKeys() ([]*smodels.APIKey, error)
+		AdminCreateAPIKey(actor string, name string, tier string) (*smodels.APIKey, error)
+		AdminDeactivateAPIKey(actor string, id uuid.UUID) error
+
+		GetAPIKey(key string) (*smodels.APIKey, error)
+		RecordAPIKeyUsage(id uuid.UUID)
+		FlushAPIKeyUsage() error
+		Subscribe(buffer int, filters ...string) (<-chan events.Event, func())
+		EventsSince(id uint64) ([]events.Event, bool)
+		DataVersion(types ...string) (uint64, time.Time, error)
+		Export(dataset string, format export.Format, filter smodels.ExportFilter, w io.Writer) error
+		Search(query string, types []string, limit uint64) ([]*smodels.SearchResult, error)
 
 		UpdateDeFi() error
 		UpdateCoins() error
 		UpdateGovernance() error
 		UpdatePrice() error
+		UpdateCurrencyRates() error
 		UpdatePools() error
 		UpdateNetworkData() error
 		UpdateValidators() error
 		UpdateSlotTimeMS() error
 	}
 	Imp struct {
-		rpcClients    map[config.Network]*client.Client
-		delinquents   chan *dmodels.Validator
-		Cache         *cache.Cache
-		cfg           config.Env
-		DAO           dao.DAO
-		coinGecko     *coingecko.Client
-		log           *zap.Logger
-		raydium       *raydium.Client
-		atrix         *atrix.Client
-		orca          *orca.Client
-		saber         *saber.Client
+		rpcClients map[config.Network]*client.Client
+		Cache      *cache.Cache
+		cfg        config.Env
+		DAO        dao.DAO
+		coinGecko  *coingecko.Client
+		log        *zap.Logger
+		// Events is published to by the update jobs when the data behind a topic changes.
+		Events *events.Bus
+		// DeFiSources are keyed by the liquidity pool name they update.
+		DeFiSources map[string]dex.Source
+		// ExchangeRates quotes the fiat currencies UpdateCurrencyRates saves.
+		ExchangeRates ExchangeRateSource
+		// PriceProviders price coins by their gecko key or mint; DEX prices are added on every update.
+		PriceProviders []price.Provider
+		// APIKeyUsage counts requests per API key until FlushAPIKeyUsage saves them.
+		APIKeyUsage   *ratelimit.Counter
 		validatorsApp *validatorsapp.Client
 	}
 )
@@ -71,20 +126,29 @@ func NewService(cfg config.Env, d dao.DAO, l *zap.Logger) Service {
 	httpClient := &http.Client{
 		Timeout: time.Second * 10,
 	}
+	rpcClients := map[config.Network]*client.Client{
+		config.Mainnet: client.NewClient(cfg.MainnetNode),
+		config.Testnet: client.NewClient(cfg.TestnetNode),
+	}
+	geckoClient := coingecko.NewClient(httpClient)
 	return &Imp{
-		rpcClients: map[config.Network]*client.Client{
-			config.Mainnet: client.NewClient(cfg.MainnetNode),
-			config.Testnet: client.NewClient(cfg.TestnetNode),
+		rpcClients: rpcClients,
+		Cache:      cache.New(time.Hour*24, time.Hour*24),
+		cfg:        cfg,
+		DAO:        d,
+		DeFiSources: map[string]dex.Source{
+			"Raydium": dex.NewRaydiumSource(raydium.NewClient(httpClient)),
+			"Orca":    dex.NewOrcaSource(orca.NewClient(httpClient)),
+			"Saber":   dex.NewSaberSource(saber.NewClient(httpClient), rpcClients[config.Mainnet]),
+			"Atrix":   dex.NewAtrixSource(atrix.NewClient(httpClient)),
+			"Solend":  dex.NewSolendSource(solend.NewClient(httpClient)),
 		},
-		Cache:         cache.New(time.Hour*24, time.Hour*24),
-		cfg:           cfg,
-		DAO:           d,
-		raydium:       raydium.NewClient(httpClient),
-		orca:          orca.NewClient(httpClient),
-		saber:         saber.NewClient(httpClient),
-		atrix:         atrix.NewClient(httpClient),
-		coinGecko:     coingecko.NewClient(httpClient),
-		log:           l,
-		validatorsApp: validatorsapp.NewClient(cfg.ValidatorsAppKey),
+		coinGecko:      geckoClient,
+		ExchangeRates:  geckoClient,
+		PriceProviders: []price.Provider{price.NewGecko(geckoClient)},
+		log:            l,
+		APIKeyUsage:    ratelimit.NewCounter(),
+		Events:         events.NewBus(),
+		validatorsApp:  validatorsapp.NewClient(cfg.ValidatorsAppKey),
 	}
 }
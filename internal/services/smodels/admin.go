@@ -0,0 +1,115 @@
+package smodels
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// AdminPool is a pool as managed through the admin API; Coin is the coin name.
+type AdminPool struct {
+	ID      uuid.UUID
+	Name    string
+	Active  bool
+	Coin    string
+	Address string
+	Network string
+	Program string
+	Image   string
+}
+
+func (p *AdminPool) Set(pool *dmodels.Pool, coin string) *AdminPool {
+	p.ID = pool.ID
+	p.Name = pool.Name
+	p.Active = pool.Active
+	p.Coin = coin
+	p.Address = pool.Address
+	p.Network = pool.Network
+	p.Program = pool.Program
+	p.Image = pool.Image
+	return p
+}
+
+type AdminCoin struct {
+	ID         uuid.UUID
+	Name       string
+	GeckoKey   string
+	Address    string
+	ThumbImage string
+	SmallImage string
+	LargeImage string
+}
+
+func (c *AdminCoin) Set(coin *dmodels.Coin) *AdminCoin {
+	c.ID = coin.ID
+	c.Name = coin.Name
+	c.GeckoKey = coin.GeckoKey
+	c.Address = coin.Address
+	c.ThumbImage = coin.ThumbImage
+	c.SmallImage = coin.SmallImage
+	c.LargeImage = coin.LargeImage
+	return c
+}
+
+type AdminLiquidityPool struct {
+	ID    uuid.UUID
+	Name  string
+	About string
+	Image string
+	URL   string
+}
+
+func (lp *AdminLiquidityPool) Set(pool *dmodels.LiquidityPool) *AdminLiquidityPool {
+	lp.ID = pool.ID
+	lp.Name = pool.Name
+	lp.About = pool.About
+	lp.Image = pool.Image
+	lp.URL = pool.URL
+	return lp
+}
+
+type AdminGovernance struct {
+	ID              uuid.UUID
+	Name            string
+	Symbol          string
+	VoteURL         string
+	WebSiteURL      string
+	Image           string
+	GeckoKey        string
+	Blockchain      string
+	ContractAddress string
+}
+
+func (g *AdminGovernance) Set(governance *dmodels.Governance) *AdminGovernance {
+	g.ID = governance.ID
+	g.Name = governance.Name
+	g.Symbol = governance.Symbol
+	g.VoteURL = governance.VoteURL
+	g.WebSiteURL = governance.WebSiteURL
+	g.Image = governance.Image
+	g.GeckoKey = governance.GeckoKey
+	g.Blockchain = governance.Blockchain
+	g.ContractAddress = governance.ContractAddress
+	return g
+}
+
+type AuditLog struct {
+	Actor     string
+	Action    string
+	Entity    string
+	EntityID  uuid.UUID
+	Before    string
+	After     string
+	CreatedAt time.Time
+}
+
+func (a *AuditLog) Set(log *dmodels.AuditLog) *AuditLog {
+	a.Actor = log.Actor
+	a.Action = log.Action
+	a.Entity = log.Entity
+	a.EntityID = log.EntityID
+	a.Before = log.Before
+	a.After = log.After
+	a.CreatedAt = log.CreatedAt
+	return a
+}
@@ -0,0 +1,27 @@
+package smodels
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// APIKey is a public API key; Key is only set when the key is created.
+type APIKey struct {
+	ID          uuid.UUID
+	Name        string
+	Key         string
+	Tier        string
+	Active      bool
+	Requests30d int64
+	CreatedAt   time.Time
+}
+
+func (k *APIKey) Set(key *dmodels.APIKey) *APIKey {
+	k.ID = key.ID
+	k.Name = key.Name
+	k.Tier = key.Tier
+	k.Active = key.Active
+	k.CreatedAt = key.CreatedAt
+	return k
+}
@@ -0,0 +1,9 @@
+package smodels
+
+// CatalogChange is a row created, updated or deactivated to match the catalog; Fields lists the changed values.
+type CatalogChange struct {
+	Entity string
+	Name   string
+	Action string
+	Fields []string
+}
@@ -1,15 +1,21 @@
 package smodels
 
-import "github.com/everstake/solana-pools/internal/dao/dmodels"
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"time"
+)
 
 type Coin struct {
-	Name       string
-	Address    string
-	USD        float64
-	ThumbImage string
-	SmallImage string
-	LargeImage string
-	DeFi       []*DeFi
+	Name           string
+	Address        string
+	USD            float64
+	PriceUpdatedAt *time.Time
+	PriceSources   string
+	PriceStale     bool
+	ThumbImage     string
+	SmallImage     string
+	LargeImage     string
+	DeFi           []*DeFi
 }
 
 func (c *Coin) Set(coin *dmodels.Coin, fi []*DeFi) *Coin {
@@ -17,6 +23,8 @@ func (c *Coin) Set(coin *dmodels.Coin, fi []*DeFi) *Coin {
 		c.DeFi = fi
 	}
 	c.USD = coin.USD
+	c.PriceUpdatedAt = coin.PriceUpdatedAt
+	c.PriceSources = coin.PriceSources
 	c.ThumbImage = coin.ThumbImage
 	c.SmallImage = coin.SmallImage
 	c.LargeImage = coin.LargeImage
@@ -24,3 +32,14 @@ func (c *Coin) Set(coin *dmodels.Coin, fi []*DeFi) *Coin {
 	c.Address = coin.Address
 	return c
 }
+
+// MarkStale flags the price as stale when it was last updated more than maxAge ago.
+// Coins that were never priced by the aggregator carry no timestamp and are not flagged.
+func (c *Coin) MarkStale(maxAge time.Duration, now time.Time) *Coin {
+	c.PriceStale = priceStale(c.PriceUpdatedAt, maxAge, now)
+	return c
+}
+
+func priceStale(updatedAt *time.Time, maxAge time.Duration, now time.Time) bool {
+	return updatedAt != nil && now.Sub(*updatedAt) > maxAge
+}
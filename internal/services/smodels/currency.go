@@ -0,0 +1,24 @@
+package smodels
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"sort"
+	"time"
+)
+
+// CurrencyRates converts USD values into Currency at any time of a range.
+type CurrencyRates struct {
+	Currency string
+	// Rates are ordered by time; times before the first of them use Current.
+	Rates   []*dmodels.CurrencyRate
+	Current float64
+}
+
+// At returns how many units of the currency one USD bought at t.
+func (r CurrencyRates) At(t time.Time) float64 {
+	i := sort.Search(len(r.Rates), func(i int) bool { return r.Rates[i].CreatedAt.After(t) })
+	if i == 0 {
+		return r.Current
+	}
+	return r.Rates[i-1].Rate
+}
@@ -9,13 +9,29 @@ type DeFi struct {
 	BuyCoin       *Coin
 	LiquidityPool *LiquidityPool
 	Liquidity     float64
+	Volume24H     float64
 	APY           decimal.Decimal
+	FeeAPR        decimal.Decimal
+	RewardAPR     decimal.Decimal
+	Trends        []*DeFiTrend
+}
+
+// DeFiTrend describes a DeFi pair over the last Days days.
+type DeFiTrend struct {
+	Days            uint64
+	AVGAPY          decimal.Decimal
+	AVGLiquidity    float64
+	APYChange       decimal.Decimal
+	LiquidityChange float64
 }
 
 func (f *DeFi) Set(defi *dmodels.DEFI, buyCoin *Coin, liquidityPool *LiquidityPool) *DeFi {
 	f.APY = defi.APY
+	f.FeeAPR = defi.FeeAPR
+	f.RewardAPR = defi.RewardAPR
 	f.LiquidityPool = liquidityPool
 	f.BuyCoin = buyCoin
 	f.Liquidity = defi.Liquidity
+	f.Volume24H = defi.Volume24H
 	return f
 }
@@ -0,0 +1,91 @@
+package smodels
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"time"
+)
+
+type (
+	// ExportFilter selects the exported rows; empty fields do not filter and the bounds are inclusive.
+	ExportFilter struct {
+		Pools      []string
+		Validators []string
+		FromEpoch  uint64
+		ToEpoch    uint64
+		From       time.Time
+		To         time.Time
+	}
+	// PoolDataExport is a row of the pool-data dataset; amounts are in lamports.
+	PoolDataExport struct {
+		Pool              string  `parquet:"name=pool, type=BYTE_ARRAY, convertedtype=UTF8"`
+		Epoch             int64   `parquet:"name=epoch, type=INT64"`
+		ActiveStake       int64   `parquet:"name=active_stake_lamports, type=INT64"`
+		TotalTokensSupply int64   `parquet:"name=tokens_supply_lamports, type=INT64"`
+		TotalLamports     int64   `parquet:"name=total_lamports, type=INT64"`
+		APY               float64 `parquet:"name=apy, type=DOUBLE"`
+		UnstakeLiquidity  int64   `parquet:"name=unstake_liquidity_lamports, type=INT64"`
+		DepositFee        float64 `parquet:"name=deposit_fee, type=DOUBLE"`
+		WithdrawalFee     float64 `parquet:"name=withdrawal_fee, type=DOUBLE"`
+		RewardsFee        float64 `parquet:"name=rewards_fee, type=DOUBLE"`
+		CreatedAt         int64   `parquet:"name=created_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	}
+	// ValidatorDataExport is a row of the validator-data dataset; amounts are in lamports.
+	ValidatorDataExport struct {
+		VotePK          string  `parquet:"name=vote_pk, type=BYTE_ARRAY, convertedtype=UTF8"`
+		Name            string  `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+		Epoch           int64   `parquet:"name=epoch, type=INT64"`
+		APY             float64 `parquet:"name=apy, type=DOUBLE"`
+		StakingAccounts int64   `parquet:"name=staking_accounts, type=INT64"`
+		ActiveStake     int64   `parquet:"name=active_stake_lamports, type=INT64"`
+		Fee             float64 `parquet:"name=fee, type=DOUBLE"`
+		Score           int64   `parquet:"name=score, type=INT64"`
+		SkippedSlots    float64 `parquet:"name=skipped_slots, type=DOUBLE"`
+		CreatedAt       int64   `parquet:"name=created_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	}
+	// PoolValidatorDataExport is a row of the pool-validator-data dataset; amounts are in lamports.
+	PoolValidatorDataExport struct {
+		Pool        string `parquet:"name=pool, type=BYTE_ARRAY, convertedtype=UTF8"`
+		Epoch       int64  `parquet:"name=epoch, type=INT64"`
+		VotePK      string `parquet:"name=vote_pk, type=BYTE_ARRAY, convertedtype=UTF8"`
+		ActiveStake int64  `parquet:"name=active_stake_lamports, type=INT64"`
+		CreatedAt   int64  `parquet:"name=created_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	}
+)
+
+func (e *PoolDataExport) Set(d *dmodels.PoolDataExport) *PoolDataExport {
+	e.Pool = d.Pool
+	e.Epoch = int64(d.Epoch)
+	e.ActiveStake = int64(d.ActiveStake)
+	e.TotalTokensSupply = int64(d.TotalTokensSupply)
+	e.TotalLamports = int64(d.TotalLamports)
+	e.APY = d.APY.InexactFloat64()
+	e.UnstakeLiquidity = int64(d.UnstakeLiquidity)
+	e.DepositFee = d.DepossitFee.InexactFloat64()
+	e.WithdrawalFee = d.WithdrawalFee.InexactFloat64()
+	e.RewardsFee = d.RewardsFee.InexactFloat64()
+	e.CreatedAt = d.CreatedAt.UnixMilli()
+	return e
+}
+
+func (e *ValidatorDataExport) Set(d *dmodels.ValidatorDataExport) *ValidatorDataExport {
+	e.VotePK = d.ValidatorID
+	e.Name = d.Name
+	e.Epoch = int64(d.Epoch)
+	e.APY = d.APY.InexactFloat64()
+	e.StakingAccounts = int64(d.StakingAccounts)
+	e.ActiveStake = int64(d.ActiveStake)
+	e.Fee = d.Fee.InexactFloat64()
+	e.Score = d.Score
+	e.SkippedSlots = d.SkippedSlots.InexactFloat64()
+	e.CreatedAt = d.CreatedAt.UnixMilli()
+	return e
+}
+
+func (e *PoolValidatorDataExport) Set(d *dmodels.PoolValidatorDataExport) *PoolValidatorDataExport {
+	e.Pool = d.Pool
+	e.Epoch = int64(d.Epoch)
+	e.VotePK = d.ValidatorID
+	e.ActiveStake = int64(d.ActiveStake)
+	e.CreatedAt = d.CreatedAt.UnixMilli()
+	return e
+}
@@ -2,6 +2,18 @@ package smodels
 
 import (
 	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"math"
+	"time"
+)
+
+// supplyTolerance is the relative difference between the on-chain and reported supply that is still considered a match.
+const supplyTolerance = 0.01
+
+const (
+	// FDVBasisMaxSupply marks an FDV computed from the capped maximum supply.
+	FDVBasisMaxSupply = "max_supply"
+	// FDVBasisTotalSupply marks an FDV of an uncapped token, computed from its total supply.
+	FDVBasisTotalSupply = "total_supply"
 )
 
 type Governance struct {
@@ -14,8 +26,19 @@ type Governance struct {
 	Blockchain         string
 	ContractAddress    string
 	MaximumTokenSupply float64
+	TotalSupply        float64
 	CirculatingSupply  float64
+	OnChainSupply      float64
+	SupplyMismatch     bool
+	InflationRate      float64
+	Holders            uint64
 	USD                float64
+	MarketCap          float64
+	FDV                float64
+	FDVBasis           string
+	PriceUpdatedAt     *time.Time
+	PriceSources       string
+	PriceStale         bool
 }
 
 func (g *Governance) Set(governance *dmodels.Governance) *Governance {
@@ -28,7 +51,73 @@ func (g *Governance) Set(governance *dmodels.Governance) *Governance {
 	g.Blockchain = governance.Blockchain
 	g.ContractAddress = governance.ContractAddress
 	g.MaximumTokenSupply = governance.MaximumTokenSupply
+	g.TotalSupply = governance.TotalSupply
 	g.CirculatingSupply = governance.CirculatingSupply
+	g.OnChainSupply = governance.OnChainSupply
+	g.SupplyMismatch = supplyMismatch(governance.OnChainSupply, reportedSupply(governance.TotalSupply, governance.MaximumTokenSupply))
+	g.InflationRate = governance.InflationRate
+	g.Holders = governance.Holders
 	g.USD = governance.USD
+	g.MarketCap = governance.CirculatingSupply * governance.USD
+	g.FDV, g.FDVBasis = fdv(governance.MaximumTokenSupply, governance.TotalSupply, governance.USD)
+	g.PriceUpdatedAt = governance.PriceUpdatedAt
+	g.PriceSources = governance.PriceSources
+	return g
+}
+
+// MarkStale flags the price as stale when it was last updated more than maxAge ago.
+func (g *Governance) MarkStale(maxAge time.Duration, now time.Time) *Governance {
+	g.PriceStale = priceStale(g.PriceUpdatedAt, maxAge, now)
+	return g
+}
+
+// fdv values the maximum supply at usd. Uncapped tokens have no maximum supply, so their total supply is used instead.
+func fdv(maxSupply, totalSupply, usd float64) (float64, string) {
+	if maxSupply > 0 {
+		return maxSupply * usd, FDVBasisMaxSupply
+	}
+	return totalSupply * usd, FDVBasisTotalSupply
+}
+
+// reportedSupply is the supply the on-chain mint supply is checked against: the total supply, or the maximum one when
+// the total is unknown.
+func reportedSupply(totalSupply, maxSupply float64) float64 {
+	if totalSupply > 0 {
+		return totalSupply
+	}
+	return maxSupply
+}
+
+// supplyMismatch reports whether the on-chain supply differs from the reported supply by more than supplyTolerance.
+func supplyMismatch(onChain, reported float64) bool {
+	if onChain == 0 || reported == 0 {
+		return false
+	}
+	return math.Abs(onChain-reported)/reported > supplyTolerance
+}
+
+type GovernanceSupply struct {
+	MaximumTokenSupply float64
+	TotalSupply        float64
+	CirculatingSupply  float64
+	OnChainSupply      float64
+	Holders            uint64
+	USD                float64
+	MarketCap          float64
+	FDV                float64
+	FDVBasis           string
+	CreatedAt          time.Time
+}
+
+func (g *GovernanceSupply) Set(supply *dmodels.GovernanceSupply) *GovernanceSupply {
+	g.MaximumTokenSupply = supply.MaximumTokenSupply
+	g.TotalSupply = supply.TotalSupply
+	g.CirculatingSupply = supply.CirculatingSupply
+	g.OnChainSupply = supply.OnChainSupply
+	g.Holders = supply.Holders
+	g.USD = supply.USD
+	g.MarketCap = supply.CirculatingSupply * supply.USD
+	g.FDV, g.FDVBasis = fdv(supply.MaximumTokenSupply, supply.TotalSupply, supply.USD)
+	g.CreatedAt = supply.CreatedAt
 	return g
 }
@@ -1,12 +1,19 @@
 package smodels
 
-import "github.com/everstake/solana-pools/internal/dao/dmodels"
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"time"
+)
 
 type LiquidityPool struct {
 	Name  string
 	About string
 	Image string
 	URL   string
+
+	Status   string
+	Error    string
+	SyncedAt *time.Time
 }
 
 func (lp *LiquidityPool) Set(pool *dmodels.LiquidityPool) *LiquidityPool {
@@ -14,5 +21,8 @@ func (lp *LiquidityPool) Set(pool *dmodels.LiquidityPool) *LiquidityPool {
 	lp.About = pool.About
 	lp.URL = pool.URL
 	lp.Image = pool.Image
+	lp.Status = pool.Status
+	lp.Error = pool.Error
+	lp.SyncedAt = pool.SyncedAt
 	return lp
 }
@@ -0,0 +1,17 @@
+package smodels
+
+type (
+	// PageRequest asks for Limit items following, or preceding, the Cursor of an earlier page; without a cursor
+	// it asks for the first page of a new snapshot with the data up to SnapshotEpoch, or of all epochs when zero.
+	PageRequest struct {
+		Cursor        string
+		SnapshotEpoch uint64
+		Limit         uint64
+	}
+	// Page holds the opaque cursors of the next and previous pages, empty at the ends of the listing.
+	Page struct {
+		Next  string
+		Prev  string
+		Total uint64
+	}
+)
@@ -0,0 +1,28 @@
+package smodels
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+type Peg struct {
+	Epoch     uint64
+	FairValue decimal.Decimal
+	DEXPrice  decimal.Decimal
+	Deviation decimal.Decimal
+	Liquidity float64
+	Depegged  bool
+	CreatedAt time.Time
+}
+
+func (p *Peg) Set(data *dmodels.PoolPeg, threshold decimal.Decimal) *Peg {
+	p.Epoch = data.Epoch
+	p.FairValue = data.FairValue
+	p.DEXPrice = data.DEXPrice
+	p.Deviation = data.Deviation
+	p.Liquidity = data.Liquidity
+	p.Depegged = data.Deviation.LessThanOrEqual(threshold.Neg())
+	p.CreatedAt = data.CreatedAt
+	return p
+}
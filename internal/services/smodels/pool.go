@@ -26,18 +26,41 @@ type (
 		WithdrawalFee    decimal.Decimal
 		RewardsFee       decimal.Decimal
 		ValidatorCount   int64
-		CreatedAt        time.Time
+		StakeWeighted    StakeWeighted
+		Yield            Yield
+		Peg              *Peg
+		// SOLUSD and TokenUSD are the prices of SOL and the pool token at CreatedAt, zero when unknown.
+		SOLUSD    decimal.Decimal
+		TokenUSD  decimal.Decimal
+		CreatedAt time.Time
+	}
+	StakeWeighted struct {
+		APY          decimal.Decimal
+		Score        decimal.Decimal
+		SkippedSlots decimal.Decimal
+	}
+	Yield struct {
+		ValidatorAPY decimal.Decimal
+		Realized     []*RealizedAPY
+	}
+	RealizedAPY struct {
+		Epochs  uint64
+		Days    uint64
+		APY     decimal.Decimal
+		FeeDrag decimal.Decimal
 	}
 	PoolDetails struct {
 		Pool
 		CreatedAt time.Time
 	}
 	Statistic struct {
-		Pools            uint64
-		ActiveStake      sol.SOL
-		TotalSupply      sol.SOL
-		AVGSkippedSlots  decimal.Decimal
-		MAXPoolsApy      decimal.Decimal
+		Pools           uint64
+		ActiveStake     sol.SOL
+		TotalSupply     sol.SOL
+		AVGSkippedSlots decimal.Decimal
+		MAXPoolsApy     decimal.Decimal
+		// MAXRealizedAPY is the highest realized APY of the pools over their shortest yield window.
+		MAXRealizedAPY   decimal.Decimal
 		MAXScore         int64
 		AVGScore         int64
 		MINScore         int64
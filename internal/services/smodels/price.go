@@ -0,0 +1,19 @@
+package smodels
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"time"
+)
+
+type Price struct {
+	USD       float64
+	Sources   string
+	CreatedAt time.Time
+}
+
+func (p *Price) Set(price *dmodels.PriceHistory) *Price {
+	p.USD = price.USD
+	p.Sources = price.Sources
+	p.CreatedAt = price.CreatedAt
+	return p
+}
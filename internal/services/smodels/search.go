@@ -0,0 +1,24 @@
+package smodels
+
+import "github.com/everstake/solana-pools/internal/dao/dmodels"
+
+// SearchResult is an entity matching a search: Key is what the API addresses it by and Score ranks the match
+// from 0 to 1.
+type SearchResult struct {
+	Type    string
+	Key     string
+	Name    string
+	Address string
+	Image   string
+	Score   float64
+}
+
+func (r *SearchResult) Set(d *dmodels.SearchResult) *SearchResult {
+	r.Type = d.Type
+	r.Key = d.Key
+	r.Name = d.Name
+	r.Address = d.Address
+	r.Image = d.Image
+	r.Score = d.Score
+	return r
+}
@@ -4,23 +4,72 @@ import (
 	"github.com/everstake/solana-pools/internal/dao/dmodels"
 	"github.com/everstake/solana-pools/pkg/models/sol"
 	"github.com/shopspring/decimal"
+	"time"
 )
 
-type Validator struct {
-	Image            string
-	Name             string
-	Delinquent       bool
-	StakingAccounts  uint64
-	NodePK           string
-	APY              decimal.Decimal
-	VotePK           string
-	TotalActiveStake sol.SOL
-	Fee              decimal.Decimal
-	Score            int64
-	SkippedSlots     decimal.Decimal
-	DataCenter       string
-	Epoch            uint64
-}
+type (
+	// ValidatorFilter narrows validator listings; nil and empty fields don't filter, the bounds are inclusive and
+	// stakes are in lamports. DelegatedBy keeps the validators some of the pools delegate to, Undelegated the ones
+	// no pool delegates to.
+	ValidatorFilter struct {
+		MinFee          *decimal.Decimal
+		MaxFee          *decimal.Decimal
+		MinAPY          *decimal.Decimal
+		MaxAPY          *decimal.Decimal
+		MinScore        *int64
+		MaxSkippedSlots *decimal.Decimal
+		Delinquent      *bool
+		DataCenters     []string
+		MinStake        *uint64
+		MaxStake        *uint64
+		DelegatedBy     []string
+		Undelegated     bool
+	}
+	Validator struct {
+		Image            string
+		Name             string
+		Delinquent       bool
+		StakingAccounts  uint64
+		NodePK           string
+		APY              decimal.Decimal
+		VotePK           string
+		TotalActiveStake sol.SOL
+		Fee              decimal.Decimal
+		Score            int64
+		SkippedSlots     decimal.Decimal
+		DataCenter       string
+		Epoch            uint64
+	}
+	ValidatorDetails struct {
+		Validator
+		History []*ValidatorEpoch
+		Pools   []*ValidatorPool
+	}
+	ValidatorEpoch struct {
+		Epoch           uint64
+		APY             decimal.Decimal
+		StakingAccounts uint64
+		ActiveStake     sol.SOL
+		Fee             decimal.Decimal
+		Score           int64
+		SkippedSlots    decimal.Decimal
+		CreatedAt       time.Time
+	}
+	// DelinquencyAlert is published when a validator becomes delinquent or recovers.
+	DelinquencyAlert struct {
+		VotePK     string
+		Name       string
+		Delinquent bool
+		CreatedAt  time.Time
+	}
+	ValidatorPool struct {
+		Name        string
+		Address     string
+		Image       string
+		ActiveStake sol.SOL
+		PoolShare   decimal.Decimal
+	}
+)
 
 func (v *Validator) Set(vv *dmodels.ValidatorView) *Validator {
 	v.Image = vv.Image
@@ -38,3 +87,27 @@ func (v *Validator) Set(vv *dmodels.ValidatorView) *Validator {
 	v.Epoch = vv.Epoch
 	return v
 }
+
+func (ve *ValidatorEpoch) Set(data *dmodels.ValidatorData) *ValidatorEpoch {
+	ve.Epoch = data.Epoch
+	ve.APY = data.APY
+	ve.StakingAccounts = data.StakingAccounts
+	ve.ActiveStake.SetLamports(data.ActiveStake)
+	ve.Fee = data.Fee
+	ve.Score = data.Score
+	ve.SkippedSlots = data.SkippedSlots
+	ve.CreatedAt = data.CreatedAt
+	return ve
+}
+
+func (vp *ValidatorPool) Set(activeStake uint64, pool *dmodels.Pool, data *dmodels.PoolData) *ValidatorPool {
+	vp.Name = pool.Name
+	vp.Address = pool.Address
+	vp.Image = pool.Image
+	vp.ActiveStake.SetLamports(activeStake)
+	vp.PoolShare = decimal.Zero
+	if data != nil && data.ActiveStake != 0 {
+		vp.PoolShare = decimal.NewFromInt(int64(activeStake)).Div(decimal.NewFromInt(int64(data.ActiveStake)))
+	}
+	return vp
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	"github.com/shopspring/decimal"
+)
+
+// weightedAverage returns Σ(value*weight)/Σ(weight), or zero when nothing carries weight.
+func weightedAverage(values []decimal.Decimal, weights []uint64) decimal.Decimal {
+	sum, total := decimal.Zero, decimal.Zero
+	for i, v := range values {
+		w := decimal.NewFromInt(int64(weights[i]))
+		sum = sum.Add(v.Mul(w))
+		total = total.Add(w)
+	}
+	if total.IsZero() {
+		return decimal.Zero
+	}
+	return sum.Div(total)
+}
+
+// stakeWeighted aggregates validator metrics weighted by the stake the pool delegated to each validator.
+func stakeWeighted(pvd []*dmodels.PoolValidatorData, validators []*dmodels.ValidatorView) smodels.StakeWeighted {
+	byID := make(map[string]*dmodels.ValidatorView, len(validators))
+	for _, v := range validators {
+		if v != nil {
+			byID[v.ID] = v
+		}
+	}
+
+	apy := make([]decimal.Decimal, 0, len(pvd))
+	score := make([]decimal.Decimal, 0, len(pvd))
+	skipped := make([]decimal.Decimal, 0, len(pvd))
+	stakes := make([]uint64, 0, len(pvd))
+	for _, d := range pvd {
+		v, ok := byID[d.ValidatorID]
+		if !ok {
+			continue
+		}
+		apy = append(apy, v.APY)
+		score = append(score, decimal.NewFromInt(v.Score))
+		skipped = append(skipped, v.SkippedSlots)
+		stakes = append(stakes, d.ActiveStake)
+	}
+
+	return smodels.StakeWeighted{
+		APY:          weightedAverage(apy, stakes),
+		Score:        weightedAverage(score, stakes),
+		SkippedSlots: weightedAverage(skipped, stakes),
+	}
+}
@@ -109,3 +109,12 @@ func rep(f func() error, t uint64, timeout time.Duration) error {
 	}
 	return err
 }
+
+func contains(arr []string, v string) bool {
+	for _, a := range arr {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
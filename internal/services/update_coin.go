@@ -1,30 +1,64 @@
 package services
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/price"
+	"time"
+)
 
+// UpdateCoins prices every coin from all providers. Coins without a fresh quote keep their last price,
+// and a failing provider does not stop the others.
 func (s Imp) UpdateCoins() error {
 	coins, err := s.DAO.GetCoins(nil)
 	if err != nil {
 		return fmt.Errorf("DAO.GetCoins: %w", err)
 	}
 
+	providers, err := s.priceProviders(coins)
+	if err != nil {
+		return err
+	}
+
+	assets := make([]price.Asset, len(coins))
+	for i, coin := range coins {
+		assets[i] = price.Asset{ID: coin.ID.String(), GeckoKey: coin.GeckoKey, Mint: dex.NormalizeMint(coin.Address)}
+	}
+	quotes, errs := price.Collect(providers, assets)
+
+	now := time.Now()
+	history := make([]*dmodels.PriceHistory, 0, len(coins))
 	for _, coin := range coins {
-		if coin.GeckoKey == "null" {
+		if q, ok := price.Median(quotes[coin.ID.String()], s.priceStaleAfter(), now); ok {
+			coin.USD = q.USD
+			coin.PriceUpdatedAt = &q.UpdatedAt
+			coin.PriceSources = q.Source
+			history = append(history, &dmodels.PriceHistory{
+				AssetType: dmodels.PriceAssetCoin,
+				AssetID:   coin.ID,
+				USD:       q.USD,
+				Sources:   q.Source,
+				CreatedAt: now,
+			})
+		}
+
+		// Images rarely change, so CoinGecko is only asked for them until they are set.
+		if coin.GeckoKey == "null" || coin.LargeImage != "NaN" {
 			continue
 		}
 		c, err := s.coinGecko.CoinsID(coin.GeckoKey,
 			false,
 			false,
-			true,
+			false,
 			false,
 			false,
 			false)
 		if err != nil {
-			return fmt.Errorf("coinGecko.CoinsID(%s): %w", coin.GeckoKey, err)
+			errs[fmt.Sprintf("coingecko images (%s)", coin.GeckoKey)] = err
+			continue
 		}
-
-		coin.USD = c.MarketData.CurrentPrice["usd"]
-
 		coin.LargeImage = c.Image.Large
 		coin.ThumbImage = c.Image.Thumb
 		coin.SmallImage = c.Image.Small
@@ -33,6 +67,11 @@ func (s Imp) UpdateCoins() error {
 	if err := s.DAO.SaveCoin(coins...); err != nil {
 		return fmt.Errorf("DAO.SaveCoin: %w", err)
 	}
+	if err := s.DAO.CreatePriceHistory(history...); err != nil {
+		return fmt.Errorf("DAO.CreatePriceHistory: %w", err)
+	}
+	s.Events.Publish(events.Event{Topic: events.TopicCoins})
+	s.touch(events.TopicCoins)
 
-	return nil
+	return providerErrors(errs)
 }
@@ -0,0 +1,113 @@
+package services_test
+
+import (
+	"errors"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/pkg/price"
+	uuid "github.com/satori/go.uuid"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+type priceProviderMock struct {
+	name   string
+	quotes map[string]price.Quote
+	err    error
+}
+
+func (p priceProviderMock) Name() string { return p.name }
+
+func (p priceProviderMock) Quotes(assets []price.Asset) (map[string]price.Quote, error) {
+	return p.quotes, p.err
+}
+
+func TestUpdateCoins(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	lastUpdate := now.Add(-3 * time.Hour)
+	mSOL := &dmodels.Coin{ID: uuid.NewV4(), Name: "mSOL", GeckoKey: "msol", Address: "mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So", LargeImage: "img"}
+	usdc := &dmodels.Coin{ID: uuid.NewV4(), Name: "USDC", GeckoKey: "usd-coin", Address: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", USD: 1, LargeImage: "img"}
+	raydium := &dmodels.LiquidityPool{ID: uuid.NewV4(), Name: "Raydium", Status: dmodels.LiquidityPoolStatusOK, SyncedAt: &now}
+
+	data := map[string]struct {
+		Gecko   priceProviderMock
+		Result  map[string]float64
+		History map[uuid.UUID]float64
+		Err     bool
+	}{
+		"median of gecko and dex": {
+			Gecko: priceProviderMock{name: "coingecko", quotes: map[string]price.Quote{
+				mSOL.ID.String(): {Source: "coingecko", USD: 102, UpdatedAt: now},
+				usdc.ID.String(): {Source: "coingecko", USD: 1, UpdatedAt: now},
+			}},
+			Result:  map[string]float64{"mSOL": 103, "USDC": 1},
+			History: map[uuid.UUID]float64{mSOL.ID: 103, usdc.ID: 1},
+		},
+		"gecko rate limited falls back to dex": {
+			Gecko:   priceProviderMock{name: "coingecko", err: errors.New("429 Too Many Requests")},
+			Result:  map[string]float64{"mSOL": 104, "USDC": 1},
+			History: map[uuid.UUID]float64{mSOL.ID: 104},
+			Err:     true,
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			saved := map[string]*dmodels.Coin{}
+			var history []*dmodels.PriceHistory
+			d := services.Imp{
+				PriceProviders: []price.Provider{s2.Gecko},
+				DAO: &dao.PostgresMock{
+					TouchDataVersionsFunc: func(types ...string) error {
+						return nil
+					},
+					GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+						m, u := *mSOL, *usdc
+						u.PriceUpdatedAt = &lastUpdate
+						return []*dmodels.Coin{&m, &u}, nil
+					},
+					GetLiquidityPoolsFunc: func(cond *postgres.Condition) ([]*dmodels.LiquidityPool, error) {
+						assert.DeepEqual(t, cond.Names, []string{"Raydium", "Orca"})
+						return []*dmodels.LiquidityPool{raydium}, nil
+					},
+					GetDEFIsFunc: func(cond *postgres.DeFiCondition) ([]*dmodels.DEFI, error) {
+						assert.DeepEqual(t, cond.LiquidityPoolIDs, []uuid.UUID{raydium.ID})
+						return []*dmodels.DEFI{
+							{LiquidityPoolID: raydium.ID, SaleCoinID: mSOL.ID, BuyCoinID: usdc.ID, Price: 104, Liquidity: 50000},
+						}, nil
+					},
+					CreatePriceHistoryFunc: func(h ...*dmodels.PriceHistory) error {
+						history = h
+						return nil
+					},
+					SaveCoinFunc: func(coins ...*dmodels.Coin) error {
+						for _, c := range coins {
+							saved[c.Name] = c
+						}
+						return nil
+					},
+				},
+			}
+
+			err := d.UpdateCoins()
+			assert.Equal(t, err != nil, s2.Err)
+			for name, usd := range s2.Result {
+				assert.Equal(t, saved[name].USD, usd)
+			}
+			assert.Equal(t, *saved["mSOL"].PriceUpdatedAt, now)
+			prices := map[uuid.UUID]float64{}
+			for _, h := range history {
+				assert.Equal(t, h.AssetType, dmodels.PriceAssetCoin)
+				prices[h.AssetID] = h.USD
+			}
+			assert.DeepEqual(t, prices, s2.History)
+			if s2.Err {
+				assert.Equal(t, saved["mSOL"].PriceSources, "dex")
+				assert.Equal(t, *saved["USDC"].PriceUpdatedAt, lastUpdate)
+			}
+		})
+	}
+}
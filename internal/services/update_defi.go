@@ -4,184 +4,59 @@ import (
 	"fmt"
 	"github.com/everstake/solana-pools/internal/dao/dmodels"
 	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/pkg/dex"
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
 	"strings"
+	"time"
 )
 
+// UpdateDeFi runs the source of every liquidity pool independently, so one failing DEX does not
+// block the others, and records the outcome on the liquidity pool.
 func (s Imp) UpdateDeFi() error {
-
-	if err := updateRaydium(&s); err != nil {
-		return fmt.Errorf("updateRaydium() %w", err)
-	}
-	if err := updateOrca(&s); err != nil {
-		return fmt.Errorf("updateOrca() %w", err)
-	}
-	if err := updateAtrix(&s); err != nil {
-		return fmt.Errorf("updateAtrix() %w", err)
-	}
-	if err := updateSaber(&s); err != nil {
-		return fmt.Errorf("updateSaber() %w", err)
-	}
-
-	return nil
-}
-
-func updateOrca(s *Imp) error {
-	pool, err := s.DAO.GetLiquidityPool(&postgres.Condition{Names: []string{"Orca"}})
-	if err != nil {
-		return err
-	}
-	if pool == nil {
-		return nil
-	}
-
-	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Network: postgres.MainNet}})
+	lps, err := s.DAO.GetLiquidityPools(nil)
 	if err != nil {
-		return fmt.Errorf("DAO.GetPools: %w", err)
-	}
-
-	ids := make([]uuid.UUID, len(pools))
-	for i, pool := range pools {
-		ids[i] = pool.CoinID
-	}
-
-	poolCoins, err := s.DAO.GetCoins(&postgres.CoinCondition{
-		Condition: &postgres.Condition{
-			IDs: ids,
-		},
-	})
-	if err != nil {
-		return fmt.Errorf("DAO.GetCoins: %w", err)
-	}
-
-	coins, err := s.DAO.GetCoins(nil)
-	if err != nil {
-		return err
-	}
-
-	orca, err := s.orca.GetPools()
-	if err != nil {
-		return err
+		return fmt.Errorf("DAO.GetLiquidityPools: %w", err)
 	}
 
-	defis := make([]*dmodels.DEFI, 0)
-	for _, poolCoin := range poolCoins {
-		for _, o := range orca {
-			if strings.Contains(o.Name, fmt.Sprintf("%s/", poolCoin.Name)) {
-				for _, d := range coins {
-					if strings.Contains(o.Name, fmt.Sprintf("/%s", d.Name)) {
-						apy := decimal.NewFromInt(0)
-						if o.Apy24H != nil {
-							apy = decimal.NewFromFloat(*o.Apy24H)
-						}
-						defis = append(defis, &dmodels.DEFI{
-							LiquidityPoolID: pool.ID,
-							SaleCoinID:      poolCoin.ID,
-							BuyCoinID:       d.ID,
-							Liquidity:       o.Liquidity,
-							APY:             apy,
-						})
-					}
-				}
-			}
+	var failed []string
+	for _, lp := range lps {
+		source, ok := s.DeFiSources[lp.Name]
+		if !ok {
+			continue
 		}
 
-	}
-
-	if err := s.DAO.DeleteDeFis(&postgres.DeFiCondition{LiquidityPoolIDs: []uuid.UUID{pool.ID}}); err != nil {
-		return err
-	}
-
-	if err := s.DAO.SaveDEFIs(defis...); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func updateRaydium(s *Imp) error {
-	pool, err := s.DAO.GetLiquidityPool(&postgres.Condition{Names: []string{"Raydium"}})
-	if err != nil {
-		return err
-	}
-	if pool == nil {
-		return nil
-	}
-
-	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Network: postgres.MainNet}})
-	if err != nil {
-		return fmt.Errorf("DAO.GetPools: %w", err)
-	}
-
-	ids := make([]uuid.UUID, len(pools))
-	for i, pool := range pools {
-		ids[i] = pool.CoinID
-	}
-
-	poolCoins, err := s.DAO.GetCoins(&postgres.CoinCondition{
-		Condition: &postgres.Condition{
-			IDs: ids,
-		},
-	})
-	if err != nil {
-		return fmt.Errorf("DAO.GetCoins: %w", err)
-	}
-
-	coins, err := s.DAO.GetCoins(nil)
-	if err != nil {
-		return err
-	}
-
-	raydium, err := s.raydium.GetPairs("")
-	if err != nil {
-		return err
-	}
-
-	defis := make([]*dmodels.DEFI, 0)
-	for _, poolCoin := range poolCoins {
-		for _, paris := range raydium {
-			if strings.Contains(paris.PairID, fmt.Sprintf("%s-", poolCoin.Address)) {
-				for _, d := range coins {
-					address := d.Address
-					if strings.Contains(address, "11111111111111111111111111111111") {
-						address = "So11111111111111111111111111111111"
-					}
-					if strings.Contains(paris.PairID, fmt.Sprintf("-%s", address)) {
-						defis = append(defis, &dmodels.DEFI{
-							LiquidityPoolID: pool.ID,
-							SaleCoinID:      poolCoin.ID,
-							BuyCoinID:       d.ID,
-							Liquidity:       paris.Liquidity,
-							APY:             decimal.NewFromFloat(paris.Apy).Div(decimal.NewFromInt(100)),
-						})
-					}
-				}
-			}
+		now := time.Now()
+		lp.Status, lp.Error, lp.SyncedAt = dmodels.LiquidityPoolStatusOK, "", &now
+		if err := updateDEX(&s, lp, source); err != nil {
+			lp.Status, lp.Error = dmodels.LiquidityPoolStatusFailed, err.Error()
+			failed = append(failed, fmt.Sprintf("%s: %s", lp.Name, err))
+		}
+		if err := s.DAO.UpdateLiquidityPoolStatus(lp); err != nil {
+			return fmt.Errorf("DAO.UpdateLiquidityPoolStatus: %w", err)
+		}
+		if lp.Status == dmodels.LiquidityPoolStatusOK {
+			s.Events.Publish(events.Event{Topic: events.LiquidityPoolTopic(lp.Name)})
 		}
-
 	}
 
-	if err := s.DAO.DeleteDeFis(&postgres.DeFiCondition{LiquidityPoolIDs: []uuid.UUID{pool.ID}}); err != nil {
-		return err
+	// the pegs are read from the saved markets, so the version changes once both are written
+	err = updatePegs(&s)
+	s.touch(events.TypeLiquidityPool, DataPegs)
+	if err != nil {
+		return fmt.Errorf("updatePegs() %w", err)
 	}
-
-	if err := s.DAO.SaveDEFIs(defis...); err != nil {
-		return err
+	if len(failed) != 0 {
+		return fmt.Errorf("updateDEX() %s", strings.Join(failed, "; "))
 	}
 
 	return nil
 }
 
-func updateAtrix(s *Imp) error {
-	pool, err := s.DAO.GetLiquidityPool(&postgres.Condition{Names: []string{"Atrix"}})
-	if err != nil {
-		return err
-	}
-	if pool == nil {
-		return nil
-	}
-
+// updateDEX replaces the DeFi rows of the liquidity pool with the markets trading a pool coin against a known coin
+// and the lending reserves of pool coins. Markets are matched on mint addresses in both directions.
+func updateDEX(s *Imp, pool *dmodels.LiquidityPool, source dex.Source) error {
 	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Network: postgres.MainNet}})
 	if err != nil {
 		return fmt.Errorf("DAO.GetPools: %w", err)
@@ -202,113 +77,58 @@ func updateAtrix(s *Imp) error {
 	}
 
 	coins, err := s.DAO.GetCoins(nil)
-	if err != nil {
-		return err
-	}
-
-	atrix, err := s.atrix.GetTVL()
-	if err != nil {
-		return err
-	}
-
-	defis := make([]*dmodels.DEFI, 0)
-	for _, poolCoin := range poolCoins {
-		for _, v := range atrix.Pools {
-			if v.CoinMint == poolCoin.Address {
-				for _, d := range coins {
-					if d.Address == v.PCMint {
-						defis = append(defis, &dmodels.DEFI{
-							LiquidityPoolID: pool.ID,
-							SaleCoinID:      poolCoin.ID,
-							BuyCoinID:       d.ID,
-							Liquidity:       v.Tvl,
-							APY:             decimal.NewFromInt(0),
-						})
-					}
-				}
-			}
-		}
-
-	}
-
-	if err := s.DAO.DeleteDeFis(&postgres.DeFiCondition{LiquidityPoolIDs: []uuid.UUID{pool.ID}}); err != nil {
-		return err
-	}
-
-	if err := s.DAO.SaveDEFIs(defis...); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func updateSaber(s *Imp) error {
-	pool, err := s.DAO.GetLiquidityPool(&postgres.Condition{Names: []string{"Saber"}})
-	if err != nil {
-		return err
-	}
-	if pool == nil {
-		return nil
-	}
-
-	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Network: postgres.MainNet}})
-	if err != nil {
-		return fmt.Errorf("DAO.GetPools: %w", err)
-	}
-
-	ids := make([]uuid.UUID, len(pools))
-	for i, pool := range pools {
-		ids[i] = pool.CoinID
-	}
-
-	poolCoins, err := s.DAO.GetCoins(&postgres.CoinCondition{
-		Condition: &postgres.Condition{
-			IDs: ids,
-		},
-	})
 	if err != nil {
 		return fmt.Errorf("DAO.GetCoins: %w", err)
 	}
 
-	coins, err := s.DAO.GetCoins(nil)
-	if err != nil {
-		return err
+	coinsByMint := make(map[string]*dmodels.Coin, len(coins))
+	mints := make([]string, 0, len(coins))
+	sourceCoins := make([]dex.Coin, 0, len(coins))
+	for _, c := range coins {
+		coinsByMint[dex.NormalizeMint(c.Address)] = c
+		mints = append(mints, c.Address)
+		sourceCoins = append(sourceCoins, dex.Coin{Symbol: c.Name, Mint: c.Address, USD: c.USD})
 	}
 
-	saber, err := s.saber.GetPools()
+	pairs, err := source.Pairs(sourceCoins)
 	if err != nil {
 		return err
 	}
 
+	now := time.Now()
 	defis := make([]*dmodels.DEFI, 0)
+	history := make([]*dmodels.DEFIHistory, 0)
 	for _, poolCoin := range poolCoins {
-		for _, v := range saber {
-			if v.Coin.Address == poolCoin.Address {
-				for _, d := range coins {
-					if d.Address == v.PC.Address {
-						defis = append(defis, &dmodels.DEFI{
-							LiquidityPoolID: pool.ID,
-							SaleCoinID:      poolCoin.ID,
-							BuyCoinID:       d.ID,
-							Liquidity:       v.Stats.TvlCoin*poolCoin.USD + v.Stats.TvlPC*d.USD,
-							APY: decimal.NewFromFloat(v.Stats.Vol24H * d.USD).
-								Mul(decimal.NewFromFloat(0.0004)).
-								Div(decimal.NewFromFloat(v.Stats.TvlCoin*poolCoin.USD + v.Stats.TvlPC*d.USD)).
-								Mul(decimal.NewFromInt(365)),
-						})
-					}
-				}
+		for _, p := range dex.Match(pairs, poolCoin.Address, mints) {
+			d := &dmodels.DEFI{
+				LiquidityPoolID: pool.ID,
+				SaleCoinID:      poolCoin.ID,
+				BuyCoinID:       coinsByMint[p.Quote].ID,
+				Liquidity:       p.Liquidity,
+				Price:           p.Price,
+				Volume24H:       p.Volume24H,
+				APY:             decimal.NewFromFloat(p.APY),
+				FeeAPR:          decimal.NewFromFloat(p.FeeAPR),
+				RewardAPR:       decimal.NewFromFloat(p.RewardAPR),
 			}
+			defis = append(defis, d)
+			history = append(history, &dmodels.DEFIHistory{
+				LiquidityPoolID: d.LiquidityPoolID,
+				SaleCoinID:      d.SaleCoinID,
+				BuyCoinID:       d.BuyCoinID,
+				Liquidity:       d.Liquidity,
+				Price:           d.Price,
+				Volume24H:       d.Volume24H,
+				APY:             d.APY,
+				FeeAPR:          d.FeeAPR,
+				RewardAPR:       d.RewardAPR,
+				CreatedAt:       now,
+			})
 		}
-
 	}
 
-	if err := s.DAO.DeleteDeFis(&postgres.DeFiCondition{LiquidityPoolIDs: []uuid.UUID{pool.ID}}); err != nil {
-		return err
-	}
-
-	if err := s.DAO.SaveDEFIs(defis...); err != nil {
-		return err
+	if err := s.DAO.ReplaceDEFIs(pool.ID, defis, history); err != nil {
+		return fmt.Errorf("DAO.ReplaceDEFIs: %w", err)
 	}
 
 	return nil
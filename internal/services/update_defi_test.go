@@ -0,0 +1,130 @@
+package services_test
+
+import (
+	"errors"
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/events"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/pkg/dex"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"gotest.tools/assert"
+	"strings"
+	"testing"
+)
+
+type sourceMock struct {
+	pairs []dex.Pair
+	err   error
+}
+
+func (m sourceMock) Pairs(coins []dex.Coin) ([]dex.Pair, error) {
+	return m.pairs, m.err
+}
+
+func TestUpdateDeFi(t *testing.T) {
+	mSOL := &dmodels.Coin{ID: uuid.NewV4(), Name: "mSOL", Address: "mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So"}
+	sol := &dmodels.Coin{ID: uuid.NewV4(), Name: "SOL", Address: "11111111111111111111111111111111"}
+	raydium := &dmodels.LiquidityPool{ID: uuid.NewV4(), Name: "Raydium"}
+	solend := &dmodels.LiquidityPool{ID: uuid.NewV4(), Name: "Solend"}
+	unknown := &dmodels.LiquidityPool{ID: uuid.NewV4(), Name: "Unknown"}
+
+	data := map[string]struct {
+		Sources  map[string]dex.Source
+		Replaced map[uuid.UUID][]*dmodels.DEFI
+		Status   map[string]string
+		Err      bool
+	}{
+		"all sources succeed": {
+			Sources: map[string]dex.Source{
+				"Raydium": sourceMock{pairs: []dex.Pair{{ID: "a", Base: mSOL.Address, Quote: dex.SOLMint, Price: 1.02, Liquidity: 1000, APY: 0.1, FeeAPR: 0.04, RewardAPR: 0.06}}},
+				"Solend":  sourceMock{pairs: []dex.Pair{{ID: "r", Base: mSOL.Address, Quote: mSOL.Address, Liquidity: 500, APY: 0.055, Lending: true}}},
+			},
+			Replaced: map[uuid.UUID][]*dmodels.DEFI{
+				raydium.ID: {{
+					LiquidityPoolID: raydium.ID,
+					SaleCoinID:      mSOL.ID,
+					BuyCoinID:       sol.ID,
+					Price:           1.02,
+					Liquidity:       1000,
+					APY:             decimal.NewFromFloat(0.1),
+					FeeAPR:          decimal.NewFromFloat(0.04),
+					RewardAPR:       decimal.NewFromFloat(0.06),
+				}},
+				solend.ID: {{LiquidityPoolID: solend.ID, SaleCoinID: mSOL.ID, BuyCoinID: mSOL.ID, Liquidity: 500, APY: decimal.NewFromFloat(0.055)}},
+			},
+			Status: map[string]string{"Raydium": dmodels.LiquidityPoolStatusOK, "Solend": dmodels.LiquidityPoolStatusOK},
+		},
+		"failing source does not block others": {
+			Sources: map[string]dex.Source{
+				"Raydium": sourceMock{err: errors.New("timeout")},
+				"Solend":  sourceMock{pairs: []dex.Pair{{ID: "r", Base: mSOL.Address, Quote: mSOL.Address, Liquidity: 500, APY: 0.055, Lending: true}}},
+			},
+			Replaced: map[uuid.UUID][]*dmodels.DEFI{
+				solend.ID: {{LiquidityPoolID: solend.ID, SaleCoinID: mSOL.ID, BuyCoinID: mSOL.ID, Liquidity: 500, APY: decimal.NewFromFloat(0.055)}},
+			},
+			Status: map[string]string{"Raydium": dmodels.LiquidityPoolStatusFailed, "Solend": dmodels.LiquidityPoolStatusOK},
+			Err:    true,
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			replaced := map[uuid.UUID][]*dmodels.DEFI{}
+			status := map[string]string{}
+			var writes []string
+			d := services.Imp{
+				DeFiSources: s2.Sources,
+				DAO: &dao.PostgresMock{
+					TouchDataVersionsFunc: func(types ...string) error {
+						writes = append(writes, strings.Join(types, ","))
+						return nil
+					},
+					GetLiquidityPoolsFunc: func(cond *postgres.Condition) ([]*dmodels.LiquidityPool, error) {
+						return []*dmodels.LiquidityPool{
+							{ID: raydium.ID, Name: raydium.Name},
+							{ID: solend.ID, Name: solend.Name},
+							{ID: unknown.ID, Name: unknown.Name},
+						}, nil
+					},
+					GetPoolsFunc: func(condition *postgres.PoolCondition) ([]*dmodels.Pool, error) {
+						return []*dmodels.Pool{{ID: uuid.NewV4(), Name: "marinade", CoinID: mSOL.ID}}, nil
+					},
+					GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+						if cond != nil {
+							return []*dmodels.Coin{mSOL}, nil
+						}
+						return []*dmodels.Coin{mSOL, sol}, nil
+					},
+					ReplaceDEFIsFunc: func(liquidityPoolID uuid.UUID, defiData []*dmodels.DEFI, history []*dmodels.DEFIHistory) error {
+						assert.Equal(t, len(history), len(defiData))
+						replaced[liquidityPoolID] = defiData
+						return nil
+					},
+					UpdateLiquidityPoolStatusFunc: func(pool *dmodels.LiquidityPool) error {
+						assert.Assert(t, pool.SyncedAt != nil)
+						assert.Equal(t, pool.Error == "", pool.Status == dmodels.LiquidityPoolStatusOK)
+						status[pool.Name] = pool.Status
+						return nil
+					},
+					GetLastPoolDataFunc: func(PoolID uuid.UUID) (*dmodels.PoolData, error) {
+						return nil, nil
+					},
+					CreatePoolPegFunc: func(pegs ...*dmodels.PoolPeg) error {
+						writes = append(writes, "pegs saved")
+						return nil
+					},
+				},
+			}
+
+			err := d.UpdateDeFi()
+			// the version must not change before the pegs are saved
+			assert.DeepEqual(t, writes, []string{"pegs saved", events.TypeLiquidityPool + "," + services.DataPegs})
+			assert.Equal(t, err != nil, s2.Err)
+			assert.DeepEqual(t, status, s2.Status)
+			assert.DeepEqual(t, replaced, s2.Replaced)
+		})
+	}
+}
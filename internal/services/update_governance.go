@@ -1,45 +1,183 @@
 package services
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"github.com/everstake/solana-pools/config"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	solana_sdk "github.com/everstake/solana-pools/pkg/extension/solana-sdk"
+	"github.com/everstake/solana-pools/pkg/price"
+	"github.com/portto/solana-go-sdk/common"
+	"math"
+	"net/url"
+	"strings"
+	"time"
 )
 
+// geckoBaseURL is the CoinGecko API root the go-gecko client uses.
+const geckoBaseURL = "https://api.coingecko.com/api/v3"
+
+// inflationWindow is how far back the circulating supply is compared to derive the inflation rate.
+const inflationWindow = 30 * 24 * time.Hour
+
 func (s Imp) UpdateGovernance() error {
 	gov, err := s.DAO.GetGovernance(nil)
 	if err != nil {
 		return fmt.Errorf("UpdateGovernance: %w", err)
 	}
 
+	assets := make([]price.Asset, len(gov))
+	for i, governance := range gov {
+		assets[i] = price.Asset{ID: governance.ID.String(), GeckoKey: governance.GeckoKey, Mint: governance.ContractAddress}
+	}
+	providers, err := s.storedPriceProviders()
+	if err != nil {
+		return fmt.Errorf("UpdateGovernance: %w", err)
+	}
+	quotes, errs := price.Collect(providers, assets)
+
+	now := time.Now()
+	history := make([]*dmodels.PriceHistory, 0, len(gov))
+	supply := make([]*dmodels.GovernanceSupply, 0, len(gov))
 	for _, governance := range gov {
-		if governance.GeckoKey == "null" {
-			continue
+		if q, ok := price.Median(quotes[governance.ID.String()], s.priceStaleAfter(), now); ok {
+			governance.USD = q.USD
+			governance.PriceUpdatedAt = &q.UpdatedAt
+			governance.PriceSources = q.Source
+			history = append(history, &dmodels.PriceHistory{
+				AssetType: dmodels.PriceAssetGovernance,
+				AssetID:   governance.ID,
+				USD:       q.USD,
+				Sources:   q.Source,
+				CreatedAt: now,
+			})
 		}
-		coin, err := s.coinGecko.CoinsID(governance.GeckoKey,
-			false,
-			false,
-			true,
-			false,
-			false,
-			false)
 
-		if err != nil {
-			return fmt.Errorf("UpdateGovernance: %w", err)
+		if governance.GeckoKey != "null" {
+			if err := s.updateGovernanceSupply(governance); err != nil {
+				errs[fmt.Sprintf("coingecko supply (%s)", governance.GeckoKey)] = err
+			}
 		}
 
-		if coin.MarketData.TotalSupply != nil {
-			governance.MaximumTokenSupply = *coin.MarketData.TotalSupply
+		if strings.EqualFold(governance.Blockchain, "solana") {
+			onChain, err := s.onChainSupply(governance.ContractAddress)
+			if err != nil {
+				errs[fmt.Sprintf("on-chain supply (%s)", governance.ContractAddress)] = err
+			} else {
+				governance.OnChainSupply = onChain
+			}
+			holders, err := s.tokenHolders(governance.ContractAddress)
+			if err != nil {
+				errs[fmt.Sprintf("holders (%s)", governance.ContractAddress)] = err
+			} else {
+				governance.Holders = holders
+			}
 		}
 
-		governance.CirculatingSupply = coin.MarketData.CirculatingSupply
-
-		governance.USD = coin.MarketData.CurrentPrice["usd"]
+		prev, err := s.DAO.GetGovernanceSupplyAt(governance.ID, now.Add(-inflationWindow))
+		if err != nil {
+			return fmt.Errorf("UpdateGovernance: %w", err)
+		}
+		governance.InflationRate = inflationRate(prev, governance.CirculatingSupply, now)
 
-		governance.Image = coin.Image.Large
+		supply = append(supply, &dmodels.GovernanceSupply{
+			GovernanceID:       governance.ID,
+			MaximumTokenSupply: governance.MaximumTokenSupply,
+			TotalSupply:        governance.TotalSupply,
+			CirculatingSupply:  governance.CirculatingSupply,
+			OnChainSupply:      governance.OnChainSupply,
+			Holders:            governance.Holders,
+			USD:                governance.USD,
+			CreatedAt:          now,
+		})
 	}
 
 	if err := s.DAO.SaveGovernance(gov...); err != nil {
 		return fmt.Errorf("UpdateGovernance: %w", err)
 	}
+	if err := s.DAO.CreatePriceHistory(history...); err != nil {
+		return fmt.Errorf("UpdateGovernance: %w", err)
+	}
+	if err := s.DAO.CreateGovernanceSupply(supply...); err != nil {
+		return fmt.Errorf("UpdateGovernance: %w", err)
+	}
+	s.touch(DataGovernance)
+
+	if err := providerErrors(errs); err != nil {
+		return fmt.Errorf("UpdateGovernance: %w", err)
+	}
+	return nil
+}
+
+// geckoCoinSupply is the part of the CoinGecko /coins/{id} response the governance update reads.
+// go-gecko does not decode max_supply, so the response is decoded here.
+type geckoCoinSupply struct {
+	Image struct {
+		Large string `json:"large"`
+	} `json:"image"`
+	MarketData struct {
+		TotalSupply       *float64 `json:"total_supply"`
+		MaxSupply         *float64 `json:"max_supply"`
+		CirculatingSupply float64  `json:"circulating_supply"`
+	} `json:"market_data"`
+}
+
+func (s Imp) updateGovernanceSupply(governance *dmodels.Governance) error {
+	params := url.Values{}
+	params.Add("localization", "false")
+	params.Add("tickers", "false")
+	params.Add("market_data", "true")
+	params.Add("community_data", "false")
+	params.Add("developer_data", "false")
+	params.Add("sparkline", "false")
+	body, err := s.coinGecko.MakeReq(fmt.Sprintf("%s/coins/%s?%s", geckoBaseURL, url.PathEscape(governance.GeckoKey), params.Encode()))
+	if err != nil {
+		return err
+	}
+	var coin geckoCoinSupply
+	if err := json.Unmarshal(body, &coin); err != nil {
+		return err
+	}
+
+	// An uncapped token has no max_supply; FDV then falls back to the total supply.
+	governance.MaximumTokenSupply = 0
+	if coin.MarketData.MaxSupply != nil {
+		governance.MaximumTokenSupply = *coin.MarketData.MaxSupply
+	}
+	if coin.MarketData.TotalSupply != nil {
+		governance.TotalSupply = *coin.MarketData.TotalSupply
+	}
+
+	governance.CirculatingSupply = coin.MarketData.CirculatingSupply
 
+	governance.Image = coin.Image.Large
 	return nil
 }
+
+// tokenHolders counts the token accounts of the SPL mint that hold a non-zero balance.
+func (s Imp) tokenHolders(mint string) (uint64, error) {
+	return solana_sdk.GetTokenHolders(s.rpcClients[config.Mainnet].RpcClient.Call(context.Background(), "getProgramAccounts",
+		common.TokenProgramID.ToBase58(), solana_sdk.TokenHoldersConfig(mint)))
+}
+
+// onChainSupply returns the supply of the SPL mint in whole tokens.
+func (s Imp) onChainSupply(mint string) (float64, error) {
+	amount, decimals, err := s.rpcClients[config.Mainnet].GetTokenSupply(context.Background(), mint)
+	if err != nil {
+		return 0, err
+	}
+	return float64(amount) / math.Pow10(int(decimals)), nil
+}
+
+// inflationRate annualizes the change of the circulating supply since prev.
+func inflationRate(prev *dmodels.GovernanceSupply, circulating float64, now time.Time) float64 {
+	if prev == nil || prev.CirculatingSupply <= 0 || circulating <= 0 {
+		return 0
+	}
+	elapsed := now.Sub(prev.CreatedAt)
+	if elapsed < 24*time.Hour {
+		return 0
+	}
+	return (circulating/prev.CirculatingSupply - 1) * float64(365*24*time.Hour) / float64(elapsed)
+}
@@ -0,0 +1,82 @@
+package services_test
+
+import (
+	"github.com/everstake/solana-pools/internal/dao"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/dao/postgres"
+	"github.com/everstake/solana-pools/internal/services"
+	"github.com/everstake/solana-pools/pkg/price"
+	uuid "github.com/satori/go.uuid"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestUpdateGovernance(t *testing.T) {
+	now := time.Now()
+	gov := &dmodels.Governance{ID: uuid.NewV4(), Name: "gov", GeckoKey: "null", Blockchain: "ethereum", MaximumTokenSupply: 2000000, CirculatingSupply: 1000000, USD: 2}
+
+	data := map[string]struct {
+		Prev      *dmodels.GovernanceSupply
+		Inflation float64
+	}{
+		"no history": {},
+		"supply unlocked": {
+			Prev:      &dmodels.GovernanceSupply{GovernanceID: gov.ID, CirculatingSupply: 800000, CreatedAt: now.AddDate(0, 0, -73)},
+			Inflation: 1.25,
+		},
+		"history too recent": {
+			Prev: &dmodels.GovernanceSupply{GovernanceID: gov.ID, CirculatingSupply: 800000, CreatedAt: now.Add(-time.Hour)},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			var saved *dmodels.Governance
+			var supply []*dmodels.GovernanceSupply
+			d := services.Imp{
+				PriceProviders: []price.Provider{priceProviderMock{name: "coingecko", quotes: map[string]price.Quote{
+					gov.ID.String(): {Source: "coingecko", USD: 3, UpdatedAt: now},
+				}}},
+				DAO: &dao.PostgresMock{
+					TouchDataVersionsFunc: func(types ...string) error {
+						return nil
+					},
+					GetCoinsFunc: func(cond *postgres.CoinCondition) ([]*dmodels.Coin, error) {
+						return nil, nil
+					},
+					GetLiquidityPoolsFunc: func(cond *postgres.Condition) ([]*dmodels.LiquidityPool, error) {
+						return nil, nil
+					},
+					GetGovernanceFunc: func(cond *postgres.GovernanceCondition) ([]*dmodels.Governance, error) {
+						g := *gov
+						return []*dmodels.Governance{&g}, nil
+					},
+					GetGovernanceSupplyAtFunc: func(governanceID uuid.UUID, at time.Time) (*dmodels.GovernanceSupply, error) {
+						assert.Equal(t, governanceID, gov.ID)
+						return s2.Prev, nil
+					},
+					SaveGovernanceFunc: func(g ...*dmodels.Governance) error {
+						saved = g[0]
+						return nil
+					},
+					CreatePriceHistoryFunc: func(history ...*dmodels.PriceHistory) error {
+						return nil
+					},
+					CreateGovernanceSupplyFunc: func(s ...*dmodels.GovernanceSupply) error {
+						supply = s
+						return nil
+					},
+				},
+			}
+
+			assert.NilError(t, d.UpdateGovernance())
+			assert.Equal(t, saved.USD, float64(3))
+			assert.Assert(t, saved.InflationRate > s2.Inflation-1e-6 && saved.InflationRate < s2.Inflation+1e-6, "inflation rate %v", saved.InflationRate)
+			assert.Equal(t, len(supply), 1)
+			assert.Equal(t, supply[0].GovernanceID, gov.ID)
+			assert.Equal(t, supply[0].CirculatingSupply, gov.CirculatingSupply)
+			assert.Equal(t, supply[0].USD, float64(3))
+		})
+	}
+}
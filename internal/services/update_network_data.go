@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"github.com/everstake/solana-pools/internal/events"
 	"github.com/everstake/solana-pools/internal/services/smodels"
 	solana_sdk "github.com/everstake/solana-pools/pkg/extension/solana-sdk"
 	"github.com/shopspring/decimal"
@@ -69,5 +70,9 @@ func (s Imp) UpdateNetworkData() error {
 	APY := decimal.NewFromFloat(apy).Mul(decimal.NewFromInt(400).Div(decimal.NewFromFloat(st)))
 	s.Cache.SetAPY(APY)
 
+	s.Events.Publish(events.Event{Topic: events.TopicEpoch})
+	s.Events.Publish(events.Event{Topic: events.TopicNetworkStats})
+	s.touch(events.TopicEpoch, events.TopicNetworkStats)
+
 	return nil
 }
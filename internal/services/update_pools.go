@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/everstake/solana-pools/config"
 	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/events"
 	"github.com/everstake/solana-pools/pkg/pools"
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
@@ -43,7 +44,7 @@ func (s Imp) UpdatePools() error {
 		if !p.Active {
 			continue
 		}
-		if err := s.updatePool(p, 400/st); err != nil {
+		if err := s.updatePool(p, st); err != nil {
 			s.log.Error(
 				"Update Pools",
 				zap.String("pool_name", p.Name),
@@ -56,6 +57,16 @@ func (s Imp) UpdatePools() error {
 			success++
 		}
 	}
+	if success != 0 {
+		s.Cache.InvalidatePools()
+		for _, p := range dPools {
+			if p.Active {
+				s.Events.Publish(events.Event{Topic: events.PoolTopic(p.Name)})
+			}
+		}
+		s.Events.Publish(events.Event{Topic: events.TopicNetworkStats})
+		s.touch(events.TypePool, events.TopicNetworkStats)
+	}
 	s.log.Debug(
 		"Pools Updated",
 		zap.Uint64("success", success),
@@ -65,7 +76,7 @@ func (s Imp) UpdatePools() error {
 	return nil
 }
 
-func (s Imp) updatePool(dPool *dmodels.Pool, correlation float64) error {
+func (s Imp) updatePool(dPool *dmodels.Pool, slotTimeMS float64) error {
 	net := config.Network(dPool.Network)
 	rpcCli, ok := s.rpcClients[net]
 
@@ -73,7 +84,7 @@ func (s Imp) updatePool(dPool *dmodels.Pool, correlation float64) error {
 		return fmt.Errorf("rpc client for %s network not found", dPool.Network)
 	}
 	poolFactory := pools.NewFactory(rpcCli)
-	pool, err := poolFactory.GetPool(dPool.Name)
+	pool, err := poolFactory.GetPool(pools.ProgramOf(dPool.Program, dPool.Name))
 	if err != nil {
 		return fmt.Errorf("poolFactory.GetPool: %s", err.Error())
 	}
@@ -85,7 +96,6 @@ func (s Imp) updatePool(dPool *dmodels.Pool, correlation float64) error {
 	dmodel := &dmodels.PoolData{
 		ID:                uuid.NewV1(),
 		PoolID:            dPool.ID,
-		APY:               decimal.NewFromFloat(data.APY),
 		ActiveStake:       data.SolanaStake,
 		TotalTokensSupply: data.TotalTokenSupply,
 		TotalLamports:     data.TotalLamports,
@@ -99,7 +109,8 @@ func (s Imp) updatePool(dPool *dmodels.Pool, correlation float64) error {
 	}
 
 	validatorsPoolData := make([]*dmodels.PoolValidatorData, 0, len(data.Validators))
-	var SumValAPY decimal.Decimal
+	valAPY := make([]decimal.Decimal, 0, len(data.Validators))
+	valStake := make([]uint64, 0, len(data.Validators))
 	for _, v := range data.Validators {
 		validator, err := s.DAO.GetValidatorByVotePK(v.VotePK)
 		if err != nil {
@@ -109,7 +120,8 @@ func (s Imp) updatePool(dPool *dmodels.Pool, correlation float64) error {
 			continue
 		}
 
-		SumValAPY = SumValAPY.Add(validator.APY)
+		valAPY = append(valAPY, validator.APY)
+		valStake = append(valStake, v.ActiveStake)
 
 		validatorsPoolData = append(validatorsPoolData, &dmodels.PoolValidatorData{
 			ValidatorID: validator.ID,
@@ -118,29 +130,25 @@ func (s Imp) updatePool(dPool *dmodels.Pool, correlation float64) error {
 		})
 	}
 
-	if dmodel.APY.IsZero() {
-		d, err := s.DAO.GetLastEpochPoolData(dmodel.PoolID, dmodel.Epoch)
-		if err != nil {
-			return fmt.Errorf("DAO.UpdatePoolData: %w", err)
-		}
-		if d != nil {
-			var epochRate decimal.Decimal
-			if d.TotalLamports != 0 {
-				lastEpochPoolTokenValue := decimal.NewFromInt(int64(d.TotalLamports)).
-					Div(decimal.NewFromInt(int64(d.TotalTokensSupply)))
-				TokenValue := decimal.NewFromInt(int64(dmodel.TotalLamports)).
-					Div(decimal.NewFromInt(int64(dmodel.TotalTokensSupply)))
-				epochRate = TokenValue.Div(lastEpochPoolTokenValue).Sub(decimal.NewFromInt(1))
-				epochRate = epochRate.Div(decimal.NewFromInt(int64(dmodel.Epoch - d.Epoch)))
-			} else {
-				epochRate = decimal.NewFromInt(0)
-			}
-			dmodel.APY = epochRate.Mul(decimal.NewFromFloat(EpochsPerYear)).Mul(decimal.NewFromFloat(correlation))
-		} else {
-			dmodel.APY = decimal.NewFromInt(0)
-		}
+	rate := &dmodels.PoolExchangeRate{
+		PoolID:            dPool.ID,
+		Epoch:             dmodel.Epoch,
+		TotalTokensSupply: dmodel.TotalTokensSupply,
+		TotalLamports:     dmodel.TotalLamports,
+		Rate:              exchangeRate(dmodel.TotalLamports, dmodel.TotalTokensSupply),
+		UpdatedAt:         dmodel.UpdatedAt,
+		CreatedAt:         dmodel.CreatedAt,
+	}
+
+	// the realized exchange-rate yield is the primary pool APY, validators only fill in until there is history
+	prev, err := s.DAO.GetPoolExchangeRate(dPool.ID, dmodel.Epoch-1)
+	if err != nil {
+		return fmt.Errorf("DAO.GetPoolExchangeRate: %w", err)
+	}
+	if apy, ok := epochAPY(prev, rate, slotTimeMS); ok && !rate.Rate.IsZero() {
+		dmodel.APY = apy
 	} else {
-		dmodel.APY = SumValAPY.Div(decimal.NewFromInt(int64(len(validatorsPoolData))))
+		dmodel.APY = weightedAverage(valAPY, valStake)
 	}
 
 	dmodel.APY = dmodel.APY.Truncate(9)
@@ -152,5 +160,11 @@ func (s Imp) updatePool(dPool *dmodels.Pool, correlation float64) error {
 	if err = s.DAO.CreatePoolValidatorData(validatorsPoolData...); err != nil {
 		return fmt.Errorf("DAO.UpdateValidators: %s", err.Error())
 	}
+
+	if !rate.Rate.IsZero() {
+		if err = s.DAO.SavePoolExchangeRate(rate); err != nil {
+			return fmt.Errorf("DAO.SavePoolExchangeRate: %w", err)
+		}
+	}
 	return nil
 }
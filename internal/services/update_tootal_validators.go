@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/dfuse-io/solana-go"
 	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/events"
 	solana_sdk "github.com/everstake/solana-pools/pkg/extension/solana-sdk"
 	"github.com/everstake/solana-pools/pkg/validatorsapp"
 	uuid "github.com/satori/go.uuid"
@@ -143,6 +144,11 @@ func (s Imp) UpdateValidators() error {
 		})
 	}
 
+	prev, err := s.DAO.GetValidatorsDelinquency()
+	if err != nil {
+		return fmt.Errorf("DAO.GetValidatorsDelinquency: %w", err)
+	}
+
 	step := 100
 
 	n := int(math.Ceil(float64(len(validators)) / float64(step)))
@@ -168,5 +174,13 @@ func (s Imp) UpdateValidators() error {
 		offset += step
 	}
 
+	for _, v := range validators {
+		s.Events.Publish(events.Event{Topic: events.ValidatorTopic(v.ID)})
+	}
+	s.touch(events.TypeValidator)
+	for _, alert := range delinquencyAlerts(prev, validators, time.Now()) {
+		s.Events.Publish(events.Event{Topic: events.TopicDelinquency, Data: alert})
+	}
+
 	return nil
 }
@@ -2,12 +2,16 @@ package services
 
 import (
 	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
 	"github.com/everstake/solana-pools/internal/dao/postgres"
 	"github.com/everstake/solana-pools/internal/services/smodels"
 	uuid "github.com/satori/go.uuid"
+	"reflect"
+	"sort"
+	"strconv"
 )
 
-func (s Imp) GetPoolValidators(name string, validatorName string, sort string, desc bool, epoch uint64, limit uint64, offset uint64) ([]*smodels.PoolValidatorData, uint64, error) {
+func (s Imp) GetPoolValidators(name string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) ([]*smodels.PoolValidatorData, uint64, error) {
 	pool, err := s.DAO.GetPool(name)
 	if err != nil {
 		return nil, 0, fmt.Errorf("DAO.GetPool: %w", err)
@@ -28,6 +32,7 @@ func (s Imp) GetPoolValidators(name string, validatorName string, sort string, d
 			ValidatorDataSort: postgres.SearchValidatorDataSort(sort),
 			Desc:              desc,
 		},
+		Filter: validatorFilter(filter),
 		Condition: &postgres.Condition{
 			Name: validatorName,
 			Pagination: postgres.Pagination{
@@ -57,6 +62,7 @@ func (s Imp) GetPoolValidators(name string, validatorName string, sort string, d
 			ValidatorDataSort: postgres.SearchValidatorDataSort(sort),
 			Desc:              desc,
 		},
+		Filter: validatorFilter(filter),
 		Condition: &postgres.Condition{
 			Name: validatorName,
 		},
@@ -68,13 +74,90 @@ func (s Imp) GetPoolValidators(name string, validatorName string, sort string, d
 	return arr, uint64(count), nil
 }
 
-func (s Imp) GetAllValidators(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, limit uint64, offset uint64) ([]*smodels.Validator, uint64, error) {
+// GetPoolsValidators returns the page of validators of every pool found by name and their totals, like GetPoolValidators
+// but reading the validators of all the pools at once; a zero limit returns all of them.
+func (s Imp) GetPoolsValidators(names []string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) (map[string][]*smodels.PoolValidatorData, map[string]uint64, error) {
+	pages, totals := make(map[string][]*smodels.PoolValidatorData, len(names)), make(map[string]uint64, len(names))
+	if len(names) == 0 {
+		return pages, totals, nil
+	}
+	pools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Names: names}})
+	if err != nil {
+		return nil, nil, fmt.Errorf("DAO.GetPools: %w", err)
+	}
+	if len(pools) == 0 {
+		return pages, totals, nil
+	}
+	poolNames := make(map[uuid.UUID]string, len(pools))
+	poolIDs := make([]uuid.UUID, len(pools))
+	for i, p := range pools {
+		poolNames[p.ID], poolIDs[i] = p.Name, p.ID
+	}
+	poolData, err := s.DAO.GetLastPoolsData(poolIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DAO.GetLastPoolsData: %w", err)
+	}
+	dataPools := make(map[uuid.UUID]string, len(poolData))
+	dataIDs := make([]uuid.UUID, len(poolData))
+	for i, d := range poolData {
+		dataPools[d.ID], dataIDs[i] = poolNames[d.PoolID], d.ID
+	}
+	if len(dataIDs) == 0 {
+		return pages, totals, nil
+	}
+
+	pvd, err := s.DAO.GetPoolValidatorData(&postgres.PoolValidatorDataCondition{
+		PoolDataIDs: dataIDs,
+		Sort: &postgres.ValidatorDataSort{
+			ValidatorDataSort: postgres.SearchValidatorDataSort(sort),
+			Desc:              desc,
+		},
+		Filter:    validatorFilter(filter),
+		Condition: &postgres.Condition{Name: validatorName},
+	}, epoch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DAO.GetPoolValidatorData: %w", err)
+	}
+
+	var page []*dmodels.PoolValidatorData
+	var validatorIDs []string
+	for _, data := range pvd {
+		name := dataPools[data.PoolDataID]
+		n := totals[name]
+		totals[name]++
+		if n < offset || (limit > 0 && n >= offset+limit) {
+			continue
+		}
+		page = append(page, data)
+		validatorIDs = append(validatorIDs, data.ValidatorID)
+	}
+	if len(page) == 0 {
+		return pages, totals, nil
+	}
+
+	validators, err := s.DAO.GetValidators(&postgres.ValidatorCondition{Condition: &postgres.Condition{}, ValidatorIDs: validatorIDs}, epoch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DAO.GetValidators: %w", err)
+	}
+	byID := make(map[string]*dmodels.ValidatorView, len(validators))
+	for _, v := range validators {
+		byID[v.ID] = v
+	}
+	for _, data := range page {
+		name := dataPools[data.PoolDataID]
+		pages[name] = append(pages[name], (&smodels.PoolValidatorData{}).Set(data.ActiveStake, byID[data.ValidatorID]))
+	}
+	return pages, totals, nil
+}
+
+func (s Imp) GetAllValidators(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, filter smodels.ValidatorFilter, limit uint64, offset uint64) ([]*smodels.Validator, uint64, error) {
 	pvd, err := s.DAO.GetValidators(&postgres.ValidatorCondition{
 		Epochs: epochs,
 		Sort: &postgres.ValidatorSort{
 			ValidatorSort: postgres.SearchValidatorSort(sort),
 			Desc:          desc,
 		},
+		Filter: validatorFilter(filter),
 		Condition: &postgres.Condition{
 			Name: validatorName,
 			Pagination: postgres.Pagination{
@@ -94,6 +177,7 @@ func (s Imp) GetAllValidators(validatorName string, sort string, desc bool, epoc
 
 	count, err := s.DAO.GetValidatorCount(&postgres.ValidatorCondition{
 		Epochs: epochs,
+		Filter: validatorFilter(filter),
 		Condition: &postgres.Condition{
 			Name: validatorName,
 		},
@@ -104,3 +188,333 @@ func (s Imp) GetAllValidators(validatorName string, sort string, desc bool, epoc
 
 	return arr, uint64(count), nil
 }
+
+// GetValidatorsPage lists the validators a cursor page at a time from the snapshot of the first page, so pages
+// don't shift while UpdateValidators writes.
+func (s Imp) GetValidatorsPage(validatorName string, sort string, desc bool, epoch uint64, epochs []uint64, filter smodels.ValidatorFilter, req smodels.PageRequest) ([]*smodels.Validator, *smodels.Page, error) {
+	c, err := openCursor(req, cursor{Listing: "validators", Sort: sort, Desc: desc, Epoch: epoch,
+		Query: queryHash(validatorName, epochs, filter)})
+	if err != nil {
+		return nil, nil, err
+	}
+	sortType := postgres.SearchValidatorSort(sort)
+
+	validators, err := s.DAO.GetValidators(&postgres.ValidatorCondition{
+		Epochs: epochs,
+		Sort: &postgres.ValidatorSort{
+			ValidatorSort: sortType,
+			Desc:          desc,
+		},
+		Filter:   validatorFilter(filter),
+		Snapshot: c.snapshot(),
+		Keyset:   c.keyset(),
+		Condition: &postgres.Condition{
+			Name:       validatorName,
+			Pagination: postgres.Pagination{Limit: queryLimit(req.Limit)},
+		},
+	}, epoch)
+	if err != nil {
+		return nil, nil, cursorError("DAO.GetValidators", err)
+	}
+
+	keys := make([]rowKey, len(validators))
+	for i, v := range validators {
+		keys[i] = rowKey{Value: validatorSortValue(sortType, v), ID: v.ID}
+	}
+	n, page := paginate(c, keys, req.Limit)
+	arr := make([]*smodels.Validator, n)
+	for i, v := range validators[:n] {
+		if c.Before {
+			i = n - 1 - i
+		}
+		arr[i] = (&smodels.Validator{}).Set(v)
+	}
+
+	count, err := s.DAO.GetValidatorCount(&postgres.ValidatorCondition{
+		Epochs:   epochs,
+		Filter:   validatorFilter(filter),
+		Snapshot: c.snapshot(),
+		Condition: &postgres.Condition{
+			Name: validatorName,
+		},
+	}, epoch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DAO.GetValidatorCount: %w", err)
+	}
+	page.Total = uint64(count)
+
+	return arr, page, nil
+}
+
+// GetPoolValidatorsPage lists the pool validators a cursor page at a time from the pool data and validators
+// snapshot of the first page.
+func (s Imp) GetPoolValidatorsPage(name string, validatorName string, sort string, desc bool, epoch uint64, filter smodels.ValidatorFilter, req smodels.PageRequest) ([]*smodels.PoolValidatorData, *smodels.Page, error) {
+	c, err := openCursor(req, cursor{Listing: "pool-validators:" + name, Sort: sort, Desc: desc, Epoch: epoch,
+		Query: queryHash(validatorName, filter)})
+	if err != nil {
+		return nil, nil, err
+	}
+	sortType := postgres.SearchValidatorDataSort(sort)
+
+	if c.PoolDataID == "" {
+		pool, err := s.DAO.GetPool(name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("DAO.GetPool: %w", err)
+		}
+		if pool == nil {
+			return nil, nil, fmt.Errorf("DAO.GetPool(%s): %w", name, postgres.ErrorRecordNotFounded)
+		}
+
+		var poolData *dmodels.PoolData
+		if c.Snapshot > 0 {
+			poolData, err = s.DAO.GetLastEpochPoolData(pool.ID, c.Snapshot+1)
+		} else {
+			poolData, err = s.DAO.GetLastPoolData(pool.ID)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("DAO.GetLastPoolData: %w", err)
+		}
+		if poolData == nil {
+			return nil, nil, fmt.Errorf("DAO.GetLastPoolData(%s): %w", name, postgres.ErrorRecordNotFounded)
+		}
+		c.PoolDataID = poolData.ID.String()
+	}
+	poolDataID, err := uuid.FromString(c.PoolDataID)
+	if err != nil {
+		return nil, nil, ErrInvalidCursor
+	}
+
+	pvd, err := s.DAO.GetPoolValidatorData(&postgres.PoolValidatorDataCondition{
+		PoolDataIDs: []uuid.UUID{poolDataID},
+		Sort: &postgres.ValidatorDataSort{
+			ValidatorDataSort: sortType,
+			Desc:              desc,
+		},
+		Filter:   validatorFilter(filter),
+		Snapshot: c.snapshot(),
+		Keyset:   c.keyset(),
+		Condition: &postgres.Condition{
+			Name:       validatorName,
+			Pagination: postgres.Pagination{Limit: queryLimit(req.Limit)},
+		},
+	}, epoch)
+	if err != nil {
+		return nil, nil, cursorError("DAO.GetPoolValidatorData", err)
+	}
+
+	ids := make([]string, len(pvd))
+	for i, data := range pvd {
+		ids[i] = data.ValidatorID
+	}
+	validators, err := s.DAO.GetValidators(&postgres.ValidatorCondition{
+		ValidatorIDs: ids,
+		Snapshot:     c.snapshot(),
+		Condition:    &postgres.Condition{},
+	}, epoch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DAO.GetValidators: %w", err)
+	}
+	byID := make(map[string]*dmodels.ValidatorView, len(validators))
+	for _, v := range validators {
+		byID[v.ID] = v
+	}
+
+	keys := make([]rowKey, len(pvd))
+	for i, data := range pvd {
+		v, ok := byID[data.ValidatorID]
+		if !ok {
+			return nil, nil, fmt.Errorf("DAO.GetValidators(%s): %w", data.ValidatorID, postgres.ErrorRecordNotFounded)
+		}
+		keys[i] = rowKey{Value: poolValidatorSortValue(sortType, data, v), ID: v.ID}
+	}
+	n, page := paginate(c, keys, req.Limit)
+	arr := make([]*smodels.PoolValidatorData, n)
+	for i, data := range pvd[:n] {
+		if c.Before {
+			i = n - 1 - i
+		}
+		arr[i] = (&smodels.PoolValidatorData{}).Set(data.ActiveStake, byID[data.ValidatorID])
+	}
+
+	count, err := s.DAO.GetValidatorDataCount(&postgres.PoolValidatorDataCondition{
+		PoolDataIDs: []uuid.UUID{poolDataID},
+		Sort: &postgres.ValidatorDataSort{
+			ValidatorDataSort: sortType,
+			Desc:              desc,
+		},
+		Filter:   validatorFilter(filter),
+		Snapshot: c.snapshot(),
+		Condition: &postgres.Condition{
+			Name: validatorName,
+		},
+	}, epoch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DAO.GetValidatorDataCount: %w", err)
+	}
+	page.Total = uint64(count)
+
+	return arr, page, nil
+}
+
+// validatorFilter is the DAO filter of f, nil when f doesn't filter.
+func validatorFilter(f smodels.ValidatorFilter) *postgres.ValidatorFilter {
+	if reflect.DeepEqual(f, smodels.ValidatorFilter{}) {
+		return nil
+	}
+	return &postgres.ValidatorFilter{
+		MinFee:          f.MinFee,
+		MaxFee:          f.MaxFee,
+		MinAPY:          f.MinAPY,
+		MaxAPY:          f.MaxAPY,
+		MinScore:        f.MinScore,
+		MaxSkippedSlots: f.MaxSkippedSlots,
+		Delinquent:      f.Delinquent,
+		DataCenters:     f.DataCenters,
+		MinStake:        f.MinStake,
+		MaxStake:        f.MaxStake,
+		DelegatedBy:     f.DelegatedBy,
+		Undelegated:     f.Undelegated,
+	}
+}
+
+// validatorSortValue is the value of the column the DAO sorts the validators by.
+func validatorSortValue(sort postgres.ValidatorSortType, v *dmodels.ValidatorView) string {
+	switch sort {
+	case postgres.ValidatorStake:
+		return strconv.FormatUint(v.ActiveStake, 10)
+	case postgres.ValidatorFee:
+		return v.Fee.String()
+	case postgres.ValidatorScore:
+		return strconv.FormatInt(v.Score, 10)
+	case postgres.ValidatorSkippedSlot:
+		return v.SkippedSlots.String()
+	case postgres.ValidatorDataCenter:
+		return v.DataCenter
+	case postgres.StakingAccounts:
+		return strconv.FormatUint(v.StakingAccounts, 10)
+	default:
+		return v.APY.String()
+	}
+}
+
+// poolValidatorSortValue is the value of the column the DAO sorts the pool validators by.
+func poolValidatorSortValue(sort postgres.ValidatorDataSortType, data *dmodels.PoolValidatorData, v *dmodels.ValidatorView) string {
+	switch sort {
+	case postgres.ValidatorDataPoolStake:
+		return strconv.FormatUint(data.ActiveStake, 10)
+	case postgres.ValidatorDataStake:
+		return strconv.FormatUint(v.ActiveStake, 10)
+	case postgres.ValidatorDataFee:
+		return v.Fee.String()
+	case postgres.ValidatorDataScore:
+		return strconv.FormatInt(v.Score, 10)
+	case postgres.ValidatorDataSkippedSlot:
+		return v.SkippedSlots.String()
+	case postgres.ValidatorDataDataCenter:
+		return v.DataCenter
+	default:
+		return v.APY.String()
+	}
+}
+
+func (s Imp) GetValidator(votePK string, epoch uint64, historyLimit uint64) (*smodels.ValidatorDetails, error) {
+	dValidator, err := s.DAO.GetValidator(votePK, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetValidator: %w", err)
+	}
+	if dValidator == nil {
+		return nil, fmt.Errorf("DAO.GetValidator(%s): %w", votePK, postgres.ErrorRecordNotFounded)
+	}
+
+	history, err := s.DAO.GetValidatorData(&postgres.ValidatorDataCondition{
+		ValidatorIDs: []string{votePK},
+		Condition: &postgres.Condition{
+			Pagination: postgres.Pagination{Limit: historyLimit},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetValidatorData: %w", err)
+	}
+
+	details := &smodels.ValidatorDetails{
+		Validator: *(&smodels.Validator{}).Set(dValidator),
+		History:   make([]*smodels.ValidatorEpoch, len(history)),
+		Pools:     make([]*smodels.ValidatorPool, 0),
+	}
+	for i, data := range history {
+		details.History[i] = (&smodels.ValidatorEpoch{}).Set(data)
+	}
+
+	pools, err := s.GetValidatorsPools([]string{votePK}, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := pools[votePK]; ok {
+		details.Pools = p
+	}
+
+	return details, nil
+}
+
+// GetValidatorsPools returns the mainnet pools delegating to each of the validators, by vote account and
+// sorted by the stake delegated to the validator, reading the pool data of all validators at once.
+func (s Imp) GetValidatorsPools(votePKs []string, epoch uint64) (map[string][]*smodels.ValidatorPool, error) {
+	dPools, err := s.DAO.GetPools(&postgres.PoolCondition{Condition: &postgres.Condition{Network: postgres.MainNet}})
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPools: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*dmodels.Pool, len(dPools))
+	poolIDs := make([]uuid.UUID, 0, len(dPools))
+	for _, pool := range dPools {
+		byID[pool.ID] = pool
+		poolIDs = append(poolIDs, pool.ID)
+	}
+
+	lastData, err := s.DAO.GetLastPoolsData(poolIDs)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetLastPoolsData: %w", err)
+	}
+
+	pools := make(map[uuid.UUID]*dmodels.Pool, len(lastData))
+	poolsData := make(map[uuid.UUID]*dmodels.PoolData, len(lastData))
+	poolDataIDs := make([]uuid.UUID, 0, len(lastData))
+	for _, data := range lastData {
+		pool, ok := byID[data.PoolID]
+		if !ok {
+			continue
+		}
+		pools[data.ID] = pool
+		poolsData[data.ID] = data
+		poolDataIDs = append(poolDataIDs, data.ID)
+	}
+
+	result := make(map[string][]*smodels.ValidatorPool, len(votePKs))
+	if len(poolDataIDs) == 0 || len(votePKs) == 0 {
+		return result, nil
+	}
+
+	pvd, err := s.DAO.GetPoolValidatorData(&postgres.PoolValidatorDataCondition{
+		PoolDataIDs:  poolDataIDs,
+		ValidatorIDs: votePKs,
+	}, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPoolValidatorData: %w", err)
+	}
+
+	for _, data := range pvd {
+		pool, ok := pools[data.PoolDataID]
+		if !ok {
+			continue
+		}
+		result[data.ValidatorID] = append(result[data.ValidatorID], (&smodels.ValidatorPool{}).Set(data.ActiveStake, pool, poolsData[data.PoolDataID]))
+	}
+
+	for _, p := range result {
+		sort.Slice(p, func(i, j int) bool {
+			return p[i].ActiveStake.GreaterThan(p[j].ActiveStake.Decimal)
+		})
+	}
+
+	return result, nil
+}
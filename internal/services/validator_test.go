@@ -316,7 +316,7 @@ func TestGetPoolValidators(t *testing.T) {
 
 	for s, s2 := range data {
 		t.Run(s, func(t *testing.T) {
-			pv, count, err := s2.DAO.GetPoolValidators(s2.Data.name, s2.Data.validatorName, s2.Data.sort, s2.Data.desc, 1, s2.Data.limit, s2.Data.offset)
+			pv, count, err := s2.DAO.GetPoolValidators(s2.Data.name, s2.Data.validatorName, s2.Data.sort, s2.Data.desc, 1, smodels.ValidatorFilter{}, s2.Data.limit, s2.Data.offset)
 			if err != nil {
 				assert.Equal(t, err.Error(), s2.Err.Error())
 				return
@@ -446,7 +446,7 @@ func TestGetAllValidators(t *testing.T) {
 
 	for s, s2 := range data {
 		t.Run(s, func(t *testing.T) {
-			gov, count, err := s2.DAO.GetAllValidators(s2.Data.validatorName, s2.Data.sort, s2.Data.desc, 1, []uint64{314}, s2.Data.limit, s2.Data.offset)
+			gov, count, err := s2.DAO.GetAllValidators(s2.Data.validatorName, s2.Data.sort, s2.Data.desc, 1, []uint64{314}, smodels.ValidatorFilter{}, s2.Data.limit, s2.Data.offset)
 			if err != nil {
 				assert.Equal(t, err.Error(), s2.Err.Error())
 				return
@@ -461,3 +461,171 @@ func TestGetAllValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestGetValidator(t *testing.T) {
+	dValidatorData := []*dmodels.ValidatorData{
+		{
+			ValidatorID:     "id1",
+			Epoch:           290,
+			APY:             decimal.NewFromFloat(0.07),
+			StakingAccounts: 500,
+			ActiveStake:     100,
+			Fee:             decimal.NewFromFloat(0.1),
+			Score:           5698,
+		},
+	}
+	data := map[string]struct {
+		DAO    services.Imp
+		Result *smodels.ValidatorDetails
+		Err    error
+	}{
+		"first": {
+			Result: &smodels.ValidatorDetails{
+				Validator: smodels.Validator{
+					Image:            "img1",
+					Name:             "val1",
+					Delinquent:       true,
+					StakingAccounts:  500,
+					NodePK:           "pk1",
+					VotePK:           "id1",
+					TotalActiveStake: sol.SOL{Decimal: decimal.New(100, -9)},
+					Score:            5698,
+					DataCenter:       "dc",
+				},
+				History: []*smodels.ValidatorEpoch{
+					{
+						Epoch:           290,
+						APY:             decimal.NewFromFloat(0.07),
+						StakingAccounts: 500,
+						ActiveStake:     sol.SOL{Decimal: decimal.New(100, -9)},
+						Fee:             decimal.NewFromFloat(0.1),
+						Score:           5698,
+					},
+				},
+				Pools: []*smodels.ValidatorPool{
+					{
+						Name:        "pool1",
+						Address:     "addr1",
+						Image:       "img1",
+						ActiveStake: sol.SOL{Decimal: decimal.New(854684, -9)},
+						PoolShare:   decimal.NewFromInt(854684).Div(decimal.NewFromInt(456215)),
+					},
+				},
+			},
+			DAO: services.Imp{
+				DAO: &dao.PostgresMock{
+					GetValidatorFunc: func(validatorID string, epoch uint64) (*dmodels.ValidatorView, error) {
+						if validatorID != "id1" {
+							return nil, fmt.Errorf("validatorID != id1, validatorID is %s", validatorID)
+						}
+						return &dValView, nil
+					},
+					GetValidatorDataFunc: func(condition *postgres.ValidatorDataCondition) ([]*dmodels.ValidatorData, error) {
+						if condition.ValidatorIDs[0] != "id1" {
+							return nil, fmt.Errorf("condition.ValidatorIDs[0] != id1, but %s", condition.ValidatorIDs[0])
+						}
+						if condition.Condition.Limit != 10 {
+							return nil, fmt.Errorf("condition.Condition.Limit != 10, but %d", condition.Condition.Limit)
+						}
+						return dValidatorData, nil
+					},
+					GetPoolsFunc: func(condition *postgres.PoolCondition) ([]*dmodels.Pool, error) {
+						return []*dmodels.Pool{&dPool}, nil
+					},
+					GetLastPoolsDataFunc: func(poolIDs []uuid.UUID) ([]*dmodels.PoolData, error) {
+						if len(poolIDs) != 1 || poolIDs[0] != dPool.ID {
+							return nil, fmt.Errorf("poolIDs != [dPool.ID], poolIDs is %v", poolIDs)
+						}
+						return []*dmodels.PoolData{&dPoolData}, nil
+					},
+					GetPoolValidatorDataFunc: func(condition *postgres.PoolValidatorDataCondition, epoch uint64) ([]*dmodels.PoolValidatorData, error) {
+						if condition.ValidatorIDs[0] != "id1" {
+							return nil, fmt.Errorf("condition.ValidatorIDs[0] != id1, but %s", condition.ValidatorIDs[0])
+						}
+						return []*dmodels.PoolValidatorData{
+							{
+								PoolDataID:  dPoolData.ID,
+								ValidatorID: "id1",
+								ActiveStake: 854684,
+							},
+						}, nil
+					},
+				},
+			},
+		},
+		"second": {
+			Result: nil,
+			Err:    fmt.Errorf("DAO.GetValidator(%s): %w", "id1", postgres.ErrorRecordNotFounded),
+			DAO: services.Imp{
+				DAO: &dao.PostgresMock{
+					GetValidatorFunc: func(validatorID string, epoch uint64) (*dmodels.ValidatorView, error) {
+						return nil, nil
+					},
+				},
+			},
+		},
+		"third": {
+			Result: nil,
+			Err:    fmt.Errorf("DAO.GetValidatorData: %w", fmt.Errorf("some error")),
+			DAO: services.Imp{
+				DAO: &dao.PostgresMock{
+					GetValidatorFunc: func(validatorID string, epoch uint64) (*dmodels.ValidatorView, error) {
+						return &dValView, nil
+					},
+					GetValidatorDataFunc: func(condition *postgres.ValidatorDataCondition) ([]*dmodels.ValidatorData, error) {
+						return nil, fmt.Errorf("some error")
+					},
+				},
+			},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			details, err := s2.DAO.GetValidator("id1", 10, 10)
+			if err != nil {
+				assert.Equal(t, err.Error(), s2.Err.Error())
+				return
+			}
+			assert.DeepEqual(t, details, s2.Result)
+		})
+	}
+}
+
+func TestGetAllValidatorsFilter(t *testing.T) {
+	minScore, delinquent, maxStake := int64(10), false, uint64(5e15)
+	maxFee := decimal.NewFromFloat(0.05)
+	data := map[string]struct {
+		Filter smodels.ValidatorFilter
+		Result *postgres.ValidatorFilter
+	}{
+		"none": {},
+		"combined": {
+			Filter: smodels.ValidatorFilter{MaxFee: &maxFee, MinScore: &minScore, Delinquent: &delinquent, MaxStake: &maxStake, DelegatedBy: []string{"Eversol"}},
+			Result: &postgres.ValidatorFilter{MaxFee: &maxFee, MinScore: &minScore, Delinquent: &delinquent, MaxStake: &maxStake, DelegatedBy: []string{"Eversol"}},
+		},
+		"undelegated": {
+			Filter: smodels.ValidatorFilter{Undelegated: true, DataCenters: []string{"24940-DE-Falkenstein"}},
+			Result: &postgres.ValidatorFilter{Undelegated: true, DataCenters: []string{"24940-DE-Falkenstein"}},
+		},
+	}
+
+	for name, d := range data {
+		t.Run(name, func(t *testing.T) {
+			s := services.Imp{DAO: &dao.PostgresMock{
+				GetValidatorsFunc: func(condition *postgres.ValidatorCondition, epoch uint64) ([]*dmodels.ValidatorView, error) {
+					assert.DeepEqual(t, condition.Filter, d.Result)
+					return nil, nil
+				},
+				GetValidatorCountFunc: func(condition *postgres.ValidatorCondition, epoch uint64) (int64, error) {
+					assert.DeepEqual(t, condition.Filter, d.Result)
+					return 0, nil
+				},
+			}}
+			_, _, err := s.GetAllValidators("", "apy", true, 10, nil, d.Filter, 10, 0)
+			assert.NilError(t, err)
+			_, _, err = s.GetValidatorsPage("", "apy", true, 10, nil, d.Filter, smodels.PageRequest{Limit: 10})
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"fmt"
+	"github.com/everstake/solana-pools/internal/dao/dmodels"
+	"github.com/everstake/solana-pools/internal/services/smodels"
+	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
+	"math"
+	"time"
+)
+
+const secondsPerYear = SecondsPerDay * 365.25
+
+var (
+	defaultYieldEpochWindows = []uint64{1, 10, 30}
+	defaultYieldDayWindows   = []uint64{90}
+)
+
+// exchangeRate is the value of one pool token in lamports.
+func exchangeRate(totalLamports uint64, totalTokensSupply uint64) decimal.Decimal {
+	if totalTokensSupply == 0 {
+		return decimal.Zero
+	}
+	return decimal.NewFromInt(int64(totalLamports)).Div(decimal.NewFromInt(int64(totalTokensSupply)))
+}
+
+// epochsPerYear uses the measured slot time instead of the nominal 400ms.
+func epochsPerYear(slotTimeMS float64) float64 {
+	return secondsPerYear / (DefaultSlotsPerEpoch * slotTimeMS / 1000)
+}
+
+// compoundAPY annualizes the growth from one rate to another, compounding periodsPerYear times a year.
+func compoundAPY(from decimal.Decimal, to decimal.Decimal, periodsPerYear float64) decimal.Decimal {
+	if from.IsZero() || to.IsZero() || periodsPerYear <= 0 {
+		return decimal.Zero
+	}
+	growth, _ := to.Div(from).Float64()
+	apy := math.Pow(growth, periodsPerYear) - 1
+	if math.IsNaN(apy) || math.IsInf(apy, 0) {
+		return decimal.Zero
+	}
+	return decimal.NewFromFloat(apy).Truncate(9)
+}
+
+// epochAPY returns the realized APY between two epoch rates, or false when they don't span an epoch.
+func epochAPY(from *dmodels.PoolExchangeRate, to *dmodels.PoolExchangeRate, slotTimeMS float64) (decimal.Decimal, bool) {
+	if from == nil || to == nil || to.Epoch <= from.Epoch {
+		return decimal.Zero, false
+	}
+	return compoundAPY(from.Rate, to.Rate, epochsPerYear(slotTimeMS)/float64(to.Epoch-from.Epoch)), true
+}
+
+// periodAPY returns the realized APY between two observations using the time elapsed between them.
+func periodAPY(from *dmodels.PoolExchangeRate, to *dmodels.PoolExchangeRate) (decimal.Decimal, bool) {
+	if from == nil || to == nil || !to.UpdatedAt.After(from.UpdatedAt) {
+		return decimal.Zero, false
+	}
+	return compoundAPY(from.Rate, to.Rate, secondsPerYear/to.UpdatedAt.Sub(from.UpdatedAt).Seconds()), true
+}
+
+func (s Imp) yieldWindows() (epochs []uint64, days []uint64) {
+	epochs, days = s.cfg.YieldEpochWindows, s.cfg.YieldDayWindows
+	if len(epochs) == 0 {
+		epochs = defaultYieldEpochWindows
+	}
+	if len(days) == 0 {
+		days = defaultYieldDayWindows
+	}
+	return epochs, days
+}
+
+// poolYield returns the validator-derived APY of the pool next to its realized APY at the epoch.
+func (s Imp) poolYield(poolID uuid.UUID, epoch uint64, validatorAPY decimal.Decimal) (smodels.Yield, error) {
+	yields, err := s.poolsYield(map[uuid.UUID]uint64{poolID: epoch}, map[uuid.UUID]decimal.Decimal{poolID: validatorAPY})
+	if err != nil {
+		return smodels.Yield{}, err
+	}
+	return yields[poolID], nil
+}
+
+// poolsYield is poolYield for every pool of epochs, reading the exchange rates of all of them at once.
+func (s Imp) poolsYield(epochs map[uuid.UUID]uint64, validatorAPYs map[uuid.UUID]decimal.Decimal) (map[uuid.UUID]smodels.Yield, error) {
+	epochWindows, dayWindows := s.yieldWindows()
+	rates, err := s.DAO.GetPoolsExchangeRateWindows(epochs, epochWindows, dayWindows)
+	if err != nil {
+		return nil, fmt.Errorf("DAO.GetPoolsExchangeRateWindows: %w", err)
+	}
+	ratesByPool := make(map[uuid.UUID][]*dmodels.PoolExchangeRate, len(epochs))
+	for _, r := range rates {
+		ratesByPool[r.PoolID] = append(ratesByPool[r.PoolID], r)
+	}
+
+	var st float64
+	if len(rates) != 0 {
+		if st, err = s.GetAvgSlotTimeMS(); err != nil {
+			return nil, fmt.Errorf("imp.GetAvgSlotTimeMS: %w", err)
+		}
+	}
+
+	yields := make(map[uuid.UUID]smodels.Yield, len(epochs))
+	for id, epoch := range epochs {
+		yields[id] = smodels.Yield{
+			ValidatorAPY: validatorAPYs[id],
+			Realized:     realizedAPY(ratesByPool[id], epoch, validatorAPYs[id], st, epochWindows, dayWindows),
+		}
+	}
+	return yields, nil
+}
+
+// realizedAPY computes the exchange-rate APY of the pool over the windows ending at the epoch from its rates.
+// Windows without enough history are omitted.
+func realizedAPY(rates []*dmodels.PoolExchangeRate, epoch uint64, validatorAPY decimal.Decimal, slotTimeMS float64, epochWindows []uint64, dayWindows []uint64) []*smodels.RealizedAPY {
+	current := rateAtEpoch(rates, epoch)
+	if current == nil {
+		return nil
+	}
+
+	realized := make([]*smodels.RealizedAPY, 0, len(epochWindows)+len(dayWindows))
+	for _, w := range epochWindows {
+		if w == 0 || w > current.Epoch {
+			continue
+		}
+		apy, ok := epochAPY(rateAtEpoch(rates, current.Epoch-w), current, slotTimeMS)
+		if !ok {
+			continue
+		}
+		realized = append(realized, &smodels.RealizedAPY{
+			Epochs:  w,
+			APY:     apy,
+			FeeDrag: validatorAPY.Sub(apy),
+		})
+	}
+	for _, w := range dayWindows {
+		if w == 0 {
+			continue
+		}
+		apy, ok := periodAPY(rateAt(rates, current.UpdatedAt.Add(-time.Duration(w)*time.Hour*24)), current)
+		if !ok {
+			continue
+		}
+		realized = append(realized, &smodels.RealizedAPY{
+			Days:    w,
+			APY:     apy,
+			FeeDrag: validatorAPY.Sub(apy),
+		})
+	}
+
+	return realized
+}
+
+// rateAtEpoch returns the latest of the rates recorded at or before the epoch.
+func rateAtEpoch(rates []*dmodels.PoolExchangeRate, epoch uint64) *dmodels.PoolExchangeRate {
+	var found *dmodels.PoolExchangeRate
+	for _, r := range rates {
+		if r.Epoch <= epoch && (found == nil || r.Epoch > found.Epoch) {
+			found = r
+		}
+	}
+	return found
+}
+
+// rateAt returns the latest of the rates observed at or before t.
+func rateAt(rates []*dmodels.PoolExchangeRate, t time.Time) *dmodels.PoolExchangeRate {
+	var found *dmodels.PoolExchangeRate
+	for _, r := range rates {
+		if !r.UpdatedAt.After(t) && (found == nil || r.UpdatedAt.After(found.UpdatedAt)) {
+			found = r
+		}
+	}
+	return found
+}
@@ -0,0 +1,9 @@
+-- only the rates seeded by the up migration that were not rewritten by a later update
+DELETE
+FROM pool_exchange_rates r
+    USING pool_exchange_rates_backfill b
+WHERE r.pool_id = b.pool_id
+  AND r.epoch = b.epoch
+  AND r.updated_at = b.updated_at;
+
+DROP TABLE pool_exchange_rates_backfill;
@@ -0,0 +1,26 @@
+CREATE TABLE pool_exchange_rates_backfill
+(
+    pool_id    uuid        NOT NULL,
+    epoch      bigint      NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (pool_id, epoch)
+);
+
+WITH seeded AS (
+    INSERT INTO pool_exchange_rates (pool_id, epoch, total_tokens_supply, total_lamports, rate, updated_at, created_at)
+        SELECT DISTINCT ON (pd.pool_id, pd.epoch) pd.pool_id,
+                                                  pd.epoch,
+                                                  pd.total_tokens_supply,
+                                                  pd.total_lamports,
+                                                  pd.total_lamports::numeric / pd.total_tokens_supply,
+                                                  pd.created_at,
+                                                  pd.created_at
+        FROM pool_data pd
+        WHERE pd.total_tokens_supply > 0
+        ORDER BY pd.pool_id, pd.epoch, pd.created_at DESC
+        ON CONFLICT DO NOTHING
+        RETURNING pool_id, epoch, updated_at
+)
+INSERT INTO pool_exchange_rates_backfill (pool_id, epoch, updated_at)
+SELECT pool_id, epoch, updated_at
+FROM seeded;
@@ -0,0 +1,4 @@
+-- only the pool inserted by the up migration, with the markets saved for it since
+DELETE FROM defi_histories WHERE liquidity_pool_id = '5f0c2d3e-8a41-4b6f-9d27-1c8e6b3a7f90';
+DELETE FROM defis WHERE liquidity_pool_id = '5f0c2d3e-8a41-4b6f-9d27-1c8e6b3a7f90';
+DELETE FROM liquidity_pools WHERE id = '5f0c2d3e-8a41-4b6f-9d27-1c8e6b3a7f90';
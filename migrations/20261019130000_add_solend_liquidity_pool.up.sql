@@ -0,0 +1,8 @@
+-- the fixed id lets the down migration remove only the row inserted here
+INSERT INTO liquidity_pools (id, name, about, image, url)
+SELECT '5f0c2d3e-8a41-4b6f-9d27-1c8e6b3a7f90',
+       'Solend',
+       'Algorithmic, decentralized lending and borrowing protocol on Solana.',
+       'https://raw.githubusercontent.com/solana-labs/token-list/main/assets/mainnet/SLNDpmoWTVADgC5rRzqbuKn2c1gY9E6i8EhhRMRRVM5/logo.png',
+       'https://solend.fi'
+WHERE NOT EXISTS(SELECT 1 FROM liquidity_pools WHERE name = 'Solend');
@@ -0,0 +1,6 @@
+-- only the prices seeded by the up migration
+DELETE
+FROM price_histories
+WHERE id IN (SELECT id FROM price_histories_seed);
+
+DROP TABLE price_histories_seed;
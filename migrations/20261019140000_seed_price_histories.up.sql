@@ -0,0 +1,19 @@
+CREATE TABLE price_histories_seed
+(
+    id uuid PRIMARY KEY
+);
+
+WITH seeded AS (
+    INSERT INTO price_histories (asset_type, asset_id, usd, sources, created_at)
+        SELECT 'coin', id, usd, price_sources, coalesce(price_updated_at, now())
+        FROM coins
+        WHERE usd > 0
+        UNION ALL
+        SELECT 'governance', id, usd, price_sources, coalesce(price_updated_at, now())
+        FROM governances
+        WHERE usd > 0
+        RETURNING id
+)
+INSERT INTO price_histories_seed (id)
+SELECT id
+FROM seeded;
@@ -0,0 +1,6 @@
+-- only the supplies seeded by the up migration
+DELETE
+FROM governance_supplies
+WHERE id IN (SELECT id FROM governance_supplies_seed);
+
+DROP TABLE governance_supplies_seed;
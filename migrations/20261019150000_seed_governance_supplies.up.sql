@@ -0,0 +1,15 @@
+CREATE TABLE governance_supplies_seed
+(
+    id uuid PRIMARY KEY
+);
+
+WITH seeded AS (
+    INSERT INTO governance_supplies (governance_id, maximum_token_supply, circulating_supply, on_chain_supply, usd, created_at)
+        SELECT id, maximum_token_supply, circulating_supply, on_chain_supply, usd, coalesce(price_updated_at, now())
+        FROM governances
+        WHERE circulating_supply > 0
+        RETURNING id
+)
+INSERT INTO governance_supplies_seed (id)
+SELECT id
+FROM seeded;
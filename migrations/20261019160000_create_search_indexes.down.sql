@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_pools_name_trgm;
+DROP INDEX IF EXISTS idx_pools_address_trgm;
+
+DROP INDEX IF EXISTS idx_validators_name_trgm;
+DROP INDEX IF EXISTS idx_validators_id_trgm;
+DROP INDEX IF EXISTS idx_validators_node_pk_trgm;
+
+DROP INDEX IF EXISTS idx_coins_name_trgm;
+DROP INDEX IF EXISTS idx_coins_address_trgm;
+DROP INDEX IF EXISTS idx_coins_gecko_key_trgm;
+
+DROP INDEX IF EXISTS idx_governances_name_trgm;
+DROP INDEX IF EXISTS idx_governances_symbol_trgm;
+DROP INDEX IF EXISTS idx_governances_contract_address_trgm;
+
+DROP INDEX IF EXISTS idx_liquidity_pools_name_trgm;
@@ -0,0 +1,18 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_pools_name_trgm ON pools USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_pools_address_trgm ON pools USING gin (address gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_validators_name_trgm ON validators USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_validators_id_trgm ON validators USING gin (id gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_validators_node_pk_trgm ON validators USING gin (node_pk gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_coins_name_trgm ON coins USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_coins_address_trgm ON coins USING gin (address gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_coins_gecko_key_trgm ON coins USING gin (gecko_key gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_governances_name_trgm ON governances USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_governances_symbol_trgm ON governances USING gin (symbol gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_governances_contract_address_trgm ON governances USING gin (contract_address gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_liquidity_pools_name_trgm ON liquidity_pools USING gin (name gin_trgm_ops);
@@ -0,0 +1,2 @@
+UPDATE governances SET maximum_token_supply = total_supply WHERE maximum_token_supply = 0;
+UPDATE governance_supplies SET maximum_token_supply = total_supply WHERE maximum_token_supply = 0;
@@ -0,0 +1,3 @@
+-- maximum_token_supply held CoinGecko's total_supply; move it to total_supply until the next update reads max_supply.
+UPDATE governances SET total_supply = maximum_token_supply, maximum_token_supply = 0 WHERE total_supply = 0;
+UPDATE governance_supplies SET total_supply = maximum_token_supply, maximum_token_supply = 0 WHERE total_supply = 0;
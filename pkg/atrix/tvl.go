@@ -12,9 +12,10 @@ type AllPools struct {
 }
 
 type Farm struct {
-	Key string  `json:"key"`
-	Tvl float64 `json:"tvl"`
-	Apy float64 `json:"apy"`
+	Key       string  `json:"key"`
+	StakeMint string  `json:"stakeMint"`
+	Tvl       float64 `json:"tvl"`
+	Apy       float64 `json:"apy"`
 }
 
 type Pool struct {
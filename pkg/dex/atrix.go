@@ -0,0 +1,33 @@
+package dex
+
+import "github.com/everstake/solana-pools/pkg/atrix"
+
+// FromAtrix normalizes Atrix pools, pricing them by their reserves. Farms are joined to pools by the LP mint
+// they stake and their APY is reported as the pool's reward APR. The API does not report volume, so
+// Atrix pools earn no fee APR.
+func FromAtrix(tvl *atrix.AllPools) []Pair {
+	if tvl == nil {
+		return nil
+	}
+	rewards := make(map[string]float64, len(tvl.Farms))
+	for _, f := range tvl.Farms {
+		rewards[f.StakeMint] += f.Apy / 100
+	}
+	result := make([]Pair, 0, len(tvl.Pools))
+	for _, p := range tvl.Pools {
+		var price float64
+		if p.CoinTokens != 0 {
+			price = p.PCTokens / p.CoinTokens
+		}
+		result = append(result, Pair{
+			ID:        p.PoolKey,
+			Base:      p.CoinMint,
+			Quote:     p.PCMint,
+			Price:     price,
+			Liquidity: p.Tvl,
+			APY:       rewards[p.LpMint],
+			RewardAPR: rewards[p.LpMint],
+		})
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package dex_test
+
+import (
+	"github.com/everstake/solana-pools/pkg/atrix"
+	"github.com/everstake/solana-pools/pkg/dex"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestFromAtrix(t *testing.T) {
+	var tvl atrix.AllPools
+	loadFixture(t, "atrix_tvl.json", &tvl)
+
+	normalized := dex.FromAtrix(&tvl)
+	assert.Equal(t, len(normalized), 3)
+	assert.Equal(t, len(dex.FromAtrix(nil)), 0)
+
+	data := map[string]struct {
+		Base   string
+		Quotes []string
+		Result []dex.Pair
+	}{
+		"mSOL": {
+			Base:   mSOL,
+			Quotes: []string{USDC, coinsSOL},
+			Result: []dex.Pair{
+				{
+					ID:        "3DSeDqC7V9Z7ST1W2WpXjLtyVK5cC1vbbRiPT2gUkw6b",
+					Base:      mSOL,
+					Quote:     USDC,
+					Price:     605165.7 / 6612.45,
+					Liquidity: 1210331.4,
+					APY:       0.2134 + 0.035,
+					RewardAPR: 0.2134 + 0.035,
+				},
+			},
+		},
+		"stSOL reversed": {
+			Base:   stSOL,
+			Quotes: []string{coinsSOL},
+			Result: []dex.Pair{
+				{ID: "GkXfKE5n2EjW6PJsm6oWD6VfU9XqjFE5ZxAhCwfFAhV1", Base: stSOL, Quote: dex.SOLMint, Price: 4300.2 / 4150.9, Liquidity: 820112.8},
+			},
+		},
+		"empty pool": {
+			Base:   scnSOL,
+			Quotes: []string{coinsSOL},
+			Result: []dex.Pair{
+				{ID: "8mLdqV2oQ6wsd6Kfa1yjH2r9J3L7dQ2ExbVVzUtd46P9", Base: scnSOL, Quote: dex.SOLMint},
+			},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			assert.DeepEqual(t, dex.Match(normalized, s2.Base, s2.Quotes), s2.Result)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package dex
+
+import (
+	"github.com/everstake/solana-pools/pkg/orca"
+	"strings"
+)
+
+// orcaLPTradeFee is the share of a trade Orca pays to liquidity providers.
+const orcaLPTradeFee = 0.0025
+
+// FromOrca normalizes Orca pools. The Orca API names pools by token symbols ("mSOL/USDC[aquafarm]"),
+// so mints maps symbols to mint addresses; pools with an unknown symbol are skipped. The APY of a farm includes
+// its emissions, which are split from the trading fees into RewardAPR.
+func FromOrca(pools []*orca.Pool, mints map[string]string) []Pair {
+	result := make([]Pair, 0, len(pools))
+	for _, p := range pools {
+		name, kind := p.Name, ""
+		if i := strings.Index(name, "["); i >= 0 {
+			name, kind = name[:i], strings.TrimSuffix(name[i+1:], "]")
+		}
+		symbols := strings.Split(name, "/")
+		if len(symbols) != 2 {
+			continue
+		}
+		base, ok := mints[symbols[0]]
+		if !ok {
+			continue
+		}
+		quote, ok := mints[symbols[1]]
+		if !ok {
+			continue
+		}
+		var apy float64
+		if p.Apy24H != nil {
+			apy = *p.Apy24H
+		}
+		farm := kind == "aquafarm" || kind == "double-dip"
+		feeAPR, rewardAPR := apy, 0.0
+		if farm {
+			feeAPR, rewardAPR = splitOrcaFarmAPY(apy, p.Volume24H, p.Liquidity)
+		}
+		result = append(result, Pair{
+			ID:        p.Account,
+			Base:      base,
+			Quote:     quote,
+			Price:     p.Price,
+			Liquidity: p.Liquidity,
+			Volume24H: p.Volume24H,
+			APY:       apy,
+			FeeAPR:    feeAPR,
+			RewardAPR: rewardAPR,
+			Farm:      farm,
+		})
+	}
+	return result
+}
+
+// splitOrcaFarmAPY splits the farm APY into the fee APR earned from the 24h volume and the emissions on top of it.
+func splitOrcaFarmAPY(apy float64, volume24H float64, liquidity float64) (feeAPR float64, rewardAPR float64) {
+	if liquidity > 0 {
+		feeAPR = volume24H * orcaLPTradeFee / liquidity * 365
+	}
+	if feeAPR > apy {
+		feeAPR = apy
+	}
+	return feeAPR, apy - feeAPR
+}
@@ -0,0 +1,53 @@
+package dex_test
+
+import (
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/orca"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestFromOrca(t *testing.T) {
+	var pools []*orca.Pool
+	loadFixture(t, "orca_pools.json", &pools)
+
+	normalized := dex.FromOrca(pools, map[string]string{
+		"SOL":    coinsSOL,
+		"mSOL":   mSOL,
+		"scnSOL": scnSOL,
+		"USDC":   USDC,
+	})
+	// ORCA is not a known coin
+	assert.Equal(t, len(normalized), 4)
+
+	// the fee APR of a farm comes from the volume, the rest of its APY are emissions
+	volume, liquidity := 211040.9, 3011022.7
+	farmFee := volume * 0.0025 / liquidity * 365
+	data := map[string]struct {
+		Base   string
+		Quotes []string
+		Result []dex.Pair
+	}{
+		"mSOL without duplicate aquafarm": {
+			Base:   mSOL,
+			Quotes: []string{USDC, coinsSOL},
+			Result: []dex.Pair{
+				{ID: "Hme4Jnqhdz2jAPUMnS7jGE5zv6Y1ynqrUEhmUAWkXmzn", Base: mSOL, Quote: USDC, Price: 91.391, Liquidity: 5120045.22, Volume24H: 1811201.3, APY: 0.0912, FeeAPR: 0.0912},
+				{ID: "9EQMEzJdE2LDAY1hw1RytpufdwAXzatYfQ3M2UuT9b88", Base: mSOL, Quote: dex.SOLMint, Price: 1 / 0.9601, Liquidity: 3011022.7, Volume24H: 211040.9, APY: 0.1501, FeeAPR: farmFee, RewardAPR: 0.1501 - farmFee, Farm: true},
+			},
+		},
+		"scnSOL farm only, fees capped at the APY": {
+			Base:   scnSOL,
+			Quotes: []string{USDC},
+			Result: []dex.Pair{
+				{ID: "6Gh36sNXrGWYiWr999d9iZtqgnipJbWuBohyHBN1cJpS", Base: scnSOL, Quote: USDC, Price: 92.102, Liquidity: 402551.6, Volume24H: 31201.8, APY: 0.0611, FeeAPR: 0.0611, RewardAPR: 0, Farm: true},
+			},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			assert.DeepEqual(t, dex.Match(normalized, s2.Base, s2.Quotes), s2.Result)
+		})
+	}
+}
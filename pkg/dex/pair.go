@@ -0,0 +1,97 @@
+package dex
+
+import "strings"
+
+const (
+	// SOLMint is the wrapped SOL mint DEX markets use for native SOL.
+	SOLMint = "So11111111111111111111111111111111111111112"
+	// systemProgram is how native SOL is stored in the coins table.
+	systemProgram = "11111111111111111111111111111111"
+)
+
+// Pair is a DEX market normalized to the mint addresses of its tokens.
+type Pair struct {
+	// ID is the market account (amm, pool key) on the DEX.
+	ID    string
+	Base  string
+	Quote string
+	// Price is the price of one Base token in Quote tokens, zero when the DEX does not report it.
+	Price float64
+	// Liquidity is the USD value locked in the market.
+	Liquidity float64
+	// Volume24H is the USD volume traded in the last 24 hours.
+	Volume24H float64
+	// APY is the total yield of the market; FeeAPR and RewardAPR break it down into trading fees paid to
+	// liquidity providers and farm rewards, when the DEX reports them.
+	APY       float64
+	FeeAPR    float64
+	RewardAPR float64
+	// Farm marks a farm (aquafarm, double-dip) built on top of a pool of the same tokens.
+	Farm bool
+	// Lending marks a lending reserve, where Base and Quote are the supplied token and APY is the supply APY.
+	Lending bool
+}
+
+// NormalizeMint maps the different spellings of native SOL to SOLMint.
+func NormalizeMint(mint string) string {
+	if mint == systemProgram || strings.HasPrefix(mint, "So1111111111111111111111111111111") {
+		return SOLMint
+	}
+	return mint
+}
+
+// Reverse returns the same market seen from the Quote token.
+func (p Pair) Reverse() Pair {
+	r := p
+	r.Base, r.Quote = p.Quote, p.Base
+	if p.Price != 0 {
+		r.Price = 1 / p.Price
+	}
+	return r
+}
+
+// Match returns the markets trading base against any of quotes, oriented so that base is the Base token,
+// and the lending reserves of base. Farms are dropped when the DEX also lists a plain pool for the same tokens.
+func Match(pairs []Pair, base string, quotes []string) []Pair {
+	base = NormalizeMint(base)
+	wanted := make(map[string]bool, len(quotes))
+	for _, q := range quotes {
+		if q = NormalizeMint(q); q != base {
+			wanted[q] = true
+		}
+	}
+
+	matched := make([]Pair, 0)
+	pools := make(map[string]bool)
+	seen := make(map[string]bool)
+	for _, p := range pairs {
+		p.Base, p.Quote = NormalizeMint(p.Base), NormalizeMint(p.Quote)
+		if p.Lending {
+			if p.Base == base && !seen[p.ID] {
+				seen[p.ID] = true
+				matched = append(matched, p)
+			}
+			continue
+		}
+		if p.Quote == base {
+			p = p.Reverse()
+		}
+		if p.Base != base || !wanted[p.Quote] || seen[p.ID] {
+			continue
+		}
+		seen[p.ID] = true
+		if !p.Farm {
+			pools[p.Quote] = true
+		}
+		matched = append(matched, p)
+	}
+
+	result := make([]Pair, 0, len(matched))
+	for _, p := range matched {
+		if p.Farm && pools[p.Quote] {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package dex_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/everstake/solana-pools/pkg/dex"
+	"gotest.tools/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	mSOL   = "mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So"
+	stSOL  = "7dHbWXmci3dT8UFYWYZweBLXgycu7Y3iL6trKn1Y7ARj"
+	scnSOL = "5oVNBeEEQvYi1cX3ir8Dx5n1P7pdxydbGF2X4TxVusJm"
+	USDC   = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+	USDT   = "Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB"
+	// coinsSOL is native SOL as stored in the coins table.
+	coinsSOL = "11111111111111111111111111111111"
+)
+
+func loadFixture(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	b, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	assert.NilError(t, err)
+	assert.NilError(t, json.Unmarshal(b, v))
+}
+
+func TestNormalizeMint(t *testing.T) {
+	data := map[string]struct {
+		Mint   string
+		Result string
+	}{
+		"system program": {Mint: coinsSOL, Result: dex.SOLMint},
+		"wrapped sol":    {Mint: dex.SOLMint, Result: dex.SOLMint},
+		"truncated":      {Mint: "So11111111111111111111111111111111", Result: dex.SOLMint},
+		"token":          {Mint: mSOL, Result: mSOL},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			assert.Equal(t, dex.NormalizeMint(s2.Mint), s2.Result)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	pairs := []dex.Pair{
+		{ID: "a", Base: mSOL, Quote: USDC, Price: 90, Liquidity: 100},
+		{ID: "a-farm", Base: mSOL, Quote: USDC, Price: 90, Liquidity: 100, Farm: true},
+		{ID: "b", Base: dex.SOLMint, Quote: mSOL, Price: 0.8, Liquidity: 50, Farm: true},
+		{ID: "c", Base: USDT, Quote: mSOL, Liquidity: 10},
+		{ID: "c", Base: USDT, Quote: mSOL, Liquidity: 10},
+		{ID: "d", Base: stSOL, Quote: USDC, Price: 89, Liquidity: 70},
+		{ID: "e", Base: mSOL, Quote: mSOL, Liquidity: 1},
+	}
+
+	data := map[string]struct {
+		Base   string
+		Quotes []string
+		Result []dex.Pair
+	}{
+		"both directions and farm dedup": {
+			Base:   mSOL,
+			Quotes: []string{USDC, coinsSOL, USDT, mSOL},
+			Result: []dex.Pair{
+				{ID: "a", Base: mSOL, Quote: USDC, Price: 90, Liquidity: 100},
+				{ID: "b", Base: mSOL, Quote: dex.SOLMint, Price: 1.25, Liquidity: 50, Farm: true},
+				{ID: "c", Base: mSOL, Quote: USDT, Liquidity: 10},
+			},
+		},
+		"only requested quotes": {
+			Base:   stSOL,
+			Quotes: []string{coinsSOL},
+			Result: []dex.Pair{},
+		},
+		"native sol base": {
+			Base:   coinsSOL,
+			Quotes: []string{mSOL},
+			Result: []dex.Pair{
+				{ID: "b", Base: dex.SOLMint, Quote: mSOL, Price: 0.8, Liquidity: 50, Farm: true},
+			},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			result := dex.Match(pairs, s2.Base, s2.Quotes)
+			assert.Equal(t, len(result), len(s2.Result))
+			for i, p := range result {
+				t.Run(fmt.Sprintf("pairs[%d]", i), func(t *testing.T) {
+					assert.DeepEqual(t, p, s2.Result[i])
+				})
+			}
+		})
+	}
+}
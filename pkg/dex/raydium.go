@@ -0,0 +1,33 @@
+package dex
+
+import (
+	"github.com/everstake/solana-pools/pkg/raydium"
+	"strings"
+)
+
+// FromRaydium normalizes Raydium pairs, whose pair_id is "<coin mint>-<pc mint>". The pairs API reports
+// the trading fee APY only.
+func FromRaydium(pairs []*raydium.Pairs) []Pair {
+	result := make([]Pair, 0, len(pairs))
+	for _, p := range pairs {
+		mints := strings.Split(p.PairID, "-")
+		if len(mints) != 2 {
+			continue
+		}
+		var price float64
+		if p.Price != nil {
+			price = *p.Price
+		}
+		result = append(result, Pair{
+			ID:        p.AmmID,
+			Base:      mints[0],
+			Quote:     mints[1],
+			Price:     price,
+			Liquidity: p.Liquidity,
+			Volume24H: p.Volume24H,
+			APY:       p.Apy / 100,
+			FeeAPR:    p.Apy / 100,
+		})
+	}
+	return result
+}
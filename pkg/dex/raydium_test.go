@@ -0,0 +1,44 @@
+package dex_test
+
+import (
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/raydium"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestFromRaydium(t *testing.T) {
+	var pairs []*raydium.Pairs
+	loadFixture(t, "raydium_pairs.json", &pairs)
+
+	normalized := dex.FromRaydium(pairs)
+	assert.Equal(t, len(normalized), 4)
+
+	data := map[string]struct {
+		Base   string
+		Quotes []string
+		Result []dex.Pair
+	}{
+		"mSOL": {
+			Base:   mSOL,
+			Quotes: []string{USDC, coinsSOL},
+			Result: []dex.Pair{
+				{ID: "ZfvDXXUhZDzDVsapffUyXHj9ByCoPjP4thL6YXcZ9ix", Base: mSOL, Quote: USDC, Price: 91.482, Liquidity: 8349822.419, Volume24H: 1204511.8, APY: 0.1316, FeeAPR: 0.1316},
+				{ID: "EGyhb2uLAsRUbRx9dNFBjMVYnFaASWMvD6RE1aEf2LxL", Base: mSOL, Quote: dex.SOLMint, Price: 1.0418, Liquidity: 2140711.65, Volume24H: 310550.4, APY: 0.1324, FeeAPR: 0.1324},
+			},
+		},
+		"stSOL reversed": {
+			Base:   stSOL,
+			Quotes: []string{USDC, coinsSOL},
+			Result: []dex.Pair{
+				{ID: "2xQNzyrGG6cv6uvKmjPR4Pr2BvFwzwfk1omjQnPnX3Ua", Base: stSOL, Quote: dex.SOLMint, Price: 1 / 0.9652, Liquidity: 410207.92, Volume24H: 50110.2, APY: 0.1115, FeeAPR: 0.1115},
+			},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			assert.DeepEqual(t, dex.Match(normalized, s2.Base, s2.Quotes), s2.Result)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package dex
+
+import "github.com/everstake/solana-pools/pkg/saber"
+
+// FromSaber normalizes Saber pools. Saber reports TVL and volume in tokens, so usd maps mints to USD prices
+// to value them; liquidity and APY stay zero for tokens without a price. fees maps ammIds to the share of
+// a trade paid to liquidity providers, read from the swap accounts; pools without one earn no fee APR.
+func FromSaber(pools []*saber.Pool, usd map[string]float64, fees map[string]float64) []Pair {
+	result := make([]Pair, 0, len(pools))
+	for _, p := range pools {
+		coinUSD, pcUSD := usd[NormalizeMint(p.Coin.Address)], usd[NormalizeMint(p.PC.Address)]
+		liquidity := p.Stats.TvlCoin*coinUSD + p.Stats.TvlPC*pcUSD
+		volume := p.Stats.Vol24H * pcUSD
+		var feeAPR float64
+		if liquidity != 0 {
+			feeAPR = volume * fees[p.AmmID] / liquidity * 365
+		}
+		result = append(result, Pair{
+			ID:        p.AmmID,
+			Base:      p.Coin.Address,
+			Quote:     p.PC.Address,
+			Price:     p.Stats.Price,
+			Liquidity: liquidity,
+			Volume24H: volume,
+			APY:       feeAPR,
+			FeeAPR:    feeAPR,
+		})
+	}
+	return result
+}
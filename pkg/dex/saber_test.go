@@ -0,0 +1,58 @@
+package dex_test
+
+import (
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/saber"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestFromSaber(t *testing.T) {
+	var pools saber.AllPools
+	loadFixture(t, "saber_pools.json", &pools)
+
+	normalized := dex.FromSaber(pools.Data.Pools, map[string]float64{
+		dex.SOLMint: 100,
+		mSOL:        104,
+	}, map[string]float64{
+		"EnTrdMMpdhugeH6Ban6gYZWXughWxKtVGfCwFn78ZmY3": 0.0004 * 0.5,
+		"Lee1XZJfJ9Hm2K1qTyeCz1LXNc1YBZaKZszvNY4KCDw":  0.0004,
+	})
+	assert.Equal(t, len(normalized), 3)
+
+	data := map[string]struct {
+		Base   string
+		Quotes []string
+		Result []dex.Pair
+	}{
+		"mSOL": {
+			Base:   mSOL,
+			Quotes: []string{coinsSOL},
+			Result: []dex.Pair{
+				{
+					ID:        "EnTrdMMpdhugeH6Ban6gYZWXughWxKtVGfCwFn78ZmY3",
+					Base:      mSOL,
+					Quote:     dex.SOLMint,
+					Price:     1.0395,
+					Liquidity: 38711.2*104 + 40120.5*100,
+					Volume24H: 12050.7 * 100,
+					APY:       12050.7 * 100 * 0.0004 * 0.5 / (38711.2*104 + 40120.5*100) * 365,
+					FeeAPR:    12050.7 * 100 * 0.0004 * 0.5 / (38711.2*104 + 40120.5*100) * 365,
+				},
+			},
+		},
+		"stSOL reversed without price": {
+			Base:   stSOL,
+			Quotes: []string{coinsSOL},
+			Result: []dex.Pair{
+				{ID: "Lee1XZJfJ9Hm2K1qTyeCz1LXNc1YBZaKZszvNY4KCDw", Base: stSOL, Quote: dex.SOLMint, Price: 1 / 0.9619, Liquidity: 10650.1 * 100},
+			},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			assert.DeepEqual(t, dex.Match(normalized, s2.Base, s2.Quotes), s2.Result)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package dex
+
+import (
+	"github.com/everstake/solana-pools/pkg/solend"
+	"github.com/shopspring/decimal"
+)
+
+var wad = decimal.New(1, 18)
+
+// FromSolend normalizes Solend reserves into lending pairs that earn the reserve token's supply APY.
+func FromSolend(reserves []*solend.Reserve) []Pair {
+	result := make([]Pair, 0, len(reserves))
+	for _, r := range reserves {
+		l := r.Reserve.Liquidity
+		available, err := decimal.NewFromString(l.AvailableAmount)
+		if err != nil {
+			continue
+		}
+		borrowed, err := decimal.NewFromString(l.BorrowedAmountWads)
+		if err != nil {
+			continue
+		}
+		price, err := decimal.NewFromString(l.MarketPrice)
+		if err != nil {
+			continue
+		}
+		apy, err := decimal.NewFromString(r.Rates.SupplyInterest)
+		if err != nil {
+			continue
+		}
+		supply := available.Add(borrowed.Div(wad)).Div(decimal.New(1, int32(l.MintDecimals)))
+		liquidity, _ := supply.Mul(price).Div(wad).Float64()
+		result = append(result, Pair{
+			ID:        r.Reserve.Pubkey,
+			Base:      l.MintPubkey,
+			Quote:     l.MintPubkey,
+			Liquidity: liquidity,
+			APY:       apy.Div(decimal.NewFromInt(100)).InexactFloat64(),
+			Lending:   true,
+		})
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package dex_test
+
+import (
+	"github.com/everstake/solana-pools/pkg/dex"
+	"github.com/everstake/solana-pools/pkg/solend"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestFromSolend(t *testing.T) {
+	var reserves solend.Reserves
+	loadFixture(t, "solend_reserves.json", &reserves)
+
+	normalized := dex.FromSolend(reserves.Results)
+	assert.Equal(t, len(normalized), 2)
+
+	data := map[string]struct {
+		Base   string
+		Quotes []string
+		Result []dex.Pair
+	}{
+		"mSOL": {
+			Base:   mSOL,
+			Quotes: []string{USDC, coinsSOL},
+			Result: []dex.Pair{
+				{ID: "CCpirWrgNuBVLdkP2haxLTbD6XqEgaYuVXixbbpxUB6", Base: mSOL, Quote: mSOL, Liquidity: 150000, APY: 0.055, Lending: true},
+			},
+		},
+		"no quotes": {
+			Base:   USDC,
+			Quotes: nil,
+			Result: []dex.Pair{
+				{ID: "BgxfHJDzm44T7XG68MYKx7YisTjZu73tVovyZSjJMpmw", Base: USDC, Quote: USDC, Liquidity: 2000, APY: 0.02, Lending: true},
+			},
+		},
+		"malformed reserve": {
+			Base:   stSOL,
+			Quotes: []string{coinsSOL},
+			Result: []dex.Pair{},
+		},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			assert.DeepEqual(t, dex.Match(normalized, s2.Base, s2.Quotes), s2.Result)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package dex
+
+import (
+	"context"
+	"fmt"
+	"github.com/everstake/solana-pools/pkg/atrix"
+	solana_sdk "github.com/everstake/solana-pools/pkg/extension/solana-sdk"
+	"github.com/everstake/solana-pools/pkg/orca"
+	"github.com/everstake/solana-pools/pkg/raydium"
+	"github.com/everstake/solana-pools/pkg/saber"
+	"github.com/everstake/solana-pools/pkg/solend"
+	"github.com/portto/solana-go-sdk/client"
+)
+
+type (
+	// Source is a DEX or lending market the DeFi pairs are collected from.
+	Source interface {
+		Pairs(coins []Coin) ([]Pair, error)
+	}
+	// Coin is a token the caller knows about, for sources that identify tokens by symbol or report amounts in tokens.
+	Coin struct {
+		Symbol string
+		Mint   string
+		USD    float64
+	}
+
+	raydiumSource struct{ client *raydium.Client }
+	orcaSource    struct{ client *orca.Client }
+	saberSource   struct {
+		client *saber.Client
+		rpc    *client.Client
+	}
+	atrixSource  struct{ client *atrix.Client }
+	solendSource struct{ client *solend.Client }
+)
+
+func NewRaydiumSource(client *raydium.Client) Source { return &raydiumSource{client: client} }
+func NewOrcaSource(client *orca.Client) Source       { return &orcaSource{client: client} }
+func NewSaberSource(client *saber.Client, rpc *client.Client) Source {
+	return &saberSource{client: client, rpc: rpc}
+}
+func NewAtrixSource(client *atrix.Client) Source   { return &atrixSource{client: client} }
+func NewSolendSource(client *solend.Client) Source { return &solendSource{client: client} }
+
+func (s *raydiumSource) Pairs(coins []Coin) ([]Pair, error) {
+	pairs, err := s.client.GetPairs("")
+	if err != nil {
+		return nil, err
+	}
+	return FromRaydium(pairs), nil
+}
+
+func (s *orcaSource) Pairs(coins []Coin) ([]Pair, error) {
+	pools, err := s.client.GetPools()
+	if err != nil {
+		return nil, err
+	}
+	mints := make(map[string]string, len(coins))
+	for _, c := range coins {
+		mints[c.Symbol] = c.Mint
+	}
+	return FromOrca(pools, mints), nil
+}
+
+func (s *saberSource) Pairs(coins []Coin) ([]Pair, error) {
+	pools, err := s.client.GetPools()
+	if err != nil {
+		return nil, err
+	}
+	usd := make(map[string]float64, len(coins))
+	for _, c := range coins {
+		usd[NormalizeMint(c.Mint)] = c.USD
+	}
+
+	// Fees are read from the swap accounts of the pools with a known token only, in batches. A pool whose
+	// account is missing or does not parse earns no fee APR instead of failing the whole source.
+	ids := make([]string, 0, len(pools))
+	for _, p := range pools {
+		_, coinOK := usd[NormalizeMint(p.Coin.Address)]
+		_, pcOK := usd[NormalizeMint(p.PC.Address)]
+		if coinOK || pcOK {
+			ids = append(ids, p.AmmID)
+		}
+	}
+	fees := make(map[string]float64, len(ids))
+	for offset := 0; offset < len(ids); offset += solana_sdk.MaxMultipleAccounts {
+		batch := ids[offset:]
+		if len(batch) > solana_sdk.MaxMultipleAccounts {
+			batch = batch[:solana_sdk.MaxMultipleAccounts]
+		}
+		accounts, err := solana_sdk.GetMultipleAccounts(s.rpc.RpcClient.Call(context.Background(), "getMultipleAccounts", batch,
+			map[string]interface{}{"encoding": "base64"}))
+		if err != nil {
+			return nil, fmt.Errorf("solana_sdk.GetMultipleAccounts: %w", err)
+		}
+		for i, data := range accounts {
+			if i >= len(batch) || data == nil {
+				continue
+			}
+			f, err := saber.ParseSwapFees(data)
+			if err != nil {
+				continue
+			}
+			fees[batch[i]] = f.LPTradeFee()
+		}
+	}
+
+	return FromSaber(pools, usd, fees), nil
+}
+
+func (s *atrixSource) Pairs(coins []Coin) ([]Pair, error) {
+	tvl, err := s.client.GetTVL()
+	if err != nil {
+		return nil, err
+	}
+	return FromAtrix(tvl), nil
+}
+
+// Pairs returns the reserves of every Solend market that lend one of the coins.
+func (s *solendSource) Pairs(coins []Coin) ([]Pair, error) {
+	markets, err := s.client.GetMarkets()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(coins))
+	for _, c := range coins {
+		known[NormalizeMint(c.Mint)] = true
+	}
+	ids := make([]string, 0)
+	for _, m := range markets {
+		for _, r := range m.Reserves {
+			if known[NormalizeMint(r.LiquidityToken.Mint)] {
+				ids = append(ids, r.Address)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	reserves, err := s.client.GetReserves(ids)
+	if err != nil {
+		return nil, err
+	}
+	return FromSolend(reserves), nil
+}
@@ -0,0 +1,154 @@
+// Package export writes rows of a struct type as CSV or Parquet. The columns are the fields of the struct,
+// named and typed by their parquet tags, e.g. `parquet:"name=epoch, type=INT64"`; CSV writes INT64 fields with
+// convertedtype=TIMESTAMP_MILLIS as RFC 3339 times.
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	CSV     = Format("csv")
+	Parquet = Format("parquet")
+)
+
+const (
+	// parquetRowGroupSize bounds the rows buffered before they are written out.
+	parquetRowGroupSize = 16 * 1024 * 1024
+	parquetParallel     = 1
+)
+
+type (
+	// Writer writes rows of the type it was created for; Close must be called to complete the output.
+	Writer interface {
+		Write(row interface{}) error
+		Close() error
+	}
+	column struct {
+		name      string
+		timestamp bool
+	}
+	csvWriter struct {
+		w       *csv.Writer
+		columns []column
+		record  []string
+	}
+	parquetWriter struct {
+		w *writer.ParquetWriter
+	}
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case CSV, Parquet:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown format %q", s)
+}
+
+// ContentType is the MIME type of the format.
+func (f Format) ContentType() string {
+	if f == Parquet {
+		return "application/vnd.apache.parquet"
+	}
+	return "text/csv"
+}
+
+// NewWriter returns a writer of rows of the type of row, a struct or a pointer to one.
+func NewWriter(format Format, w io.Writer, row interface{}) (Writer, error) {
+	switch format {
+	case CSV:
+		return newCSVWriter(w, row)
+	case Parquet:
+		pw, err := writer.NewParquetWriterFromWriter(w, row, parquetParallel)
+		if err != nil {
+			return nil, fmt.Errorf("writer.NewParquetWriterFromWriter: %w", err)
+		}
+		pw.RowGroupSize = parquetRowGroupSize
+		pw.CompressionType = parquet.CompressionCodec_SNAPPY
+		return &parquetWriter{w: pw}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+func newCSVWriter(w io.Writer, row interface{}) (*csvWriter, error) {
+	t := reflect.TypeOf(row)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("row must be a struct, got %s", t)
+	}
+	cw := &csvWriter{w: csv.NewWriter(w), columns: make([]column, t.NumField())}
+	header := make([]string, t.NumField())
+	for i := range cw.columns {
+		cw.columns[i] = parseTag(t.Field(i))
+		header[i] = cw.columns[i].name
+	}
+	cw.record = make([]string, len(header))
+	return cw, cw.w.Write(header)
+}
+
+func parseTag(f reflect.StructField) column {
+	c := column{name: f.Name}
+	for _, kv := range strings.Split(f.Tag.Get("parquet"), ",") {
+		kv := strings.SplitN(strings.TrimSpace(kv), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.ToLower(kv[0]) {
+		case "name":
+			c.name = kv[1]
+		case "convertedtype":
+			c.timestamp = kv[1] == "TIMESTAMP_MILLIS"
+		}
+	}
+	return c
+}
+
+func (cw *csvWriter) Write(row interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(row))
+	for i, c := range cw.columns {
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.String:
+			cw.record[i] = f.String()
+		case reflect.Bool:
+			cw.record[i] = strconv.FormatBool(f.Bool())
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			if c.timestamp {
+				cw.record[i] = time.UnixMilli(f.Int()).UTC().Format(time.RFC3339)
+			} else {
+				cw.record[i] = strconv.FormatInt(f.Int(), 10)
+			}
+		case reflect.Float32, reflect.Float64:
+			cw.record[i] = strconv.FormatFloat(f.Float(), 'f', -1, 64)
+		default:
+			return fmt.Errorf("unsupported column %s of kind %s", c.name, f.Kind())
+		}
+	}
+	return cw.w.Write(cw.record)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (pw *parquetWriter) Write(row interface{}) error {
+	return pw.w.Write(row)
+}
+
+func (pw *parquetWriter) Close() error {
+	return pw.w.WriteStop()
+}
@@ -0,0 +1,71 @@
+package export_test
+
+import (
+	"bytes"
+	"github.com/everstake/solana-pools/pkg/export"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+type row struct {
+	Pool      string  `parquet:"name=pool, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Epoch     int64   `parquet:"name=epoch, type=INT64"`
+	APY       float64 `parquet:"name=apy, type=DOUBLE"`
+	CreatedAt int64   `parquet:"name=created_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+}
+
+var rows = []row{
+	{Pool: "Eversol", Epoch: 280, APY: 0.0712, CreatedAt: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC).UnixMilli()},
+	{Pool: "Marinade, Inc", Epoch: 281, APY: 0.07, CreatedAt: time.Date(2022, 3, 3, 12, 30, 0, 0, time.UTC).UnixMilli()},
+}
+
+func write(t *testing.T, format export.Format) []byte {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(format, &buf, &row{})
+	assert.NilError(t, err)
+	for i := range rows {
+		assert.NilError(t, w.Write(&rows[i]))
+	}
+	assert.NilError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestCSV(t *testing.T) {
+	assert.Equal(t, string(write(t, export.CSV)), "pool,epoch,apy,created_at\n"+
+		"Eversol,280,0.0712,2022-03-01T00:00:00Z\n"+
+		"\"Marinade, Inc\",281,0.07,2022-03-03T12:30:00Z\n")
+}
+
+func TestParquet(t *testing.T) {
+	f, err := buffer.NewBufferFile(write(t, export.Parquet))
+	assert.NilError(t, err)
+	pr, err := reader.NewParquetReader(f, &row{}, 1)
+	assert.NilError(t, err)
+	defer pr.ReadStop()
+
+	assert.Equal(t, pr.GetNumRows(), int64(len(rows)))
+	got := make([]row, len(rows))
+	assert.NilError(t, pr.Read(&got))
+	assert.DeepEqual(t, got, rows)
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := map[string]struct {
+		format export.Format
+		err    bool
+	}{
+		"csv":     {format: export.CSV},
+		"Parquet": {format: export.Parquet},
+		"json":    {err: true},
+	}
+	for s, tt := range tests {
+		t.Run(s, func(t *testing.T) {
+			f, err := export.ParseFormat(s)
+			assert.Equal(t, err != nil, tt.err)
+			assert.Equal(t, f, tt.format)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package solana_sdk
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/portto/solana-go-sdk/rpc"
+)
+
+// MaxMultipleAccounts is the most accounts the RPC returns in one getMultipleAccounts call.
+const MaxMultipleAccounts = 100
+
+type GetMultipleAccountsResponse struct {
+	rpc.GeneralResponse
+	Result GetMultipleAccountsResult `json:"result"`
+}
+
+type GetMultipleAccountsResult struct {
+	Context rpc.Context                       `json:"context"`
+	Value   []*GetMultipleAccountsResultValue `json:"value"`
+}
+
+type GetMultipleAccountsResultValue struct {
+	Lamports   uint64    `json:"lamports"`
+	Owner      string    `json:"owner"`
+	Executable bool      `json:"executable"`
+	RentEpoch  uint64    `json:"rentEpoch"`
+	Data       [2]string `json:"data"`
+}
+
+// GetMultipleAccounts returns the data of the accounts in the order they were requested, nil for missing accounts.
+// The call must ask for the base64 encoding.
+func GetMultipleAccounts(body []byte, err error) ([][]byte, error) {
+	if err != nil {
+		return nil, fmt.Errorf("rpc: call error, err: %v", err)
+	}
+	var res GetMultipleAccountsResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, fmt.Errorf("rpc: failed to json decode body, err: %v", err)
+	}
+	if res.Error != nil {
+		return nil, fmt.Errorf("rpc: response error, err: %v", res.Error.Message)
+	}
+	data := make([][]byte, len(res.Result.Value))
+	for i, v := range res.Result.Value {
+		if v == nil {
+			continue
+		}
+		if data[i], err = base64.StdEncoding.DecodeString(v.Data[0]); err != nil {
+			return nil, fmt.Errorf("rpc: failed to base64 decode account data, err: %v", err)
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,65 @@
+package solana_sdk
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"github.com/portto/solana-go-sdk/rpc"
+)
+
+const (
+	// TokenAccountSize is the data size of an SPL token account.
+	TokenAccountSize = 165
+	// TokenAccountAmountOffset is the offset of the amount in the SPL token account data.
+	TokenAccountAmountOffset = 64
+)
+
+type GetProgramAccountsResponse struct {
+	rpc.GeneralResponse
+	Result []GetProgramAccountsResultValue `json:"result"`
+}
+
+type GetProgramAccountsResultValue struct {
+	Pubkey  string                         `json:"pubkey"`
+	Account GetMultipleAccountsResultValue `json:"account"`
+}
+
+// TokenHoldersConfig is the getProgramAccounts config that returns only the amount of every token account of mint.
+func TokenHoldersConfig(mint string) map[string]interface{} {
+	return map[string]interface{}{
+		"encoding":  "base64",
+		"dataSlice": map[string]interface{}{"offset": TokenAccountAmountOffset, "length": 8},
+		"filters": []interface{}{
+			map[string]interface{}{"dataSize": TokenAccountSize},
+			map[string]interface{}{"memcmp": map[string]interface{}{"offset": 0, "bytes": mint}},
+		},
+	}
+}
+
+// GetTokenHolders counts the token accounts with a non-zero amount in a getProgramAccounts
+// response requested with TokenHoldersConfig.
+func GetTokenHolders(body []byte, err error) (uint64, error) {
+	if err != nil {
+		return 0, fmt.Errorf("rpc: call error, err: %v", err)
+	}
+	var res GetProgramAccountsResponse
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return 0, fmt.Errorf("rpc: failed to json decode body, err: %v", err)
+	}
+	if res.Error != nil {
+		return 0, fmt.Errorf("rpc: response error, err: %v", res.Error.Message)
+	}
+	var holders uint64
+	for _, v := range res.Result {
+		data, err := base64.StdEncoding.DecodeString(v.Account.Data[0])
+		if err != nil {
+			return 0, fmt.Errorf("rpc: failed to base64 decode account data, err: %v", err)
+		}
+		if len(data) == 8 && binary.LittleEndian.Uint64(data) > 0 {
+			holders++
+		}
+	}
+	return holders, nil
+}
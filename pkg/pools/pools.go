@@ -1,6 +1,7 @@
 package pools
 
 import (
+	"fmt"
 	"github.com/everstake/solana-pools/pkg/pools/marinade"
 	"github.com/everstake/solana-pools/pkg/pools/parrot"
 	"github.com/everstake/solana-pools/pkg/pools/solido"
@@ -22,15 +23,44 @@ func NewFactory(rpcClient *client.Client) Factory {
 	return Factory{solanaRPC: rpcClient}
 }
 
-func (f Factory) GetPool(name string) (p Pool, err error) {
+// Programs pool data can be read from.
+const (
+	ProgramMarinade  = "marinade"
+	ProgramParrot    = "parrot"
+	ProgramSolido    = "solido"
+	ProgramStakePool = "stake-pool"
+)
+
+var Programs = []string{ProgramMarinade, ProgramParrot, ProgramSolido, ProgramStakePool}
+
+// ProgramOf returns the pool program, derived from the pool name when it is not set.
+func ProgramOf(program string, name string) string {
+	if program != "" {
+		return program
+	}
 	switch name {
 	case types.ParrotPool:
-		return parrot.New(f.solanaRPC), nil
+		return ProgramParrot
 	case types.MarinadePool:
-		return marinade.New(f.solanaRPC), nil
+		return ProgramMarinade
 	case types.SolidoPool:
-		return solido.New(f.solanaRPC), nil
+		return ProgramSolido
 	default:
+		return ProgramStakePool
+	}
+}
+
+func (f Factory) GetPool(program string) (p Pool, err error) {
+	switch program {
+	case ProgramParrot:
+		return parrot.New(f.solanaRPC), nil
+	case ProgramMarinade:
+		return marinade.New(f.solanaRPC), nil
+	case ProgramSolido:
+		return solido.New(f.solanaRPC), nil
+	case ProgramStakePool:
 		return stdpool.New(f.solanaRPC), nil
+	default:
+		return nil, fmt.Errorf("unknown pool program %s", program)
 	}
 }
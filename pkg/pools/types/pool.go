@@ -19,7 +19,6 @@ const (
 type (
 	Pool struct {
 		Address          solana.PublicKey
-		APY              float64
 		Epoch            uint64
 		SolanaStake      uint64
 		TotalTokenSupply uint64
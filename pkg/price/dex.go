@@ -0,0 +1,62 @@
+package price
+
+import "time"
+
+// minDEXLiquidity is the USD liquidity below which a market is too thin to price a token.
+const minDEXLiquidity = 10000
+
+type (
+	// Market is a DEX market selling Base (a mint) for a quote token worth QuoteUSD.
+	Market struct {
+		Base      string
+		Price     float64
+		QuoteUSD  float64
+		Liquidity float64
+		UpdatedAt time.Time
+	}
+
+	dex struct {
+		markets []Market
+	}
+)
+
+// NewDEX prices assets by their mint from DEX markets, as the liquidity weighted average over the markets
+// holding at least minDEXLiquidity.
+func NewDEX(markets []Market) Provider {
+	return &dex{markets: markets}
+}
+
+func (d *dex) Name() string { return "dex" }
+
+func (d *dex) Quotes(assets []Asset) (map[string]Quote, error) {
+	type total struct {
+		value, liquidity float64
+		updatedAt        time.Time
+	}
+	totals := make(map[string]*total)
+	for _, m := range d.markets {
+		if m.Price <= 0 || m.QuoteUSD <= 0 || m.Liquidity < minDEXLiquidity {
+			continue
+		}
+		t, ok := totals[m.Base]
+		if !ok {
+			t = &total{}
+			totals[m.Base] = t
+		}
+		t.value += m.Price * m.QuoteUSD * m.Liquidity
+		t.liquidity += m.Liquidity
+		if m.UpdatedAt.After(t.updatedAt) {
+			t.updatedAt = m.UpdatedAt
+		}
+	}
+
+	quotes := make(map[string]Quote, len(assets))
+	for _, a := range assets {
+		t, ok := totals[a.Mint]
+		if !ok || a.Mint == "" {
+			continue
+		}
+		quotes[a.ID] = Quote{Source: d.Name(), USD: t.value / t.liquidity, UpdatedAt: t.updatedAt}
+	}
+	return quotes, nil
+}
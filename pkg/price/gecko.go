@@ -0,0 +1,56 @@
+package price
+
+import (
+	coingecko "github.com/superoo7/go-gecko/v3"
+	"time"
+)
+
+// geckoPageSize is the largest page /coins/markets serves.
+const geckoPageSize = 250
+
+type gecko struct {
+	client *coingecko.Client
+}
+
+// NewGecko prices assets with a GeckoKey from CoinGecko, in one /coins/markets request per 250 assets.
+func NewGecko(client *coingecko.Client) Provider {
+	return &gecko{client: client}
+}
+
+func (g *gecko) Name() string { return "coingecko" }
+
+func (g *gecko) Quotes(assets []Asset) (map[string]Quote, error) {
+	byKey := make(map[string][]string, len(assets))
+	keys := make([]string, 0, len(assets))
+	for _, a := range assets {
+		if a.GeckoKey == "" || a.GeckoKey == "null" {
+			continue
+		}
+		if _, ok := byKey[a.GeckoKey]; !ok {
+			keys = append(keys, a.GeckoKey)
+		}
+		byKey[a.GeckoKey] = append(byKey[a.GeckoKey], a.ID)
+	}
+
+	quotes := make(map[string]Quote, len(assets))
+	for start := 0; start < len(keys); start += geckoPageSize {
+		end := start + geckoPageSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		markets, err := g.client.CoinsMarket("usd", keys[start:end], "", geckoPageSize, 1, false, nil)
+		if err != nil {
+			return quotes, err
+		}
+		for _, m := range *markets {
+			updatedAt, err := time.Parse(time.RFC3339, m.LastUpdated)
+			if err != nil {
+				updatedAt = time.Now()
+			}
+			for _, id := range byKey[m.ID] {
+				quotes[id] = Quote{Source: g.Name(), USD: m.CurrentPrice, UpdatedAt: updatedAt}
+			}
+		}
+	}
+	return quotes, nil
+}
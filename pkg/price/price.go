@@ -0,0 +1,78 @@
+package price
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	// Provider is a source of USD prices.
+	Provider interface {
+		Name() string
+		// Quotes returns the prices it knows, keyed by Asset.ID. Assets it has no price for are left out.
+		Quotes(assets []Asset) (map[string]Quote, error)
+	}
+	// Asset is a token to price, identified by the keys the different providers use.
+	Asset struct {
+		ID       string
+		GeckoKey string
+		Mint     string
+	}
+	Quote struct {
+		Source    string
+		USD       float64
+		UpdatedAt time.Time
+	}
+)
+
+// Median aggregates the quotes of one asset into their median, ignoring quotes older than maxAge and
+// non-positive prices. The result is as fresh as the newest quote and names the sources it is built from.
+func Median(quotes []Quote, maxAge time.Duration, now time.Time) (Quote, bool) {
+	fresh := make([]Quote, 0, len(quotes))
+	for _, q := range quotes {
+		if q.USD <= 0 || now.Sub(q.UpdatedAt) > maxAge {
+			continue
+		}
+		fresh = append(fresh, q)
+	}
+	if len(fresh) == 0 {
+		return Quote{}, false
+	}
+	sort.Slice(fresh, func(i, j int) bool { return fresh[i].USD < fresh[j].USD })
+
+	var result Quote
+	if n := len(fresh); n%2 == 1 {
+		result.USD = fresh[n/2].USD
+	} else {
+		result.USD = (fresh[n/2-1].USD + fresh[n/2].USD) / 2
+	}
+
+	sources := make([]string, len(fresh))
+	for i, q := range fresh {
+		sources[i] = q.Source
+		if q.UpdatedAt.After(result.UpdatedAt) {
+			result.UpdatedAt = q.UpdatedAt
+		}
+	}
+	sort.Strings(sources)
+	result.Source = strings.Join(sources, ",")
+	return result, true
+}
+
+// Collect asks every provider for quotes and groups them by asset. A failing provider does not stop the
+// others; its error is returned keyed by the provider name.
+func Collect(providers []Provider, assets []Asset) (map[string][]Quote, map[string]error) {
+	quotes := make(map[string][]Quote, len(assets))
+	errs := make(map[string]error)
+	for _, p := range providers {
+		q, err := p.Quotes(assets)
+		if err != nil {
+			errs[p.Name()] = err
+		}
+		for id, quote := range q {
+			quotes[id] = append(quotes[id], quote)
+		}
+	}
+	return quotes, errs
+}
@@ -0,0 +1,97 @@
+package price_test
+
+import (
+	"errors"
+	"github.com/everstake/solana-pools/pkg/price"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+type providerMock struct {
+	name   string
+	quotes map[string]price.Quote
+	err    error
+}
+
+func (p providerMock) Name() string { return p.name }
+
+func (p providerMock) Quotes(assets []price.Asset) (map[string]price.Quote, error) {
+	return p.quotes, p.err
+}
+
+func TestMedian(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	data := map[string]struct {
+		Quotes []price.Quote
+		Result price.Quote
+		OK     bool
+	}{
+		"odd": {
+			Quotes: []price.Quote{
+				{Source: "dex", USD: 101, UpdatedAt: now.Add(-time.Minute)},
+				{Source: "coingecko", USD: 99, UpdatedAt: now.Add(-5 * time.Minute)},
+				{Source: "other", USD: 100, UpdatedAt: now.Add(-10 * time.Minute)},
+			},
+			Result: price.Quote{Source: "coingecko,dex,other", USD: 100, UpdatedAt: now.Add(-time.Minute)},
+			OK:     true,
+		},
+		"even": {
+			Quotes: []price.Quote{
+				{Source: "dex", USD: 101, UpdatedAt: now},
+				{Source: "coingecko", USD: 99, UpdatedAt: now},
+			},
+			Result: price.Quote{Source: "coingecko,dex", USD: 100, UpdatedAt: now},
+			OK:     true,
+		},
+		"stale and empty quotes are ignored": {
+			Quotes: []price.Quote{
+				{Source: "coingecko", USD: 50, UpdatedAt: now.Add(-2 * time.Hour)},
+				{Source: "other", USD: 0, UpdatedAt: now},
+				{Source: "dex", USD: 101, UpdatedAt: now},
+			},
+			Result: price.Quote{Source: "dex", USD: 101, UpdatedAt: now},
+			OK:     true,
+		},
+		"all stale": {
+			Quotes: []price.Quote{{Source: "coingecko", USD: 50, UpdatedAt: now.Add(-2 * time.Hour)}},
+		},
+		"no quotes": {},
+	}
+
+	for s, s2 := range data {
+		t.Run(s, func(t *testing.T) {
+			q, ok := price.Median(s2.Quotes, time.Hour, now)
+			assert.Equal(t, ok, s2.OK)
+			assert.DeepEqual(t, q, s2.Result)
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	now := time.Now()
+	quotes, errs := price.Collect([]price.Provider{
+		providerMock{name: "coingecko", err: errors.New("429 Too Many Requests")},
+		providerMock{name: "dex", quotes: map[string]price.Quote{"mSOL": {Source: "dex", USD: 104, UpdatedAt: now}}},
+	}, []price.Asset{{ID: "mSOL"}})
+
+	assert.DeepEqual(t, quotes, map[string][]price.Quote{"mSOL": {{Source: "dex", USD: 104, UpdatedAt: now}}})
+	assert.Equal(t, len(errs), 1)
+	assert.ErrorContains(t, errs["coingecko"], "429")
+}
+
+func TestDEX(t *testing.T) {
+	now := time.Now()
+	provider := price.NewDEX([]price.Market{
+		{Base: "mSOL", Price: 1.04, QuoteUSD: 100, Liquidity: 30000, UpdatedAt: now.Add(-time.Minute)},
+		{Base: "mSOL", Price: 104.8, QuoteUSD: 1, Liquidity: 10000, UpdatedAt: now},
+		{Base: "mSOL", Price: 200, QuoteUSD: 1, Liquidity: 500, UpdatedAt: now},
+		{Base: "stSOL", Price: 1.02, QuoteUSD: 0, Liquidity: 50000, UpdatedAt: now},
+	})
+
+	quotes, err := provider.Quotes([]price.Asset{{ID: "1", Mint: "mSOL"}, {ID: "2", Mint: "stSOL"}, {ID: "3"}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, quotes, map[string]price.Quote{
+		"1": {Source: "dex", USD: (104*30000 + 104.8*10000) / 40000, UpdatedAt: now},
+	})
+}
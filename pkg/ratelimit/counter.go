@@ -0,0 +1,28 @@
+package ratelimit
+
+import "sync"
+
+// Counter counts requests per key until they are drained.
+type Counter struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func NewCounter() *Counter {
+	return &Counter{counts: make(map[string]int64)}
+}
+
+func (c *Counter) Add(key string) {
+	c.mu.Lock()
+	c.counts[key]++
+	c.mu.Unlock()
+}
+
+// Drain returns the counts collected since the previous drain and resets them.
+func (c *Counter) Drain() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := c.counts
+	c.counts = make(map[string]int64)
+	return counts
+}
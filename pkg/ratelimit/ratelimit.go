@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AnonymousTier is the tier of requests without an API key.
+const AnonymousTier = "anonymous"
+
+// idleAfter is how long a bucket is kept after it was last used.
+const idleAfter = 10 * time.Minute
+
+// Limit allows Rate requests per second on average and up to Burst at once.
+type Limit struct {
+	Rate  float64
+	Burst float64
+}
+
+// ParseTiers parses tiers written as "rate/burst", e.g. {"anonymous": "5/10"}.
+func ParseTiers(tiers map[string]string) (map[string]Limit, error) {
+	limits := make(map[string]Limit, len(tiers))
+	for name, v := range tiers {
+		parts := strings.Split(v, "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("tier %s: want rate/burst, got %s", name, v)
+		}
+		rate, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("tier %s: bad rate %s", name, parts[0])
+		}
+		burst, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("tier %s: bad burst %s", name, parts[1])
+		}
+		limits[name] = Limit{Rate: rate, Burst: burst}
+	}
+	if _, ok := limits[AnonymousTier]; !ok {
+		return nil, fmt.Errorf("tier %s is required", AnonymousTier)
+	}
+	return limits, nil
+}
+
+type bucket struct {
+	tokens   float64
+	updated  time.Time
+	lastUsed time.Time
+}
+
+// Limiter keeps a token bucket per key, e.g. per API key or client IP.
+type Limiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func New() *Limiter {
+	return &Limiter{buckets: make(map[string]*bucket)}
+}
+
+// Allow takes a token from the key's bucket; when it is empty it returns false and the time until the next token.
+func (l *Limiter) Allow(key string, limit Limit, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > idleAfter {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastUsed) > idleAfter {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: limit.Burst, updated: now}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.updated).Seconds() * limit.Rate
+	if b.tokens > limit.Burst {
+		b.tokens = limit.Burst
+	}
+	b.updated, b.lastUsed = now, now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// Refund returns a token taken by Allow to the key's bucket, up to the burst.
+func (l *Limiter) Refund(key string, limit Limit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.buckets[key]; ok {
+		b.tokens++
+		if b.tokens > limit.Burst {
+			b.tokens = limit.Burst
+		}
+	}
+}
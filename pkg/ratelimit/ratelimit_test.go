@@ -0,0 +1,64 @@
+package ratelimit_test
+
+import (
+	"github.com/everstake/solana-pools/pkg/ratelimit"
+	"gotest.tools/assert"
+	"testing"
+	"time"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	l := ratelimit.New()
+	limit := ratelimit.Limit{Rate: 2, Burst: 3}
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		ok, _ := l.Allow("ip:1.1.1.1", limit, now)
+		assert.Assert(t, ok, "request %d within burst", i)
+	}
+	ok, retry := l.Allow("ip:1.1.1.1", limit, now)
+	assert.Assert(t, !ok)
+	assert.Equal(t, retry, 500*time.Millisecond)
+
+	ok, _ = l.Allow("ip:2.2.2.2", limit, now)
+	assert.Assert(t, ok, "buckets are per key")
+
+	ok, _ = l.Allow("ip:1.1.1.1", limit, now.Add(500*time.Millisecond))
+	assert.Assert(t, ok, "a token is refilled after 1/rate")
+}
+
+func TestLimiterRefund(t *testing.T) {
+	l := ratelimit.New()
+	limit := ratelimit.Limit{Rate: 1, Burst: 1}
+	now := time.Now()
+
+	ok, _ := l.Allow("ip:1.1.1.1", limit, now)
+	assert.Assert(t, ok)
+	l.Refund("ip:1.1.1.1", limit)
+	l.Refund("ip:1.1.1.1", limit)
+	ok, _ = l.Allow("ip:1.1.1.1", limit, now)
+	assert.Assert(t, ok, "the refunded token is available")
+	ok, _ = l.Allow("ip:1.1.1.1", limit, now)
+	assert.Assert(t, !ok, "refunds do not exceed the burst")
+}
+
+func TestParseTiers(t *testing.T) {
+	limits, err := ratelimit.ParseTiers(map[string]string{"anonymous": "5/10", "pro": "100/200"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, limits, map[string]ratelimit.Limit{"anonymous": {Rate: 5, Burst: 10}, "pro": {Rate: 100, Burst: 200}})
+
+	_, err = ratelimit.ParseTiers(map[string]string{"pro": "100/200"})
+	assert.Error(t, err, "tier anonymous is required")
+
+	_, err = ratelimit.ParseTiers(map[string]string{"anonymous": "5"})
+	assert.Error(t, err, "tier anonymous: want rate/burst, got 5")
+}
+
+func TestCounterDrain(t *testing.T) {
+	c := ratelimit.NewCounter()
+	c.Add("a")
+	c.Add("a")
+	c.Add("b")
+	assert.DeepEqual(t, c.Drain(), map[string]int64{"a": 2, "b": 1})
+	assert.DeepEqual(t, c.Drain(), map[string]int64{})
+}
@@ -0,0 +1,58 @@
+package saber
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// swapFeesOffset is the offset of the fees in a StableSwap account: is_initialized, is_paused and nonce,
+// five u64 ramp and admin fields, then nine public keys (admins, token accounts, mints and admin fee accounts).
+const (
+	swapFeesOffset = 3 + 5*8 + 9*32
+	swapSize       = swapFeesOffset + 8*8
+)
+
+// Fees are the fee fractions of a StableSwap pool.
+type Fees struct {
+	AdminTradeFeeNumerator      uint64
+	AdminTradeFeeDenominator    uint64
+	AdminWithdrawFeeNumerator   uint64
+	AdminWithdrawFeeDenominator uint64
+	TradeFeeNumerator           uint64
+	TradeFeeDenominator         uint64
+	WithdrawFeeNumerator        uint64
+	WithdrawFeeDenominator      uint64
+}
+
+// ParseSwapFees reads the fees from the data of a StableSwap (ammId) account.
+func ParseSwapFees(data []byte) (*Fees, error) {
+	if len(data) < swapSize {
+		return nil, fmt.Errorf("swap account is %d bytes, want at least %d", len(data), swapSize)
+	}
+	values := make([]uint64, 8)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint64(data[swapFeesOffset+i*8:])
+	}
+	return &Fees{
+		AdminTradeFeeNumerator:      values[0],
+		AdminTradeFeeDenominator:    values[1],
+		AdminWithdrawFeeNumerator:   values[2],
+		AdminWithdrawFeeDenominator: values[3],
+		TradeFeeNumerator:           values[4],
+		TradeFeeDenominator:         values[5],
+		WithdrawFeeNumerator:        values[6],
+		WithdrawFeeDenominator:      values[7],
+	}, nil
+}
+
+// LPTradeFee is the share of every trade paid to liquidity providers: the trade fee less the admin's cut of it.
+func (f Fees) LPTradeFee() float64 {
+	if f.TradeFeeDenominator == 0 {
+		return 0
+	}
+	fee := float64(f.TradeFeeNumerator) / float64(f.TradeFeeDenominator)
+	if f.AdminTradeFeeDenominator != 0 {
+		fee *= 1 - float64(f.AdminTradeFeeNumerator)/float64(f.AdminTradeFeeDenominator)
+	}
+	return fee
+}
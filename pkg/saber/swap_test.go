@@ -0,0 +1,33 @@
+package saber_test
+
+import (
+	"encoding/binary"
+	"github.com/everstake/solana-pools/pkg/saber"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestParseSwapFees(t *testing.T) {
+	data := make([]byte, 395)
+	for i, v := range []uint64{50, 100, 0, 10000, 4, 10000, 5, 1000} {
+		binary.LittleEndian.PutUint64(data[331+i*8:], v)
+	}
+
+	fees, err := saber.ParseSwapFees(data)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, fees, &saber.Fees{
+		AdminTradeFeeNumerator:      50,
+		AdminTradeFeeDenominator:    100,
+		AdminWithdrawFeeNumerator:   0,
+		AdminWithdrawFeeDenominator: 10000,
+		TradeFeeNumerator:           4,
+		TradeFeeDenominator:         10000,
+		WithdrawFeeNumerator:        5,
+		WithdrawFeeDenominator:      1000,
+	})
+	assert.Equal(t, fees.LPTradeFee(), 0.0002)
+
+	_, err = saber.ParseSwapFees(data[:394])
+	assert.ErrorContains(t, err, "394 bytes")
+	assert.Equal(t, saber.Fees{}.LPTradeFee(), 0.0)
+}
@@ -0,0 +1,89 @@
+package solend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Market struct {
+	Name      string           `json:"name"`
+	Address   string           `json:"address"`
+	IsPrimary bool             `json:"isPrimary"`
+	Reserves  []*ReserveConfig `json:"reserves"`
+}
+
+type ReserveConfig struct {
+	Address        string         `json:"address"`
+	LiquidityToken LiquidityToken `json:"liquidityToken"`
+}
+
+type LiquidityToken struct {
+	Mint     string `json:"mint"`
+	Symbol   string `json:"symbol"`
+	Decimals int64  `json:"decimals"`
+}
+
+type Reserves struct {
+	Results []*Reserve `json:"results"`
+}
+
+type Reserve struct {
+	Reserve ReserveData `json:"reserve"`
+	Rates   Rates       `json:"rates"`
+}
+
+type ReserveData struct {
+	Pubkey    string           `json:"pubkey"`
+	Liquidity ReserveLiquidity `json:"liquidity"`
+}
+
+type ReserveLiquidity struct {
+	MintPubkey      string `json:"mintPubkey"`
+	MintDecimals    int64  `json:"mintDecimals"`
+	AvailableAmount string `json:"availableAmount"`
+	// BorrowedAmountWads is the borrowed amount scaled by 10^18.
+	BorrowedAmountWads string `json:"borrowedAmountWads"`
+	// MarketPrice is the USD price scaled by 10^18.
+	MarketPrice string `json:"marketPrice"`
+}
+
+// Rates are annual percentages.
+type Rates struct {
+	SupplyInterest string `json:"supplyInterest"`
+	BorrowInterest string `json:"borrowInterest"`
+}
+
+func (c *Client) GetMarkets() ([]*Market, error) {
+	url := fmt.Sprintf("%s/v1/markets/configs?scope=all&deployment=production", baseURL)
+	resp, err := c.MakeReq(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*Market
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c *Client) GetReserves(ids []string) ([]*Reserve, error) {
+	params := url.Values{}
+	params.Add("ids", strings.Join(ids, ","))
+
+	url := fmt.Sprintf("%s/v1/reserves?%s", baseURL, params.Encode())
+	resp, err := c.MakeReq(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var data Reserves
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
+}